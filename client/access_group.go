@@ -40,6 +40,56 @@ func (c *Client) GetAccessGroup(ctx context.Context, req GetAccessGroupRequest)
 	return r, err
 }
 
+type ListAccessGroupsRequest struct {
+	TeamID    string
+	ProjectID string
+}
+
+// ListAccessGroups lists the access groups within a team, following pagination until every access group has
+// been returned. If a ProjectID is provided, only access groups with access to that project are returned.
+func (c *Client) ListAccessGroups(ctx context.Context, req ListAccessGroupsRequest) (r []AccessGroup, err error) {
+	next := ""
+	for {
+		url := fmt.Sprintf("%s/v1/access-groups?limit=100", c.baseURL)
+		if req.ProjectID != "" {
+			url = fmt.Sprintf("%s&projectId=%s", url, req.ProjectID)
+		}
+		if c.TeamID(req.TeamID) != "" {
+			url = fmt.Sprintf("%s&teamId=%s", url, c.TeamID(req.TeamID))
+		}
+		if next != "" {
+			url = fmt.Sprintf("%s&next=%s", url, next)
+		}
+		tflog.Info(ctx, "listing access groups", map[string]any{
+			"url": url,
+		})
+		resp := struct {
+			AccessGroups []AccessGroup `json:"accessGroups"`
+			Pagination   struct {
+				Next *string `json:"next"`
+			} `json:"pagination"`
+		}{}
+		err = c.doRequest(clientRequest{
+			ctx:    ctx,
+			method: "GET",
+			url:    url,
+			body:   "",
+		}, &resp)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list access groups: %w", err)
+		}
+
+		for i := range resp.AccessGroups {
+			resp.AccessGroups[i].TeamID = c.TeamID(req.TeamID)
+		}
+		r = append(r, resp.AccessGroups...)
+		if resp.Pagination.Next == nil || *resp.Pagination.Next == "" || *resp.Pagination.Next == next {
+			return r, nil
+		}
+		next = *resp.Pagination.Next
+	}
+}
+
 type CreateAccessGroupRequest struct {
 	TeamID string
 	Name   string
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListAccessGroups(t *testing.T) {
+	var queries []string
+	h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/access-groups" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		queries = append(queries, r.URL.RawQuery)
+		if r.URL.Query().Get("next") == "" {
+			fmt.Fprintln(w, `{ "accessGroups": [{ "accessGroupId": "ag_1", "name": "one" }], "pagination": { "count": 1, "next": "ag_1" } }`)
+			return
+		}
+		fmt.Fprintln(w, `{ "accessGroups": [{ "accessGroupId": "ag_2", "name": "two" }], "pagination": { "count": 1, "next": null } }`)
+	}))
+	defer h.Close()
+	cl := New("INVALID")
+	cl.baseURL = fmt.Sprintf("http://%s", h.Listener.Addr().String())
+
+	groups, err := cl.ListAccessGroups(context.Background(), ListAccessGroupsRequest{TeamID: "team_123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups) != 2 || groups[0].ID != "ag_1" || groups[1].ID != "ag_2" || groups[1].TeamID != "team_123" {
+		t.Errorf("unexpected access groups %+v", groups)
+	}
+	expected := []string{"limit=100&teamId=team_123", "limit=100&teamId=team_123&next=ag_1"}
+	if fmt.Sprint(queries) != fmt.Sprint(expected) {
+		t.Errorf("expected queries %v, got %v", expected, queries)
+	}
+}
@@ -80,6 +80,7 @@ type AliasResponse struct {
 	UID          string `json:"uid"`
 	Alias        string `json:"alias"`
 	DeploymentID string `json:"deploymentId"`
+	ProjectID    string `json:"projectId"`
 	TeamID       string `json:"-"`
 }
 
@@ -0,0 +1,180 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// dnsOverHTTPSURL is the public resolver used to look up CAA records. DNS over HTTPS is used, rather than the
+// system resolver, as Go's resolver cannot look up CAA records and plain DNS is often blocked where HTTPS is not.
+var dnsOverHTTPSURL = "https://dns.google/dns-query"
+
+// typeCAA is the DNS record type of a CAA record, which dnsmessage has no constant for.
+const typeCAA dnsmessage.Type = 257
+
+// CertificateAuthorities are the certificate authorities that Vercel uses to issue certificates for domains.
+var CertificateAuthorities = []string{"letsencrypt.org", "pki.goog"}
+
+// CAARecord is a DNS CAA record, which restricts the certificate authorities that may issue certificates for a
+// domain.
+type CAARecord struct {
+	Domain string
+	Flags  uint8
+	Tag    string
+	Value  string
+}
+
+// String formats the record as it would appear in a zone file.
+func (r CAARecord) String() string {
+	return fmt.Sprintf("%s CAA %d %s %q", r.Domain, r.Flags, r.Tag, r.Value)
+}
+
+// issuer returns the domain name of the certificate authority that an issue or issuewild record allows. This is
+// empty for records that forbid issuance entirely.
+func (r CAARecord) issuer() string {
+	issuer, _, _ := strings.Cut(r.Value, ";")
+	return strings.ToLower(strings.TrimSpace(issuer))
+}
+
+// LookupCAA returns the CAA records that apply to a domain. As per RFC 8659, these are the CAA records of the
+// closest domain, working up from the domain itself towards the root, that has any.
+func (c *Client) LookupCAA(ctx context.Context, domain string) ([]CAARecord, error) {
+	name := strings.TrimSuffix(strings.TrimPrefix(domain, "*."), ".")
+	for name != "" {
+		records, err := c.queryCAA(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("unable to look up CAA records for %s: %w", name, err)
+		}
+		if len(records) > 0 {
+			return records, nil
+		}
+		_, parent, found := strings.Cut(name, ".")
+		if !found {
+			break
+		}
+		name = parent
+	}
+	return nil, nil
+}
+
+// BlockingCAARecords returns the CAA records that stop Vercel's certificate authorities from issuing a certificate
+// for a domain. If nothing is returned, the records allow a certificate to be issued.
+func BlockingCAARecords(domain string, records []CAARecord) []CAARecord {
+	tag := "issue"
+	if strings.HasPrefix(domain, "*.") && hasCAATag(records, "issuewild") {
+		tag = "issuewild"
+	}
+
+	var relevant []CAARecord
+	for _, r := range records {
+		if !strings.EqualFold(r.Tag, tag) {
+			continue
+		}
+		if contains(CertificateAuthorities, r.issuer()) {
+			return nil
+		}
+		relevant = append(relevant, r)
+	}
+	return relevant
+}
+
+func hasCAATag(records []CAARecord, tag string) bool {
+	for _, r := range records {
+		if strings.EqualFold(r.Tag, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(items []string, i string) bool {
+	for _, j := range items {
+		if j == i {
+			return true
+		}
+	}
+	return false
+}
+
+// queryCAA looks up the CAA records of a single domain name.
+func (c *Client) queryCAA(ctx context.Context, domain string) ([]CAARecord, error) {
+	name, err := dnsmessage.NewName(domain + ".")
+	if err != nil {
+		return nil, err
+	}
+	query := dnsmessage.Message{
+		Header: dnsmessage.Header{RecursionDesired: true},
+		Questions: []dnsmessage.Question{{
+			Name:  name,
+			Type:  typeCAA,
+			Class: dnsmessage.ClassINET,
+		}},
+	}
+	body, err := query.Pack()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, dnsOverHTTPSURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/dns-message")
+	req.Header.Set("Accept", "application/dns-message")
+	resp, err := c.http().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d from DNS resolver", resp.StatusCode)
+	}
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response dnsmessage.Message
+	if err := response.Unpack(responseBody); err != nil {
+		return nil, fmt.Errorf("invalid response from DNS resolver: %w", err)
+	}
+	switch response.RCode {
+	case dnsmessage.RCodeSuccess, dnsmessage.RCodeNameError:
+	default:
+		return nil, fmt.Errorf("DNS resolver returned %s", response.RCode)
+	}
+
+	var records []CAARecord
+	for _, answer := range response.Answers {
+		unknown, ok := answer.Body.(*dnsmessage.UnknownResource)
+		if !ok || answer.Header.Type != typeCAA {
+			continue
+		}
+		record, err := parseCAA(answer.Header.Name.String(), unknown.Data)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// parseCAA parses the wire format of a CAA record: a flags byte, a tag length byte, the tag, and then the value.
+func parseCAA(domain string, data []byte) (CAARecord, error) {
+	if len(data) < 2 || len(data) < 2+int(data[1]) {
+		return CAARecord{}, fmt.Errorf("invalid CAA record for %s", domain)
+	}
+	tagLength := int(data[1])
+	return CAARecord{
+		Domain: domain,
+		Flags:  data[0],
+		Tag:    string(data[2 : 2+tagLength]),
+		Value:  string(data[2+tagLength:]),
+	}, nil
+}
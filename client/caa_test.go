@@ -0,0 +1,139 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+func caaData(flags uint8, tag, value string) []byte {
+	return append(append([]byte{flags, byte(len(tag))}, tag...), value...)
+}
+
+func TestLookupCAA(t *testing.T) {
+	zone := map[string][][]byte{
+		"example.com.": {
+			caaData(0, "issue", "digicert.com"),
+			caaData(0, "iodef", "mailto:security@example.com"),
+		},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var query dnsmessage.Message
+		if err := query.Unpack(body); err != nil {
+			t.Fatalf("invalid query: %s", err)
+		}
+		question := query.Questions[0]
+		response := dnsmessage.Message{
+			Header:    dnsmessage.Header{ID: query.ID, Response: true},
+			Questions: query.Questions,
+		}
+		for _, data := range zone[question.Name.String()] {
+			response.Answers = append(response.Answers, dnsmessage.Resource{
+				Header: dnsmessage.ResourceHeader{Name: question.Name, Type: typeCAA, Class: dnsmessage.ClassINET},
+				Body:   &dnsmessage.UnknownResource{Type: typeCAA, Data: data},
+			})
+		}
+		packed, err := response.Pack()
+		if err != nil {
+			t.Fatalf("unable to pack response: %s", err)
+		}
+		w.Header().Set("Content-Type", "application/dns-message")
+		_, _ = w.Write(packed)
+	}))
+	defer server.Close()
+	dnsOverHTTPSURL = server.URL
+
+	c := New("")
+	records, err := c.LookupCAA(context.Background(), "www.example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected the 2 records of the parent domain, got %v", records)
+	}
+	if records[0].String() != `example.com. CAA 0 issue "digicert.com"` {
+		t.Errorf("unexpected record %s", records[0])
+	}
+
+	blocking := BlockingCAARecords("www.example.com", records)
+	if len(blocking) != 1 || blocking[0].Tag != "issue" {
+		t.Errorf("expected the issue record to be blocking, got %v", blocking)
+	}
+
+	records, err = c.LookupCAA(context.Background(), "example.org")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(records) != 0 {
+		t.Errorf("expected no records, got %v", records)
+	}
+}
+
+func TestBlockingCAARecords(t *testing.T) {
+	type TestCase struct {
+		Name           string
+		Domain         string
+		Records        []CAARecord
+		ExpectBlocking int
+	}
+
+	for _, tc := range []TestCase{
+		{
+			Name:   "No records",
+			Domain: "example.com",
+		},
+		{
+			Name:   "Let's Encrypt allowed",
+			Domain: "example.com",
+			Records: []CAARecord{
+				{Tag: "issue", Value: "digicert.com"},
+				{Tag: "issue", Value: "letsencrypt.org; validationmethods=http-01"},
+			},
+		},
+		{
+			Name:   "Other certificate authority only",
+			Domain: "example.com",
+			Records: []CAARecord{
+				{Tag: "issue", Value: "digicert.com"},
+				{Tag: "iodef", Value: "mailto:security@example.com"},
+			},
+			ExpectBlocking: 1,
+		},
+		{
+			Name:   "Issuance forbidden",
+			Domain: "example.com",
+			Records: []CAARecord{
+				{Tag: "issue", Value: ";"},
+			},
+			ExpectBlocking: 1,
+		},
+		{
+			Name:   "Wildcard uses issuewild",
+			Domain: "*.example.com",
+			Records: []CAARecord{
+				{Tag: "issue", Value: "letsencrypt.org"},
+				{Tag: "issuewild", Value: "digicert.com"},
+			},
+			ExpectBlocking: 1,
+		},
+		{
+			Name:   "Wildcard falls back to issue",
+			Domain: "*.example.com",
+			Records: []CAARecord{
+				{Tag: "issue", Value: "pki.goog"},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			blocking := BlockingCAARecords(tc.Domain, tc.Records)
+			if len(blocking) != tc.ExpectBlocking {
+				t.Errorf("expected %d blocking records, got %v", tc.ExpectBlocking, blocking)
+			}
+		})
+	}
+}
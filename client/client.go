@@ -8,10 +8,13 @@ import (
 
 // Client is an API wrapper, providing a high-level interface to the Vercel API.
 type Client struct {
-	token   string
-	client  *http.Client
-	team    Team
-	baseURL string
+	token    string
+	client   *http.Client
+	team     Team
+	baseURL  string
+	features *Features
+
+	maintenanceMaxWait *time.Duration
 }
 
 func (c *Client) http() *http.Client {
@@ -13,7 +13,8 @@ type UploadCustomCertificateRequest struct {
 }
 
 type CertificateResponse struct {
-	ID string `json:"id"`
+	ID  string   `json:"id"`
+	CNs []string `json:"cns"`
 }
 
 func (c *Client) UploadCustomCertificate(ctx context.Context, request UploadCustomCertificateRequest) (cr CertificateResponse, err error) {
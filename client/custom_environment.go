@@ -136,3 +136,35 @@ func (c *Client) DeleteCustomEnvironment(ctx context.Context, request DeleteCust
 	}, nil)
 	return err
 }
+
+type ListCustomEnvironmentsRequest struct {
+	TeamID    string `json:"-"`
+	ProjectID string `json:"-"`
+}
+
+// ListCustomEnvironments returns all of the custom environments for a project.
+func (c *Client) ListCustomEnvironments(ctx context.Context, request ListCustomEnvironmentsRequest) (res []CustomEnvironmentResponse, err error) {
+	url := fmt.Sprintf("%s/v9/projects/%s/custom-environments", c.baseURL, request.ProjectID)
+	if c.TeamID(request.TeamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(request.TeamID))
+	}
+	tflog.Info(ctx, "listing custom environments", map[string]any{
+		"url": url,
+	})
+	var response struct {
+		Environments []CustomEnvironmentResponse `json:"environments"`
+	}
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	for i := range response.Environments {
+		response.Environments[i].TeamID = c.TeamID(request.TeamID)
+		response.Environments[i].ProjectID = request.ProjectID
+	}
+	return response.Environments, nil
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/url"
 	"strings"
 	"time"
 
@@ -28,6 +29,7 @@ type gitSource struct {
 	Owner     string `json:"owner,omitempty"`
 	Slug      string `json:"slug,omitempty"`
 	Ref       string `json:"ref"`
+	Sha       string `json:"sha,omitempty"`
 }
 
 // CreateDeploymentRequest defines the request the Vercel API expects in order to create a deployment.
@@ -45,7 +47,16 @@ type CreateDeploymentRequest struct {
 	Routes          []any          `json:"routes,omitempty"`
 	Target          string         `json:"target,omitempty"`
 	GitSource       *gitSource     `json:"gitSource,omitempty"`
-	Ref             string         `json:"-"`
+	// AutoAssignCustomDomains controls whether production domains are aliased to
+	// a production deployment once it is ready. The API defaults this to true.
+	AutoAssignCustomDomains *bool `json:"autoAssignCustomDomains,omitempty"`
+	// Meta is a set of key/value tags attached to the deployment. They can be
+	// used to find the deployment again with ListDeployments.
+	Meta map[string]string `json:"meta,omitempty"`
+	// BuildEnvironment holds environment variables that are only available during the build. They are
+	// added to Build.Environment when the deployment is created, and are never logged.
+	BuildEnvironment map[string]string `json:"-"`
+	Ref              string            `json:"-"`
 }
 
 // DeploymentResponse defines the response the Vercel API returns when a deployment is created or updated.
@@ -70,17 +81,83 @@ type DeploymentResponse struct {
 	Build struct {
 		Environment []string `json:"env"`
 	} `json:"build"`
-	AliasAssigned    bool      `json:"aliasAssigned"`
-	ChecksConclusion string    `json:"checksConclusion"`
-	ErrorCode        string    `json:"errorCode"`
-	ErrorMessage     string    `json:"errorMessage"`
-	ID               string    `json:"id"`
-	ProjectID        string    `json:"projectId"`
-	TeamID           string    `json:"-"`
-	ReadyState       string    `json:"readyState"`
-	Target           *string   `json:"target"`
-	URL              string    `json:"url"`
-	GitSource        gitSource `json:"gitSource"`
+	Environment      []string          `json:"env"`
+	AliasAssigned    bool              `json:"aliasAssigned"`
+	ChecksConclusion string            `json:"checksConclusion"`
+	ErrorCode        string            `json:"errorCode"`
+	ErrorMessage     string            `json:"errorMessage"`
+	ID               string            `json:"id"`
+	ProjectID        string            `json:"projectId"`
+	TeamID           string            `json:"-"`
+	ReadyState       string            `json:"readyState"`
+	Target           *string           `json:"target"`
+	URL              string            `json:"url"`
+	GitSource        gitSource         `json:"gitSource"`
+	Meta             map[string]string `json:"meta"`
+}
+
+// DeploymentGitMetadata describes the git commit a deployment was created from.
+type DeploymentGitMetadata struct {
+	// Provider is one of "github", "gitlab" or "bitbucket".
+	Provider      string
+	Repo          string
+	Ref           string
+	Sha           string
+	PullRequestID string
+	CommitAuthor  string
+}
+
+// gitMetaKeys are the prefixes Vercel uses for the git metadata of a deployment, by git provider.
+var gitMetaKeys = []string{"github", "gitlab", "bitbucket"}
+
+// GitMetadata returns the git commit that a deployment was created from, based on the metadata Vercel attaches to
+// deployments of connected git repositories. It returns nil if the deployment was not created from git.
+func (dr *DeploymentResponse) GitMetadata() *DeploymentGitMetadata {
+	first := func(keys ...string) string {
+		for _, k := range keys {
+			if v := dr.Meta[k]; v != "" {
+				return v
+			}
+		}
+		return ""
+	}
+	for _, p := range gitMetaKeys {
+		sha := dr.Meta[p+"CommitSha"]
+		if sha == "" {
+			continue
+		}
+		var repo string
+		switch p {
+		case "github":
+			org, name := first("githubCommitOrg", "githubOrg"), first("githubCommitRepo", "githubRepo")
+			if org != "" && name != "" {
+				repo = org + "/" + name
+			}
+		case "gitlab":
+			repo = dr.Meta["gitlabProjectPath"]
+		case "bitbucket":
+			owner, slug := dr.Meta["bitbucketRepoOwner"], dr.Meta["bitbucketRepoSlug"]
+			if owner != "" && slug != "" {
+				repo = owner + "/" + slug
+			}
+		}
+		return &DeploymentGitMetadata{
+			Provider:      p,
+			Repo:          repo,
+			Ref:           dr.Meta[p+"CommitRef"],
+			Sha:           sha,
+			PullRequestID: dr.Meta[p+"PrId"],
+			CommitAuthor:  first(p+"CommitAuthorLogin", p+"CommitAuthorName"),
+		}
+	}
+	if dr.GitSource.Type == "" {
+		return nil
+	}
+	return &DeploymentGitMetadata{
+		Provider: dr.GitSource.Type,
+		Ref:      dr.GitSource.Ref,
+		Sha:      dr.GitSource.Sha,
+	}
 }
 
 // IsComplete is used to determine whether a deployment is still processing, or whether it is fully done.
@@ -186,8 +263,16 @@ func (c *Client) getGitSource(ctx context.Context, projectID, ref, teamID string
 
 // CreateDeployment creates a deployment within Vercel.
 func (c *Client) CreateDeployment(ctx context.Context, request CreateDeploymentRequest, teamID string) (r DeploymentResponse, err error) {
-	request.Name = request.ProjectID                // Name is ignored if project is specified
-	request.Build.Environment = request.Environment // Ensure they are both the same, as project environment variables are
+	request.Name = request.ProjectID // Name is ignored if project is specified
+	// The deployment environment is also available during the build, as project environment variables are.
+	// Build-only environment variables are redacted until the request has been logged.
+	request.Build.Environment = map[string]string{}
+	for k, v := range request.Environment {
+		request.Build.Environment[k] = v
+	}
+	for k := range request.BuildEnvironment {
+		request.Build.Environment[k] = "[redacted]"
+	}
 	if request.Ref != "" {
 		gitSource, err := c.getGitSource(ctx, request.ProjectID, request.Ref, teamID)
 		if err != nil {
@@ -199,12 +284,15 @@ func (c *Client) CreateDeployment(ctx context.Context, request CreateDeploymentR
 	if c.TeamID(teamID) != "" {
 		url = fmt.Sprintf("%s&teamId=%s", url, c.TeamID(teamID))
 	}
-	payload := string(mustMarshal(request))
-
 	tflog.Info(ctx, "creating deployment", map[string]any{
 		"url":     url,
-		"payload": payload,
+		"payload": string(mustMarshal(request)),
 	})
+	for k, v := range request.BuildEnvironment {
+		request.Build.Environment[k] = v
+	}
+	payload := string(mustMarshal(request))
+
 	err = c.doRequest(clientRequest{
 		ctx:    ctx,
 		method: "POST",
@@ -228,19 +316,29 @@ func (c *Client) CreateDeployment(ctx context.Context, request CreateDeploymentR
 		return r, err
 	}
 
+	if !c.Features().Deployments.WaitForReady {
+		return r, r.CheckForError(request.ProjectID)
+	}
+
 	// Now we've successfully created a deployment, but the deployment process is async.
 	// So poll the deployment until it either fails, or is completed.
+	progress := NewProgress("waiting for deployment " + r.ID)
 	for !r.IsComplete() {
 		err = r.CheckForError(request.ProjectID)
 		if err != nil {
 			return r, err
 		}
+		progress.Step(ctx, strings.ToLower(r.ReadyState), map[string]any{
+			"deployment_id": r.ID,
+			"url":           r.URL,
+		})
 		time.Sleep(5 * time.Second)
 		r, err = c.GetDeployment(ctx, r.ID, teamID)
 		if err != nil {
 			return r, fmt.Errorf("error getting deployment: %w", err)
 		}
 	}
+	progress.Done(ctx)
 
 	if r.AliasWarning != nil {
 		// Log out that there is a warning for an alias.
@@ -294,3 +392,117 @@ func (c *Client) GetDeployment(ctx context.Context, deploymentID, teamID string)
 	r.TeamID = c.TeamID(teamID)
 	return r, err
 }
+
+// DeploymentBuildOutput is a single output of a deployment build, such as a static file or a function.
+type DeploymentBuildOutput struct {
+	// Type is one of "file", "lambda" for Serverless Functions, or "edge" for Edge Functions.
+	Type string `json:"type"`
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+}
+
+// DeploymentBuild describes one of the builds that produced the output of a deployment.
+type DeploymentBuild struct {
+	ID         string                  `json:"id"`
+	Entrypoint string                  `json:"entrypoint"`
+	ReadyState string                  `json:"readyState"`
+	Output     []DeploymentBuildOutput `json:"output"`
+}
+
+// GetDeploymentBuilds retrieves the builds of an existing Deployment, including the size of each of their outputs.
+func (c *Client) GetDeploymentBuilds(ctx context.Context, deploymentID, teamID string) (r []DeploymentBuild, err error) {
+	url := fmt.Sprintf("%s/v11/deployments/%s/builds", c.baseURL, deploymentID)
+	if c.TeamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(teamID))
+	}
+
+	tflog.Info(ctx, "getting deployment builds", map[string]any{
+		"url": url,
+	})
+	var response struct {
+		Builds []DeploymentBuild `json:"builds"`
+	}
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+		body:   "",
+	}, &response)
+	return response.Builds, err
+}
+
+// DeploymentFileTreeEntry describes a single file or directory within the output of a deployment.
+type DeploymentFileTreeEntry struct {
+	Name        string                    `json:"name"`
+	Type        string                    `json:"type"`
+	UID         string                    `json:"uid,omitempty"`
+	Mode        int64                     `json:"mode"`
+	ContentType string                    `json:"contentType,omitempty"`
+	Children    []DeploymentFileTreeEntry `json:"children,omitempty"`
+}
+
+// GetDeploymentFileTree retrieves the file tree that makes up the build output of an existing Deployment.
+func (c *Client) GetDeploymentFileTree(ctx context.Context, deploymentID, teamID string) (r []DeploymentFileTreeEntry, err error) {
+	url := fmt.Sprintf("%s/v6/deployments/%s/files", c.baseURL, deploymentID)
+	if c.TeamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(teamID))
+	}
+
+	tflog.Info(ctx, "getting deployment file tree", map[string]any{
+		"url": url,
+	})
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+		body:   "",
+	}, &r)
+	return r, err
+}
+
+// ListedDeployment is the summary of a deployment returned when listing deployments.
+type ListedDeployment struct {
+	ID        string            `json:"uid"`
+	URL       string            `json:"url"`
+	State     string            `json:"state"`
+	Target    *string           `json:"target"`
+	CreatedAt int64             `json:"created"`
+	Meta      map[string]string `json:"meta"`
+}
+
+type ListDeploymentsRequest struct {
+	ProjectID string
+	TeamID    string
+	// Meta filters the deployments to those that have every one of the given meta tags.
+	Meta map[string]string
+}
+
+// ListDeployments lists the 100 most recent deployments (no pagination) of a project, most recent first.
+func (c *Client) ListDeployments(ctx context.Context, request ListDeploymentsRequest) ([]ListedDeployment, error) {
+	query := url.Values{}
+	query.Set("projectId", request.ProjectID)
+	query.Set("limit", "100")
+	for k, v := range request.Meta {
+		query.Set("meta-"+k, v)
+	}
+	if c.TeamID(request.TeamID) != "" {
+		query.Set("teamId", c.TeamID(request.TeamID))
+	}
+	url := fmt.Sprintf("%s/v6/deployments?%s", c.baseURL, query.Encode())
+
+	tflog.Info(ctx, "listing deployments", map[string]any{
+		"url": url,
+	})
+	var res struct {
+		Deployments []ListedDeployment `json:"deployments"`
+	}
+	err := c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+	}, &res)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list deployments: %w", err)
+	}
+	return res.Deployments, nil
+}
@@ -24,7 +24,9 @@ type TrustedIps struct {
 }
 
 type ProtectionBypass struct {
-	Scope string `json:"scope"`
+	Scope     string `json:"scope"`
+	Note      string `json:"note,omitempty"`
+	CreatedAt int64  `json:"createdAt"`
 }
 
 type OptionsAllowlist struct {
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestDeploymentGitMetadata(t *testing.T) {
+	type TestCase struct {
+		Name         string
+		ResponseJSON string
+		Expected     *DeploymentGitMetadata
+	}
+
+	for _, tc := range []TestCase{
+		{
+			Name:         "Not from git",
+			ResponseJSON: `{ "meta": { "workspace": "prod" } }`,
+		},
+		{
+			Name: "GitHub",
+			ResponseJSON: `{ "meta": {
+				"githubCommitSha": "abc123",
+				"githubCommitRef": "main",
+				"githubCommitOrg": "vercel",
+				"githubCommitRepo": "next.js",
+				"githubCommitAuthorLogin": "octocat",
+				"githubCommitAuthorName": "The Octocat",
+				"githubPrId": "42"
+			} }`,
+			Expected: &DeploymentGitMetadata{
+				Provider:      "github",
+				Repo:          "vercel/next.js",
+				Ref:           "main",
+				Sha:           "abc123",
+				PullRequestID: "42",
+				CommitAuthor:  "octocat",
+			},
+		},
+		{
+			Name: "Bitbucket",
+			ResponseJSON: `{ "meta": {
+				"bitbucketCommitSha": "def456",
+				"bitbucketCommitRef": "feature",
+				"bitbucketRepoOwner": "team",
+				"bitbucketRepoSlug": "app",
+				"bitbucketCommitAuthorName": "Jane Doe"
+			} }`,
+			Expected: &DeploymentGitMetadata{
+				Provider:     "bitbucket",
+				Repo:         "team/app",
+				Ref:          "feature",
+				Sha:          "def456",
+				CommitAuthor: "Jane Doe",
+			},
+		},
+		{
+			Name:         "Git source only",
+			ResponseJSON: `{ "gitSource": { "type": "gitlab", "ref": "main", "sha": "789abc" } }`,
+			Expected: &DeploymentGitMetadata{
+				Provider: "gitlab",
+				Ref:      "main",
+				Sha:      "789abc",
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			var dr DeploymentResponse
+			if err := json.Unmarshal([]byte(tc.ResponseJSON), &dr); err != nil {
+				t.Fatal(err)
+			}
+			got := dr.GitMetadata()
+			if !reflect.DeepEqual(got, tc.Expected) {
+				t.Errorf("expected %+v, got %+v", tc.Expected, got)
+			}
+		})
+	}
+}
+
+func TestGetDeploymentBuilds(t *testing.T) {
+	var path, query string
+	h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, query = r.URL.Path, r.URL.RawQuery
+		fmt.Fprintln(w, `{ "builds": [{ "id": "bld_1", "entrypoint": "package.json", "readyState": "READY", "output": [
+			{ "type": "file", "path": "index.html", "size": 512 },
+			{ "type": "lambda", "path": "api/hello", "size": 2048 },
+			{ "type": "edge", "path": "middleware", "size": 1024 }
+		] }] }`)
+	}))
+	defer h.Close()
+	cl := New("INVALID")
+	cl.baseURL = fmt.Sprintf("http://%s", h.Listener.Addr().String())
+
+	builds, err := cl.GetDeploymentBuilds(context.Background(), "dpl_123", "team_123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/v11/deployments/dpl_123/builds" || query != "teamId=team_123" {
+		t.Errorf("unexpected request %s?%s", path, query)
+	}
+	if len(builds) != 1 || len(builds[0].Output) != 3 || builds[0].Output[1].Type != "lambda" || builds[0].Output[1].Size != 2048 {
+		t.Errorf("unexpected builds %+v", builds)
+	}
+}
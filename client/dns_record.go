@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -96,29 +97,45 @@ func (c *Client) GetDNSRecord(ctx context.Context, recordID, teamID string) (r D
 	return r, err
 }
 
-// ListDNSRecords is a test helper for listing DNS records that exist for a given domain.
-// We limit this to 100, as this is the largest limit allowed by the API.
-// This is only used by the sweeper script, so this is safe to do so, but converting
-// into a production ready function would require some refactoring.
+// ListDNSRecords lists every DNS record that exists for a given domain, following the pagination of the API.
 func (c *Client) ListDNSRecords(ctx context.Context, domain, teamID string) (r []DNSRecord, err error) {
-	url := fmt.Sprintf("%s/v4/domains/%s/records?limit=100", c.baseURL, domain)
-	if c.TeamID(teamID) != "" {
-		url = fmt.Sprintf("%s&teamId=%s", url, c.TeamID(teamID))
-	}
-
-	dr := struct {
-		Records []DNSRecord `json:"records"`
-	}{}
-	err = c.doRequest(clientRequest{
-		ctx:    ctx,
-		method: "GET",
-		url:    url,
-		body:   "",
-	}, &dr)
-	for i := 0; i < len(dr.Records); i++ {
-		dr.Records[i].TeamID = c.TeamID(teamID)
+	until := ""
+	for {
+		url := fmt.Sprintf("%s/v4/domains/%s/records?limit=100", c.baseURL, domain)
+		if c.TeamID(teamID) != "" {
+			url = fmt.Sprintf("%s&teamId=%s", url, c.TeamID(teamID))
+		}
+		if until != "" {
+			url = fmt.Sprintf("%s&until=%s", url, until)
+		}
+		tflog.Info(ctx, "listing dns records", map[string]any{
+			"url": url,
+		})
+
+		dr := struct {
+			Records    []DNSRecord `json:"records"`
+			Pagination struct {
+				Next *json.Number `json:"next"`
+			} `json:"pagination"`
+		}{}
+		err = c.doRequest(clientRequest{
+			ctx:    ctx,
+			method: "GET",
+			url:    url,
+			body:   "",
+		}, &dr)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < len(dr.Records); i++ {
+			dr.Records[i].TeamID = c.TeamID(teamID)
+		}
+		r = append(r, dr.Records...)
+		if dr.Pagination.Next == nil || dr.Pagination.Next.String() == until {
+			return r, nil
+		}
+		until = dr.Pagination.Next.String()
 	}
-	return dr.Records, err
 }
 
 // SRVUpdate defines the updatable fields within an SRV block of a DNS record.
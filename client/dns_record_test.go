@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListDNSRecords(t *testing.T) {
+	var queries []string
+	h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v4/domains/example.com/records" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		queries = append(queries, r.URL.RawQuery)
+		if r.URL.Query().Get("until") == "" {
+			fmt.Fprintln(w, `{ "records": [{ "id": "rec_1", "name": "www", "type": "A", "recordType": "A", "value": "1.1.1.1", "creator": "user" }], "pagination": { "count": 1, "next": 1700000000000 } }`)
+			return
+		}
+		fmt.Fprintln(w, `{ "records": [{ "id": "rec_2", "name": "", "recordType": "ALIAS", "value": "cname.vercel-dns.com.", "creator": "system" }], "pagination": { "count": 1, "next": null } }`)
+	}))
+	defer h.Close()
+	cl := New("INVALID")
+	cl.baseURL = fmt.Sprintf("http://%s", h.Listener.Addr().String())
+
+	records, err := cl.ListDNSRecords(context.Background(), "example.com", "team_123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 || records[0].ID != "rec_1" || records[1].Creator != "system" || records[1].TeamID != "team_123" {
+		t.Errorf("unexpected records %+v", records)
+	}
+	expected := []string{"limit=100&teamId=team_123", "limit=100&teamId=team_123&until=1700000000000"}
+	if fmt.Sprint(queries) != fmt.Sprint(expected) {
+		t.Errorf("expected queries %v, got %v", expected, queries)
+	}
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Domain is a domain registered with, or added to, a Vercel account.
+type Domain struct {
+	ID                  string   `json:"id"`
+	Name                string   `json:"name"`
+	ServiceType         string   `json:"serviceType"`
+	Verified            bool     `json:"verified"`
+	Nameservers         []string `json:"nameservers"`
+	IntendedNameservers []string `json:"intendedNameservers"`
+	CustomNameservers   []string `json:"customNameservers"`
+}
+
+// GetDomain retrieves information about a domain from Vercel.
+func (c *Client) GetDomain(ctx context.Context, domain, teamID string) (d Domain, err error) {
+	url := fmt.Sprintf("%s/v5/domains/%s", c.baseURL, domain)
+	if c.TeamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(teamID))
+	}
+	tflog.Info(ctx, "getting domain", map[string]any{
+		"url": url,
+	})
+	var res struct {
+		Domain Domain `json:"domain"`
+	}
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+	}, &res)
+	return res.Domain, err
+}
+
+// DomainConfig describes whether the DNS of a domain is configured to serve Vercel deployments.
+type DomainConfig struct {
+	Misconfigured      bool     `json:"misconfigured"`
+	ConfiguredBy       *string  `json:"configuredBy"`
+	AcceptedChallenges []string `json:"acceptedChallenges"`
+}
+
+// GetDomainConfig retrieves whether the DNS of a domain is configured to serve Vercel deployments.
+func (c *Client) GetDomainConfig(ctx context.Context, domain, teamID string) (d DomainConfig, err error) {
+	url := fmt.Sprintf("%s/v6/domains/%s/config", c.baseURL, domain)
+	if c.TeamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(teamID))
+	}
+	tflog.Info(ctx, "getting domain config", map[string]any{
+		"url": url,
+	})
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+	}, &d)
+	return d, err
+}
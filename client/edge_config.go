@@ -11,6 +11,7 @@ type EdgeConfig struct {
 	Slug   string `json:"slug"`
 	ID     string `json:"id"`
 	TeamID string `json:"ownerId"`
+	Digest string `json:"digest"`
 }
 
 type CreateEdgeConfigRequest struct {
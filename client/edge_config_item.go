@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -133,3 +134,62 @@ func (c *Client) GetEdgeConfigItem(ctx context.Context, request EdgeConfigItemRe
 	e.TeamID = c.TeamID(request.TeamID)
 	return e, err
 }
+
+// RawEdgeConfigItem is an Edge Config Item whose value has been left as undecoded JSON.
+// Unlike EdgeConfigItem, this can hold values of any JSON type.
+type RawEdgeConfigItem struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+// ListEdgeConfigItems retrieves all of the items within an Edge Config.
+func (c *Client) ListEdgeConfigItems(ctx context.Context, edgeConfigID, teamID string) (items []RawEdgeConfigItem, err error) {
+	url := fmt.Sprintf("%s/v1/edge-config/%s/items", c.baseURL, edgeConfigID)
+	if c.TeamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(teamID))
+	}
+
+	tflog.Info(ctx, "listing edge config items", map[string]any{
+		"url": url,
+	})
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+	}, &items)
+	return items, err
+}
+
+// RawEdgeConfigOperation is a change to a single Edge Config Item, whose value is already JSON encoded.
+type RawEdgeConfigOperation struct {
+	Operation string          `json:"operation"`
+	Key       string          `json:"key"`
+	Value     json.RawMessage `json:"value,omitempty"`
+}
+
+// UpdateEdgeConfigItems applies a number of changes to the items within an Edge Config. The changes are applied
+// together, so either all of them succeed or none of them do.
+func (c *Client) UpdateEdgeConfigItems(ctx context.Context, edgeConfigID, teamID string, operations []RawEdgeConfigOperation) error {
+	url := fmt.Sprintf("%s/v1/edge-config/%s/items", c.baseURL, edgeConfigID)
+	if c.TeamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(teamID))
+	}
+
+	payload := string(mustMarshal(
+		struct {
+			Items []RawEdgeConfigOperation `json:"items"`
+		}{
+			Items: operations,
+		},
+	))
+	tflog.Info(ctx, "updating edge config items", map[string]any{
+		"url":        url,
+		"operations": len(operations),
+	})
+	return c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "PATCH",
+		url:    url,
+		body:   payload,
+	}, nil)
+}
@@ -3,6 +3,8 @@ package client
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -198,6 +200,7 @@ func (c *Client) CreateEnvironmentVariables(ctx context.Context, request CreateE
 // UpdateEnvironmentVariableRequest defines the information that needs to be passed to Vercel in order to
 // update an environment variable.
 type UpdateEnvironmentVariableRequest struct {
+	Key                  string   `json:"key,omitempty"`
 	Value                string   `json:"value"`
 	Target               []string `json:"target"`
 	CustomEnvironmentIDs []string `json:"customEnvironmentIds,omitempty"`
@@ -249,11 +252,89 @@ func (c *Client) DeleteEnvironmentVariable(ctx context.Context, projectID, teamI
 	}, nil)
 }
 
+// GetEnvironmentVariables gets all the environment variables of a project.
 func (c *Client) GetEnvironmentVariables(ctx context.Context, projectID, teamID string) ([]EnvironmentVariable, error) {
-	url := fmt.Sprintf("%s/v8/projects/%s/env?decrypt=true", c.baseURL, projectID)
+	return c.ListEnvironmentVariables(ctx, projectID, teamID, EnvironmentVariableFilter{})
+}
+
+// These control how WaitForEnvironmentVariablesDeleted polls. The delay between checks starts at the interval and
+// doubles after each check, up to the max interval, until the timeout is reached.
+var (
+	environmentVariableDeletePollInterval    = 250 * time.Millisecond
+	environmentVariableDeletePollMaxInterval = 5 * time.Second
+	environmentVariableDeletePollTimeout     = 2 * time.Minute
+)
+
+// WaitForEnvironmentVariablesDeleted polls the environment variables of a project until none of the given IDs
+// are returned. Deletions take a moment to propagate, and creating a variable with the same key and targets
+// before then fails with a conflict.
+func (c *Client) WaitForEnvironmentVariablesDeleted(ctx context.Context, projectID, teamID string, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	deleted := map[string]bool{}
+	for _, id := range ids {
+		deleted[id] = true
+	}
+	deadline := time.Now().Add(environmentVariableDeletePollTimeout)
+	interval := environmentVariableDeletePollInterval
+	for {
+		envs, err := c.GetEnvironmentVariables(ctx, projectID, teamID)
+		if err != nil {
+			return fmt.Errorf("error getting environment variables: %w", err)
+		}
+		var remaining []string
+		for _, e := range envs {
+			if deleted[e.ID] {
+				remaining = append(remaining, fmt.Sprintf("%s (%s)", e.Key, e.ID))
+			}
+		}
+		if len(remaining) == 0 {
+			return nil
+		}
+		if time.Now().Add(interval).After(deadline) {
+			return fmt.Errorf("timed out waiting for environment variables to be deleted: %v", remaining)
+		}
+		tflog.Info(ctx, "waiting for environment variables to be deleted", map[string]any{
+			"project_id": projectID,
+			"remaining":  len(remaining),
+		})
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+		interval *= 2
+		if interval > environmentVariableDeletePollMaxInterval {
+			interval = environmentVariableDeletePollMaxInterval
+		}
+	}
+}
+
+// EnvironmentVariableFilter restricts the environment variables returned by ListEnvironmentVariables. Filtering is
+// done by the Vercel API, so that projects with many environment variables do not need to be downloaded in full.
+// Any filter that is not set is not applied.
+type EnvironmentVariableFilter struct {
+	// Target only returns environment variables that apply to the given target, e.g. `production`.
+	Target string
+	// CustomEnvironmentID only returns environment variables that apply to the given custom environment.
+	CustomEnvironmentID string
+}
+
+// ListEnvironmentVariables gets the environment variables of a project that match a filter.
+func (c *Client) ListEnvironmentVariables(ctx context.Context, projectID, teamID string, filter EnvironmentVariableFilter) ([]EnvironmentVariable, error) {
+	query := url.Values{}
+	query.Set("decrypt", "true")
+	if filter.Target != "" {
+		query.Set("target", filter.Target)
+	}
+	if filter.CustomEnvironmentID != "" {
+		query.Set("customEnvironmentId", filter.CustomEnvironmentID)
+	}
 	if c.TeamID(teamID) != "" {
-		url = fmt.Sprintf("%s&teamId=%s", url, c.TeamID(teamID))
+		query.Set("teamId", c.TeamID(teamID))
 	}
+	url := fmt.Sprintf("%s/v8/projects/%s/env?%s", c.baseURL, projectID, query.Encode())
 
 	envResponse := struct {
 		Env []EnvironmentVariable `json:"envs"`
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestListEnvironmentVariables(t *testing.T) {
+	type TestCase struct {
+		Name        string
+		Filter      EnvironmentVariableFilter
+		ExpectQuery string
+	}
+
+	for _, tc := range []TestCase{
+		{
+			Name:        "No filter",
+			ExpectQuery: "decrypt=true&teamId=team_123",
+		},
+		{
+			Name:        "Target",
+			Filter:      EnvironmentVariableFilter{Target: "production"},
+			ExpectQuery: "decrypt=true&target=production&teamId=team_123",
+		},
+		{
+			Name:        "Custom environment",
+			Filter:      EnvironmentVariableFilter{CustomEnvironmentID: "env_123"},
+			ExpectQuery: "customEnvironmentId=env_123&decrypt=true&teamId=team_123",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			var query string
+			h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query = r.URL.RawQuery
+				fmt.Fprintln(w, `{ "envs": [{ "id": "env_abc", "key": "FOO", "target": ["production"] }] }`)
+			}))
+			defer h.Close()
+			cl := New("INVALID")
+			cl.baseURL = fmt.Sprintf("http://%s", h.Listener.Addr().String())
+			envs, err := cl.ListEnvironmentVariables(context.Background(), "prj_123", "team_123", tc.Filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if query != tc.ExpectQuery {
+				t.Errorf("expected query %q, got %q", tc.ExpectQuery, query)
+			}
+			if len(envs) != 1 || envs[0].TeamID != "team_123" {
+				t.Errorf("unexpected environment variables %+v", envs)
+			}
+		})
+	}
+}
+
+func TestWaitForEnvironmentVariablesDeleted(t *testing.T) {
+	environmentVariableDeletePollInterval = time.Millisecond
+	environmentVariableDeletePollMaxInterval = 5 * time.Millisecond
+	environmentVariableDeletePollTimeout = time.Second
+
+	requests := 0
+	h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 3 {
+			fmt.Fprintln(w, `{ "envs": [{ "id": "env_abc", "key": "FOO" }, { "id": "env_def", "key": "BAR" }] }`)
+			return
+		}
+		fmt.Fprintln(w, `{ "envs": [{ "id": "env_def", "key": "BAR" }] }`)
+	}))
+	defer h.Close()
+	cl := New("INVALID")
+	cl.baseURL = fmt.Sprintf("http://%s", h.Listener.Addr().String())
+
+	err := cl.WaitForEnvironmentVariablesDeleted(context.Background(), "prj_123", "team_123", []string{"env_abc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+
+	err = cl.WaitForEnvironmentVariablesDeleted(context.Background(), "prj_123", "team_123", []string{"env_def"})
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("expected a timeout error, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = cl.WaitForEnvironmentVariablesDeleted(ctx, "prj_123", "team_123", []string{"env_def"})
+	if err == nil {
+		t.Error("expected an error when the context is cancelled")
+	}
+}
@@ -11,6 +11,20 @@ func NotFound(err error) bool {
 	return err != nil && errors.As(err, &apiErr) && apiErr.StatusCode == 404
 }
 
+// statusCode returns the status code of a failed request, whether or not the response was in the API's error format.
+// It returns 0 if no response was received.
+func statusCode(err error) int {
+	var apiErr APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	var unexpected UnexpectedResponseError
+	if errors.As(err, &unexpected) {
+		return unexpected.StatusCode
+	}
+	return 0
+}
+
 func noContent(err error) bool {
 	var apiErr APIError
 	return err != nil && errors.As(err, &apiErr) && apiErr.StatusCode == 204
@@ -0,0 +1,58 @@
+package client
+
+// Features are opt-in changes to the behaviour of the provider, configured through the `features` block of the
+// provider configuration. They allow new behaviour to be shipped without changing it for existing configurations.
+type Features struct {
+	EnvVars     EnvVarsFeatures
+	Deployments DeploymentsFeatures
+	Secrets     SecretsFeatures
+}
+
+// EnvVarsFeatures configures how environment variables are managed.
+type EnvVarsFeatures struct {
+	// DestructiveUpdates controls whether changed environment variables are deleted and re-created, rather than
+	// being updated in place.
+	DestructiveUpdates bool
+	// Parallelism is the number of environment variables that are deleted or updated at once.
+	Parallelism int
+}
+
+// DeploymentsFeatures configures how deployments are managed.
+type DeploymentsFeatures struct {
+	// WaitForReady controls whether creating a deployment waits for it to finish building and be aliased.
+	WaitForReady bool
+}
+
+// SecretsFeatures configures how secret values are managed.
+type SecretsFeatures struct {
+	// WriteOnly controls whether the write-only variants of secret attributes can be used, so that secrets are not
+	// stored in state.
+	WriteOnly bool
+}
+
+// DefaultFeatures returns the behaviour of the provider when no features are configured.
+func DefaultFeatures() Features {
+	return Features{
+		EnvVars: EnvVarsFeatures{
+			DestructiveUpdates: true,
+			Parallelism:        10,
+		},
+		Deployments: DeploymentsFeatures{
+			WaitForReady: true,
+		},
+	}
+}
+
+// WithFeatures sets the features that have been configured for the provider.
+func (c *Client) WithFeatures(features Features) *Client {
+	c.features = &features
+	return c
+}
+
+// Features returns the features that have been configured for the provider, or the defaults if none have been.
+func (c *Client) Features() Features {
+	if c.features == nil {
+		return DefaultFeatures()
+	}
+	return *c.features
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -17,29 +18,97 @@ type CreateFileRequest struct {
 	TeamID   string
 }
 
+// fileUploadAttempts is the number of times a file upload is attempted when it fails with a transient error.
+const fileUploadAttempts = 3
+
+// fileUploadBackoff is how long to wait before the second attempt at uploading a file. Later attempts wait longer.
+var fileUploadBackoff = time.Second
+
+// FileUploadError describes a file that could not be uploaded to Vercel.
+type FileUploadError struct {
+	Filename string
+	Size     int
+	// StatusCode is the HTTP status code of the last attempt, or 0 if no response was received.
+	StatusCode int
+	Attempts   int
+	Err        error
+}
+
+// Error gives the FileUploadError a user friendly error message.
+func (e FileUploadError) Error() string {
+	status := "no response"
+	if e.StatusCode != 0 {
+		status = fmt.Sprintf("status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("%s (%d bytes): %s after %d attempt(s): %s", e.Filename, e.Size, status, e.Attempts, e.Err)
+}
+
+func (e FileUploadError) Unwrap() error {
+	return e.Err
+}
+
+// transientUploadError detects the errors that the Vercel API documents as safe to retry when uploading a file.
+func transientUploadError(err error) bool {
+	switch statusCode(err) {
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
 // CreateFile will upload a file to Vercel so that it can be later used for a Deployment.
+// Uploads that fail with a transient server error are retried. If the upload still fails, a FileUploadError is returned.
 func (c *Client) CreateFile(ctx context.Context, request CreateFileRequest) error {
 	url := fmt.Sprintf("%s/v2/now/files", c.baseURL)
 	if c.TeamID(request.TeamID) != "" {
 		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(request.TeamID))
 	}
-	req, err := http.NewRequestWithContext(
-		ctx,
-		"POST",
-		url,
-		strings.NewReader(request.Content),
-	)
-	if err != nil {
-		return err
-	}
 
-	req.Header.Add("x-vercel-digest", request.SHA)
-	req.Header.Set("Content-Type", "application/octet-stream")
+	var err error
+	attempt := 1
+	for ; attempt <= fileUploadAttempts; attempt++ {
+		var req *http.Request
+		req, err = http.NewRequestWithContext(
+			ctx,
+			"POST",
+			url,
+			strings.NewReader(request.Content),
+		)
+		if err != nil {
+			return err
+		}
 
-	tflog.Info(ctx, "uploading file", map[string]any{
-		"url": url,
-		"sha": request.SHA,
-	})
-	err = c._doRequest(req, nil, false)
-	return err
+		req.Header.Add("x-vercel-digest", request.SHA)
+		req.Header.Set("Content-Type", "application/octet-stream")
+
+		tflog.Info(ctx, "uploading file", map[string]any{
+			"url":     url,
+			"sha":     request.SHA,
+			"attempt": attempt,
+		})
+		err = c._doRequest(req, nil, false)
+		if err == nil {
+			return nil
+		}
+		if !transientUploadError(err) || attempt == fileUploadAttempts {
+			break
+		}
+		tflog.Warn(ctx, "retrying file upload after transient error", map[string]any{
+			"file":  request.Filename,
+			"error": err.Error(),
+		})
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(attempt) * fileUploadBackoff):
+		}
+	}
+
+	return FileUploadError{
+		Filename:   request.Filename,
+		Size:       len(request.Content),
+		StatusCode: statusCode(err),
+		Attempts:   attempt,
+		Err:        err,
+	}
 }
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCreateFile(t *testing.T) {
+	fileUploadBackoff = time.Millisecond
+	type TestCase struct {
+		Name           string
+		Statuses       []int
+		ExpectAttempts int
+		ExpectStatus   int
+	}
+
+	for _, tc := range []TestCase{
+		{
+			Name:           "Success",
+			Statuses:       []int{http.StatusOK},
+			ExpectAttempts: 1,
+		},
+		{
+			Name:           "Transient error is retried",
+			Statuses:       []int{http.StatusBadGateway, http.StatusOK},
+			ExpectAttempts: 2,
+		},
+		{
+			Name:           "Transient error is retried until attempts run out",
+			Statuses:       []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+			ExpectAttempts: 3,
+			ExpectStatus:   http.StatusServiceUnavailable,
+		},
+		{
+			Name:           "Client error is not retried",
+			Statuses:       []int{http.StatusBadRequest},
+			ExpectAttempts: 1,
+			ExpectStatus:   http.StatusBadRequest,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			attempts := 0
+			h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				status := tc.Statuses[attempts]
+				attempts++
+				w.WriteHeader(status)
+				if status >= 300 {
+					fmt.Fprintln(w, "upstream error")
+					return
+				}
+				fmt.Fprintln(w, "{}")
+			}))
+			defer h.Close()
+			cl := New("INVALID")
+			cl.baseURL = fmt.Sprintf("http://%s", h.Listener.Addr().String())
+			err := cl.CreateFile(context.Background(), CreateFileRequest{
+				Filename: "index.html",
+				SHA:      "abc",
+				Content:  "hello",
+			})
+			if attempts != tc.ExpectAttempts {
+				t.Errorf("expected %d attempts, got %d", tc.ExpectAttempts, attempts)
+			}
+			if tc.ExpectStatus == 0 {
+				if err != nil {
+					t.Error(err)
+				}
+				return
+			}
+			var uploadErr FileUploadError
+			if !errors.As(err, &uploadErr) {
+				t.Fatalf("expected a FileUploadError, got %v", err)
+			}
+			if uploadErr.StatusCode != tc.ExpectStatus || uploadErr.Filename != "index.html" || uploadErr.Size != 5 {
+				t.Errorf("unexpected upload error %+v", uploadErr)
+			}
+		})
+	}
+}
+
+func TestCreateFileProxyNotFound(t *testing.T) {
+	h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintln(w, "<html><body>Not Found</body></html>")
+	}))
+	defer h.Close()
+	cl := New("INVALID")
+	cl.baseURL = fmt.Sprintf("http://%s", h.Listener.Addr().String())
+	err := cl.CreateFile(context.Background(), CreateFileRequest{
+		Filename: "index.html",
+		SHA:      "abc",
+		Content:  "hello",
+	})
+	var uploadErr FileUploadError
+	if !errors.As(err, &uploadErr) || uploadErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected a FileUploadError with status code 404, got %v", err)
+	}
+	if NotFound(err) {
+		t.Errorf("expected a 404 that is not in the API's error format not to be treated as not found")
+	}
+}
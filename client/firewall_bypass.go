@@ -3,12 +3,16 @@ package client
 import (
 	"context"
 	"fmt"
+	"time"
 )
 
 type FirewallBypassRule struct {
 	Domain       string `json:"domain,omitempty"`
 	SourceIp     string `json:"sourceIp"`
 	ProjectScope bool   `json:"projectScope,omitempty"`
+	// TTL is the lifetime of the rule in milliseconds. Rules without a TTL
+	// never expire.
+	TTL int64 `json:"ttl,omitempty"`
 }
 
 type FirewallBypass struct {
@@ -17,6 +21,12 @@ type FirewallBypass struct {
 	Domain        string `json:"Domain"`
 	Ip            string `json:"Ip"`
 	IsProjectRule bool   `json:"IsProjectRule"`
+	ExpiresAt     *int64 `json:"ExpiresAt"`
+}
+
+// Expired returns true if the bypass rule had a TTL that has now elapsed.
+func (b FirewallBypass) Expired(now time.Time) bool {
+	return b.ExpiresAt != nil && *b.ExpiresAt <= now.UnixMilli()
 }
 
 func (c *Client) GetFirewallBypass(ctx context.Context, teamID, projectID string, request FirewallBypassRule) (a FirewallBypass, err error) {
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// FirewallEvent is an aggregated count of requests that the firewall took an action on.
+type FirewallEvent struct {
+	StartTime  string `json:"startTime"`
+	EndTime    string `json:"endTime"`
+	IsActive   bool   `json:"isActive"`
+	ActionType string `json:"action_type"`
+	Host       string `json:"host"`
+	PublicIP   string `json:"public_ip"`
+	Count      int64  `json:"count"`
+	// RuleID is the ID of the custom rule that triggered the action. It is empty for
+	// actions taken by managed rulesets, IP blocking, or system mitigations.
+	RuleID string `json:"ruleId"`
+}
+
+type GetFirewallEventsRequest struct {
+	ProjectID string
+	TeamID    string
+	Start     time.Time
+	End       time.Time
+}
+
+// GetFirewallEvents returns the actions taken by the firewall of a project within a time window.
+func (c *Client) GetFirewallEvents(ctx context.Context, request GetFirewallEventsRequest) ([]FirewallEvent, error) {
+	url := fmt.Sprintf(
+		"%s/v1/security/firewall/events?projectId=%s&startTimestamp=%d&endTimestamp=%d",
+		c.baseURL,
+		request.ProjectID,
+		request.Start.UnixMilli(),
+		request.End.UnixMilli(),
+	)
+	if c.TeamID(request.TeamID) != "" {
+		url = fmt.Sprintf("%s&teamId=%s", url, c.TeamID(request.TeamID))
+	}
+	tflog.Info(ctx, "getting firewall events", map[string]any{
+		"url": url,
+	})
+	var res struct {
+		Actions []FirewallEvent `json:"actions"`
+	}
+	err := c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+	}, &res)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get firewall events: %w", err)
+	}
+	return res.Actions, nil
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// defaultMaintenanceMaxWait is how long requests are retried for while Vercel is undergoing maintenance, if no other
+// time has been configured.
+const defaultMaintenanceMaxWait = 15 * time.Minute
+
+var (
+	// maintenanceRetryInterval is the initial time to wait before retrying a request during maintenance.
+	maintenanceRetryInterval = 10 * time.Second
+	// maintenanceMaxRetryInterval caps the time between retries during maintenance.
+	maintenanceMaxRetryInterval = 2 * time.Minute
+)
+
+// WithMaintenanceMaxWait sets how long requests are retried for while Vercel is undergoing maintenance. A wait of
+// zero disables retrying.
+func (c *Client) WithMaintenanceMaxWait(wait time.Duration) *Client {
+	c.maintenanceMaxWait = &wait
+	return c
+}
+
+func (c *Client) maxMaintenanceWait() time.Duration {
+	if c.maintenanceMaxWait == nil {
+		return defaultMaintenanceMaxWait
+	}
+	return *c.maintenanceMaxWait
+}
+
+// MaintenanceError is returned when the Vercel API is still undergoing maintenance once the maximum time to wait for
+// it has passed.
+type MaintenanceError struct {
+	Waited time.Duration
+	Err    error
+}
+
+func (e MaintenanceError) Error() string {
+	return fmt.Sprintf(
+		"Vercel maintenance in progress: the API was still unavailable after waiting %s. Please try again once the maintenance has finished, see https://www.vercel-status.com for details: %s",
+		e.Waited.Round(time.Second),
+		e.Err,
+	)
+}
+
+func (e MaintenanceError) Unwrap() error {
+	return e.Err
+}
+
+// isMaintenance detects if an error returned by the Vercel API was the result of the platform undergoing maintenance.
+// This is reported as a 503, either in the API's error format or as a maintenance page.
+func isMaintenance(err error) bool {
+	var apiErr APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == 503 {
+		return apiErr.Code == "maintenance" ||
+			strings.Contains(strings.ToLower(apiErr.Message), "maintenance") ||
+			strings.Contains(strings.ToLower(string(apiErr.RawMessage)), "maintenance")
+	}
+	var unexpected UnexpectedResponseError
+	return errors.As(err, &unexpected) && unexpected.StatusCode == 503 &&
+		strings.Contains(strings.ToLower(string(unexpected.Body)), "maintenance")
+}
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMaintenanceRetry(t *testing.T) {
+	defer func(interval time.Duration) { maintenanceRetryInterval = interval }(maintenanceRetryInterval)
+	maintenanceRetryInterval = time.Millisecond
+
+	type TestCase struct {
+		Name                string
+		MaintenanceRequests int
+		MaxWait             time.Duration
+		ExpectMaintenance   bool
+	}
+
+	for _, tc := range []TestCase{
+		{
+			Name:                "Maintenance finishes",
+			MaintenanceRequests: 2,
+			MaxWait:             time.Minute,
+		},
+		{
+			Name:                "Maintenance continues",
+			MaintenanceRequests: 1000,
+			MaxWait:             20 * time.Millisecond,
+			ExpectMaintenance:   true,
+		},
+		{
+			Name:                "Retrying disabled",
+			MaintenanceRequests: 1,
+			ExpectMaintenance:   true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			requests := 0
+			h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				requests++
+				if requests <= tc.MaintenanceRequests {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					fmt.Fprintln(w, "<html><body>Vercel is undergoing scheduled maintenance</body></html>")
+					return
+				}
+				fmt.Fprintln(w, `{ "id": "team_123" }`)
+			}))
+			defer h.Close()
+			cl := New("INVALID").WithMaintenanceMaxWait(tc.MaxWait)
+			cl.baseURL = fmt.Sprintf("http://%s", h.Listener.Addr().String())
+			_, err := cl.GetTeam(context.Background(), "INVALID")
+
+			var maintenanceErr MaintenanceError
+			if tc.ExpectMaintenance != errors.As(err, &maintenanceErr) {
+				t.Fatalf("expected maintenance error: %t, got: %v", tc.ExpectMaintenance, err)
+			}
+			if !tc.ExpectMaintenance && err != nil {
+				t.Fatal(err)
+			}
+			if tc.ExpectMaintenance && statusCode(maintenanceErr.Err) != http.StatusServiceUnavailable {
+				t.Errorf("expected the underlying error to have status code 503, got %d", statusCode(maintenanceErr.Err))
+			}
+		})
+	}
+}
+
+func TestIsMaintenance(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Err      error
+		Expected bool
+	}{
+		{Name: "Maintenance code", Err: APIError{StatusCode: 503, Code: "maintenance"}, Expected: true},
+		{Name: "Maintenance page", Err: UnexpectedResponseError{StatusCode: 503, Body: []byte("Scheduled Maintenance")}, Expected: true},
+		{Name: "Other 503", Err: APIError{StatusCode: 503, Message: "upstream unavailable"}},
+		{Name: "Not a 503", Err: APIError{StatusCode: 500, Message: "maintenance"}},
+		{Name: "Not an API error", Err: errors.New("maintenance")},
+		{Name: "No error"},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			if got := isMaintenance(tc.Err); got != tc.Expected {
+				t.Errorf("expected %t, got %t", tc.Expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package client
+
+import (
+	"expvar"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Metrics about the requests made to the Vercel API, exposed via expvar. These are only served if the provider is
+// started with a debug listener, which is useful to see what a long-running apply is waiting on.
+var (
+	requestsTotal    = expvar.NewMap("vercel_api_requests_total")
+	requestErrors    = expvar.NewMap("vercel_api_request_errors_total")
+	requestsInFlight = expvar.NewInt("vercel_api_requests_in_flight")
+
+	inFlightMu sync.Mutex
+	inFlight   = map[*inFlightRequest]struct{}{}
+)
+
+func init() {
+	expvar.Publish("vercel_api_requests_in_flight_detail", expvar.Func(inFlightRequests))
+}
+
+type inFlightRequest struct {
+	Method  string `json:"method"`
+	Path    string `json:"path"`
+	Started string `json:"started"`
+	Elapsed string `json:"elapsed"`
+	start   time.Time
+}
+
+// trackRequest records an API request as being in flight. The returned function must be called with the result of
+// the request once it has finished.
+func trackRequest(method, path string) func(statusCode int, err error) {
+	r := &inFlightRequest{
+		Method: method,
+		Path:   path,
+		start:  time.Now(),
+	}
+	requestsTotal.Add(method, 1)
+	requestsInFlight.Add(1)
+	inFlightMu.Lock()
+	inFlight[r] = struct{}{}
+	inFlightMu.Unlock()
+
+	return func(statusCode int, err error) {
+		requestsInFlight.Add(-1)
+		inFlightMu.Lock()
+		delete(inFlight, r)
+		inFlightMu.Unlock()
+		switch {
+		case err != nil:
+			requestErrors.Add("transport", 1)
+		case statusCode >= 300:
+			requestErrors.Add(fmt.Sprintf("%dxx", statusCode/100), 1)
+		}
+	}
+}
+
+// inFlightRequests lists the requests currently in flight, oldest first.
+func inFlightRequests() any {
+	inFlightMu.Lock()
+	requests := make([]inFlightRequest, 0, len(inFlight))
+	for r := range inFlight {
+		requests = append(requests, *r)
+	}
+	inFlightMu.Unlock()
+
+	sort.Slice(requests, func(i, j int) bool {
+		return requests[i].start.Before(requests[j].start)
+	})
+	for i := range requests {
+		requests[i].Started = requests[i].start.UTC().Format(time.RFC3339)
+		requests[i].Elapsed = time.Since(requests[i].start).Round(time.Millisecond).String()
+	}
+	return requests
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// progressInterval is how often progress is logged for long-running operations.
+const progressInterval = 30 * time.Second
+
+// Progress reports on the progress of a long-running operation, such as uploading files or waiting for a
+// deployment to build. Progress is logged at most every 30 seconds, along with the elapsed time, so that long
+// applies can be told apart from ones that are stuck.
+type Progress struct {
+	operation string
+	start     time.Time
+	lastLog   time.Time
+}
+
+// NewProgress starts tracking the progress of an operation.
+func NewProgress(operation string) *Progress {
+	now := time.Now()
+	return &Progress{
+		operation: operation,
+		start:     now,
+		lastLog:   now,
+	}
+}
+
+// Step records the current step of the operation, logging it if enough time has passed since progress was last
+// logged.
+func (p *Progress) Step(ctx context.Context, step string, fields map[string]any) {
+	if time.Since(p.lastLog) < progressInterval {
+		return
+	}
+	p.log(ctx, step, fields)
+}
+
+// Done logs that the operation has completed, if it took long enough for progress to have been logged.
+func (p *Progress) Done(ctx context.Context) {
+	if time.Since(p.start) < progressInterval {
+		return
+	}
+	p.log(ctx, "done", nil)
+}
+
+func (p *Progress) log(ctx context.Context, step string, fields map[string]any) {
+	p.lastLog = time.Now()
+	f := map[string]any{
+		"operation": p.operation,
+		"step":      step,
+		"elapsed":   time.Since(p.start).Round(time.Second).String(),
+	}
+	for k, v := range fields {
+		f[k] = v
+	}
+	tflog.Info(ctx, p.operation+": "+step, f)
+}
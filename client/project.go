@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -33,6 +34,10 @@ type EnvironmentVariable struct {
 	TeamID               string   `json:"-"`
 	Comment              string   `json:"comment"`
 	Decrypted            *bool    `json:"decrypted"`
+	CreatedAt            int64    `json:"createdAt,omitempty"`
+	UpdatedAt            int64    `json:"updatedAt,omitempty"`
+	CreatedBy            string   `json:"createdBy,omitempty"`
+	UpdatedBy            string   `json:"updatedBy,omitempty"`
 }
 
 type DeploymentExpiration struct {
@@ -65,7 +70,7 @@ type CreateProjectRequest struct {
 
 // CreateProject will create a project within Vercel.
 func (c *Client) CreateProject(ctx context.Context, teamID string, request CreateProjectRequest) (r ProjectResponse, err error) {
-	url := fmt.Sprintf("%s/v8/projects", c.baseURL)
+	url := fmt.Sprintf("%s/v11/projects", c.baseURL)
 	if c.TeamID(teamID) != "" {
 		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(teamID))
 	}
@@ -91,7 +96,7 @@ func (c *Client) CreateProject(ctx context.Context, teamID string, request Creat
 // DeleteProject deletes a project within Vercel. Note that there is no need to explicitly
 // remove every environment variable, as these cease to exist when a project is removed.
 func (c *Client) DeleteProject(ctx context.Context, projectID, teamID string) error {
-	url := fmt.Sprintf("%s/v8/projects/%s", c.baseURL, projectID)
+	url := fmt.Sprintf("%s/v9/projects/%s", c.baseURL, projectID)
 	if c.TeamID(teamID) != "" {
 		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(teamID))
 	}
@@ -192,6 +197,7 @@ type ProjectResponse struct {
 	OIDCTokenConfig                      *OIDCTokenConfig            `json:"oidcTokenConfig"`
 	OptionsAllowlist                     *OptionsAllowlist           `json:"optionsAllowlist"`
 	ProtectionBypass                     map[string]ProtectionBypass `json:"protectionBypass"`
+	RollingRelease                       *RollingRelease             `json:"rollingRelease"`
 	AutoExposeSystemEnvVars              *bool                       `json:"autoExposeSystemEnvs"`
 	EnablePreviewFeedback                *bool                       `json:"enablePreviewFeedback"`
 	EnableProductionFeedback             *bool                       `json:"enableProductionFeedback"`
@@ -203,13 +209,48 @@ type ProjectResponse struct {
 	GitForkProtection                    bool                        `json:"gitForkProtection"`
 	ProductionDeploymentsFastLane        bool                        `json:"productionDeploymentsFastLane"`
 	DirectoryListing                     bool                        `json:"directoryListing"`
+	ProtectedSourcemaps                  *bool                       `json:"protectedSourcemaps"`
+	AutoJobCancelation                   *bool                       `json:"autoJobCancelation"`
+	RelatedProjects                      []string                    `json:"relatedProjects"`
 	SkewProtectionMaxAge                 int                         `json:"skewProtectionMaxAge"`
 	GitComments                          *GitComments                `json:"gitComments"`
+	GitProviderOptions                   *GitProviderOptions         `json:"gitProviderOptions"`
 	Security                             *Security                   `json:"security"`
 	DeploymentExpiration                 *DeploymentExpiration       `json:"deploymentExpiration"`
 	ResourceConfig                       *ResourceConfigResponse     `json:"resourceConfig"`
 	NodeVersion                          string                      `json:"nodeVersion"`
 	Crons                                *ProjectCronsResponse       `json:"crons"`
+	Targets                              *ProjectTargets             `json:"targets"`
+	DeletedAt                            *int64                      `json:"deletedAt"`
+}
+
+// PendingDeletion returns whether the project has been deleted, but is still being removed by Vercel. Projects in
+// this state are still returned by the API for a short time, while their dependent resources are removed.
+func (p ProjectResponse) PendingDeletion() bool {
+	return p.DeletedAt != nil
+}
+
+// ProjectTargets contains the deployments currently assigned to each of the project's environments.
+type ProjectTargets struct {
+	Production *ProjectTargetDeployment `json:"production"`
+}
+
+// ProjectTargetDeployment is a summary of the deployment assigned to a project environment.
+type ProjectTargetDeployment struct {
+	ID        string            `json:"id"`
+	URL       string            `json:"url"`
+	CreatedAt int64             `json:"createdAt"`
+	Meta      map[string]string `json:"meta"`
+}
+
+// CommitSHA returns the SHA of the commit the deployment was built from, if it was created from a Git provider.
+func (d *ProjectTargetDeployment) CommitSHA() string {
+	for _, k := range []string{"githubCommitSha", "gitlabCommitSha", "bitbucketCommitSha"} {
+		if sha := d.Meta[k]; sha != "" {
+			return sha
+		}
+	}
+	return ""
 }
 
 type ProjectCronsResponse struct {
@@ -222,24 +263,34 @@ type GitComments struct {
 	OnPullRequest bool `json:"onPullRequest"`
 }
 
+// GitProviderOptions controls how Vercel interacts with the connected Git provider.
+// CreateDeployments is either "enabled" or "disabled".
+type GitProviderOptions struct {
+	CreateDeployments string `json:"createDeployments"`
+}
+
 type Security struct {
 	AttackModeEnabled bool `json:"attackModeEnabled"`
 }
 
 type ResourceConfigResponse struct {
-	FunctionDefaultMemoryType *string `json:"functionDefaultMemoryType"`
-	FunctionDefaultTimeout    *int64  `json:"functionDefaultTimeout"`
-	Fluid                     bool    `json:"fluid"`
-	ElasticConcurrencyEnabled bool    `json:"elasticConcurrencyEnabled"`
-	BuildMachineType          string  `json:"buildMachineType"`
+	FunctionDefaultMemoryType  *string  `json:"functionDefaultMemoryType"`
+	FunctionDefaultTimeout     *int64   `json:"functionDefaultTimeout"`
+	FunctionDefaultRegions     []string `json:"functionDefaultRegions"`
+	FunctionZeroConfigFailover *bool    `json:"functionZeroConfigFailover"`
+	Fluid                      bool     `json:"fluid"`
+	ElasticConcurrencyEnabled  bool     `json:"elasticConcurrencyEnabled"`
+	BuildMachineType           string   `json:"buildMachineType"`
 }
 
 type ResourceConfig struct {
-	FunctionDefaultMemoryType *string `json:"functionDefaultMemoryType,omitempty"`
-	FunctionDefaultTimeout    *int64  `json:"functionDefaultTimeout,omitempty"`
-	Fluid                     *bool   `json:"fluid,omitempty"`
-	ElasticConcurrencyEnabled *bool   `json:"elasticConcurrencyEnabled,omitempty"`
-	BuildMachineType          *string `json:"buildMachineType,omitempty"`
+	FunctionDefaultMemoryType  *string  `json:"functionDefaultMemoryType,omitempty"`
+	FunctionDefaultTimeout     *int64   `json:"functionDefaultTimeout,omitempty"`
+	FunctionDefaultRegions     []string `json:"functionDefaultRegions,omitempty"`
+	FunctionZeroConfigFailover *bool    `json:"functionZeroConfigFailover,omitempty"`
+	Fluid                      *bool    `json:"fluid,omitempty"`
+	ElasticConcurrencyEnabled  *bool    `json:"elasticConcurrencyEnabled,omitempty"`
+	BuildMachineType           *string  `json:"buildMachineType,omitempty"`
 }
 
 // GetProject retrieves information about an existing project from Vercel.
@@ -265,9 +316,31 @@ func (c *Client) GetProject(ctx context.Context, projectID, teamID string) (r Pr
 	return r, err
 }
 
+// GetProjectFields retrieves a project as the raw fields returned by the Vercel API. This includes fields that
+// ProjectResponse does not decode, so that they can be reported on.
+func (c *Client) GetProjectFields(ctx context.Context, projectID, teamID string) (r map[string]json.RawMessage, err error) {
+	url := fmt.Sprintf("%s/v10/projects/%s", c.baseURL, projectID)
+	if c.TeamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(teamID))
+	}
+	tflog.Info(ctx, "getting project fields", map[string]any{
+		"url": url,
+	})
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+		body:   "",
+	}, &r)
+	if err != nil {
+		return r, fmt.Errorf("unable to get project: %w", err)
+	}
+	return r, nil
+}
+
 // ListProjects lists the top 100 projects (no pagination) from within Vercel.
 func (c *Client) ListProjects(ctx context.Context, teamID string) (r []ProjectResponse, err error) {
-	url := fmt.Sprintf("%s/v8/projects?limit=100", c.baseURL)
+	url := fmt.Sprintf("%s/v10/projects?limit=100", c.baseURL)
 	if c.TeamID(teamID) != "" {
 		url = fmt.Sprintf("%s&teamId=%s", url, c.TeamID(teamID))
 	}
@@ -290,6 +363,49 @@ func (c *Client) ListProjects(ctx context.Context, teamID string) (r []ProjectRe
 	return pr.Projects, err
 }
 
+// ListAllProjects lists every project in a team, following the pagination of the API. Use ListProjects where only
+// the first page is needed.
+func (c *Client) ListAllProjects(ctx context.Context, teamID string) ([]ProjectResponse, error) {
+	var projects []ProjectResponse
+	until := ""
+	for {
+		url := fmt.Sprintf("%s/v10/projects?limit=100", c.baseURL)
+		if c.TeamID(teamID) != "" {
+			url = fmt.Sprintf("%s&teamId=%s", url, c.TeamID(teamID))
+		}
+		if until != "" {
+			url = fmt.Sprintf("%s&until=%s", url, until)
+		}
+		tflog.Info(ctx, "listing projects", map[string]any{
+			"url": url,
+		})
+
+		var response struct {
+			Projects   []ProjectResponse `json:"projects"`
+			Pagination struct {
+				Next *json.Number `json:"next"`
+			} `json:"pagination"`
+		}
+		err := c.doRequest(clientRequest{
+			ctx:    ctx,
+			method: "GET",
+			url:    url,
+			body:   "",
+		}, &response)
+		if err != nil {
+			return nil, err
+		}
+		for i := range response.Projects {
+			response.Projects[i].TeamID = c.TeamID(teamID)
+		}
+		projects = append(projects, response.Projects...)
+		if response.Pagination.Next == nil || response.Pagination.Next.String() == until {
+			return projects, nil
+		}
+		until = response.Pagination.Next.String()
+	}
+}
+
 // UpdateProjectRequest defines the possible fields that can be updated within a vercel project.
 // note that the values are all pointers, with many containing `omitempty` for serialisation.
 // This is because the Vercel API behaves in the following manner:
@@ -323,8 +439,12 @@ type UpdateProjectRequest struct {
 	GitForkProtection                    bool                            `json:"gitForkProtection"`
 	ProductionDeploymentsFastLane        bool                            `json:"productionDeploymentsFastLane"`
 	DirectoryListing                     bool                            `json:"directoryListing"`
+	ProtectedSourcemaps                  *bool                           `json:"protectedSourcemaps,omitempty"`
+	AutoJobCancelation                   *bool                           `json:"autoJobCancelation,omitempty"`
+	RelatedProjects                      *[]string                       `json:"relatedProjects,omitempty"`
 	SkewProtectionMaxAge                 int                             `json:"skewProtectionMaxAge"`
 	GitComments                          *GitComments                    `json:"gitComments"`
+	GitProviderOptions                   *GitProviderOptions             `json:"gitProviderOptions,omitempty"`
 	ResourceConfig                       *ResourceConfig                 `json:"resourceConfig,omitempty"`
 	NodeVersion                          string                          `json:"nodeVersion,omitempty"`
 }
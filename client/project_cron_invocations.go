@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// CronInvocation is a single run of a cron job defined in a project's vercel.json.
+type CronInvocation struct {
+	ID           string `json:"id"`
+	Path         string `json:"path"`
+	Schedule     string `json:"schedule"`
+	DeploymentID string `json:"deploymentId"`
+	// Status is one of "succeeded", "failed" or "timed_out".
+	Status     string `json:"status"`
+	StatusCode int64  `json:"statusCode"`
+	StartedAt  int64  `json:"startedAt"`
+	Duration   int64  `json:"duration"`
+}
+
+type ListCronInvocationsRequest struct {
+	ProjectID string
+	TeamID    string
+	Since     time.Time
+}
+
+// ListCronInvocations lists the 100 most recent cron invocations (no pagination) of a project since a
+// point in time, most recent first.
+func (c *Client) ListCronInvocations(ctx context.Context, request ListCronInvocationsRequest) ([]CronInvocation, error) {
+	url := fmt.Sprintf(
+		"%s/v1/projects/%s/crons/invocations?since=%d&limit=100",
+		c.baseURL,
+		request.ProjectID,
+		request.Since.UnixMilli(),
+	)
+	if c.TeamID(request.TeamID) != "" {
+		url = fmt.Sprintf("%s&teamId=%s", url, c.TeamID(request.TeamID))
+	}
+
+	tflog.Info(ctx, "listing cron invocations", map[string]any{
+		"url": url,
+	})
+	var res struct {
+		Invocations []CronInvocation `json:"invocations"`
+	}
+	err := c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+	}, &res)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list cron invocations: %w", err)
+	}
+	return res.Invocations, nil
+}
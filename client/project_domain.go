@@ -69,6 +69,18 @@ type ProjectDomainResponse struct {
 	RedirectStatusCode  *int64  `json:"redirectStatusCode"`
 	GitBranch           *string `json:"gitBranch"`
 	CustomEnvironmentID *string `json:"customEnvironmentId"`
+	// Verified is false until Vercel has confirmed ownership of the domain. Until then,
+	// Verification lists the DNS records that need adding.
+	Verified     bool                 `json:"verified"`
+	Verification []DomainVerification `json:"verification"`
+}
+
+// DomainVerification is a DNS record that needs adding to prove ownership of a domain.
+type DomainVerification struct {
+	Type   string `json:"type"`
+	Domain string `json:"domain"`
+	Value  string `json:"value"`
+	Reason string `json:"reason"`
 }
 
 // GetProjectDomain retrieves information about a project domain from Vercel.
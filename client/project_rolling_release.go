@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RollingReleaseStage is a single stage of a rolling release. Duration is the number of minutes before the
+// stage automatically advances, and is only used if approval is not required.
+type RollingReleaseStage struct {
+	TargetPercentage int64  `json:"targetPercentage"`
+	Duration         *int64 `json:"duration,omitempty"`
+	RequireApproval  bool   `json:"requireApproval"`
+}
+
+// RollingRelease configures production deployments of a project to be gradually rolled out, by sending an
+// increasing percentage of traffic to them in stages. The final stage always targets 100% of traffic.
+type RollingRelease struct {
+	Target string                `json:"target"`
+	Stages []RollingReleaseStage `json:"stages"`
+}
+
+type UpdateRollingReleaseRequest struct {
+	TeamID         string
+	ProjectID      string
+	RollingRelease RollingRelease
+}
+
+// UpdateRollingRelease enables or updates the rolling release configuration of a project.
+func (c *Client) UpdateRollingRelease(ctx context.Context, request UpdateRollingReleaseRequest) (rr RollingRelease, err error) {
+	url := fmt.Sprintf("%s/v1/projects/%s/rolling-release/config", c.baseURL, request.ProjectID)
+	if c.TeamID(request.TeamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(request.TeamID))
+	}
+
+	payload := string(mustMarshal(struct {
+		RollingRelease RollingRelease `json:"rollingRelease"`
+	}{
+		RollingRelease: request.RollingRelease,
+	}))
+	tflog.Info(ctx, "updating rolling release", map[string]any{
+		"url":     url,
+		"payload": payload,
+	})
+	var response struct {
+		RollingRelease RollingRelease `json:"rollingRelease"`
+	}
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "PATCH",
+		url:    url,
+		body:   payload,
+	}, &response)
+	return response.RollingRelease, err
+}
+
+// DeleteRollingRelease disables rolling releases for a project, so production deployments receive all traffic
+// as soon as they are promoted.
+func (c *Client) DeleteRollingRelease(ctx context.Context, projectID, teamID string) error {
+	url := fmt.Sprintf("%s/v1/projects/%s/rolling-release/config", c.baseURL, projectID)
+	if c.TeamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(teamID))
+	}
+
+	tflog.Info(ctx, "deleting rolling release", map[string]any{
+		"url": url,
+	})
+	return c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "DELETE",
+		url:    url,
+		body:   "",
+	}, nil)
+}
@@ -0,0 +1,147 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ProjectRouteTransformTarget identifies what a route transform applies to.
+type ProjectRouteTransformTarget struct {
+	Key string `json:"key"`
+}
+
+// ProjectRouteTransform modifies a request or response as it passes through a route.
+type ProjectRouteTransform struct {
+	// Type is one of "request.headers", "request.query" or "response.headers".
+	Type string `json:"type"`
+	// Op is one of "set", "append" or "delete".
+	Op     string                      `json:"op"`
+	Target ProjectRouteTransformTarget `json:"target"`
+	Args   string                      `json:"args,omitempty"`
+}
+
+// ProjectRouteDefinition is the routing behaviour of a project route. It uses the same
+// format as the `routes` property of vercel.json.
+type ProjectRouteDefinition struct {
+	Src        string                  `json:"src"`
+	Dest       string                  `json:"dest,omitempty"`
+	Status     int64                   `json:"status,omitempty"`
+	Headers    map[string]string       `json:"headers,omitempty"`
+	Transforms []ProjectRouteTransform `json:"transforms,omitempty"`
+	Continue   bool                    `json:"continue,omitempty"`
+}
+
+// ProjectRoute is a routing rule that is managed through the API rather than through vercel.json.
+type ProjectRoute struct {
+	ID          string                 `json:"id,omitempty"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Enabled     bool                   `json:"enabled"`
+	Route       ProjectRouteDefinition `json:"route"`
+	ProjectID   string                 `json:"-"`
+	TeamID      string                 `json:"-"`
+}
+
+func (c *Client) projectRoutesURL(projectID, teamID, routeID string) string {
+	url := fmt.Sprintf("%s/v1/projects/%s/routes", c.baseURL, projectID)
+	if routeID != "" {
+		url = fmt.Sprintf("%s/%s", url, routeID)
+	}
+	if c.TeamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(teamID))
+	}
+	return url
+}
+
+// CreateProjectRoute adds a new routing rule to a project.
+func (c *Client) CreateProjectRoute(ctx context.Context, request ProjectRoute) (r ProjectRoute, err error) {
+	url := c.projectRoutesURL(request.ProjectID, request.TeamID, "")
+	payload := string(mustMarshal(request))
+	tflog.Info(ctx, "creating project route", map[string]any{
+		"url":     url,
+		"payload": payload,
+	})
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "POST",
+		url:    url,
+		body:   payload,
+	}, &r)
+	r.ProjectID = request.ProjectID
+	r.TeamID = c.TeamID(request.TeamID)
+	return r, err
+}
+
+// GetProjectRoute retrieves a single routing rule of a project.
+func (c *Client) GetProjectRoute(ctx context.Context, projectID, teamID, routeID string) (r ProjectRoute, err error) {
+	url := c.projectRoutesURL(projectID, teamID, routeID)
+	tflog.Info(ctx, "getting project route", map[string]any{
+		"url": url,
+	})
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+		body:   "",
+	}, &r)
+	r.ProjectID = projectID
+	r.TeamID = c.TeamID(teamID)
+	return r, err
+}
+
+// ListProjectRoutes retrieves all of the API managed routing rules of a project, in the order they are evaluated.
+func (c *Client) ListProjectRoutes(ctx context.Context, projectID, teamID string) ([]ProjectRoute, error) {
+	url := c.projectRoutesURL(projectID, teamID, "")
+	tflog.Info(ctx, "listing project routes", map[string]any{
+		"url": url,
+	})
+	resp := struct {
+		Routes []ProjectRoute `json:"routes"`
+	}{}
+	err := c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+		body:   "",
+	}, &resp)
+	for i := range resp.Routes {
+		resp.Routes[i].ProjectID = projectID
+		resp.Routes[i].TeamID = c.TeamID(teamID)
+	}
+	return resp.Routes, err
+}
+
+// UpdateProjectRoute replaces an existing routing rule of a project.
+func (c *Client) UpdateProjectRoute(ctx context.Context, request ProjectRoute) (r ProjectRoute, err error) {
+	url := c.projectRoutesURL(request.ProjectID, request.TeamID, request.ID)
+	payload := string(mustMarshal(request))
+	tflog.Info(ctx, "updating project route", map[string]any{
+		"url":     url,
+		"payload": payload,
+	})
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "PATCH",
+		url:    url,
+		body:   payload,
+	}, &r)
+	r.ProjectID = request.ProjectID
+	r.TeamID = c.TeamID(request.TeamID)
+	return r, err
+}
+
+// DeleteProjectRoute removes a routing rule from a project.
+func (c *Client) DeleteProjectRoute(ctx context.Context, projectID, teamID, routeID string) error {
+	url := c.projectRoutesURL(projectID, teamID, routeID)
+	tflog.Info(ctx, "deleting project route", map[string]any{
+		"url": url,
+	})
+	return c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "DELETE",
+		url:    url,
+		body:   "",
+	}, nil)
+}
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ShareableLinkScope is the protection bypass scope used by shareable links.
+const ShareableLinkScope = "shareable-link"
+
+type CreateShareableLinkRequest struct {
+	TeamID    string
+	ProjectID string
+	Note      string
+}
+
+type generateShareableLinkRequest struct {
+	Scope string `json:"scope"`
+	Note  string `json:"note,omitempty"`
+}
+
+// CreateShareableLink generates a new shareable link for a project, allowing anyone with the link to view
+// protected deployments without logging in. The returned string is the link's secret, which is passed to a
+// deployment using the `_vercel_share` query parameter.
+func (c *Client) CreateShareableLink(ctx context.Context, request CreateShareableLinkRequest) (s string, err error) {
+	url := fmt.Sprintf("%s/v10/projects/%s/protection-bypass", c.baseURL, request.ProjectID)
+	if c.TeamID(request.TeamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(request.TeamID))
+	}
+
+	payload := string(mustMarshal(struct {
+		Generate generateShareableLinkRequest `json:"generate"`
+	}{
+		Generate: generateShareableLinkRequest{
+			Scope: ShareableLinkScope,
+			Note:  request.Note,
+		},
+	}))
+	tflog.Info(ctx, "creating shareable link", map[string]any{
+		"url":     url,
+		"payload": payload,
+	})
+	response := struct {
+		ProtectionBypass map[string]ProtectionBypass `json:"protectionBypass"`
+	}{}
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "PATCH",
+		url:    url,
+		body:   payload,
+	}, &response)
+	if err != nil {
+		return s, fmt.Errorf("unable to create shareable link: %w", err)
+	}
+
+	// The response can include the project's other protection bypasses, so pick the newest shareable link.
+	var createdAt int64
+	for key, bypass := range response.ProtectionBypass {
+		if bypass.Scope == ShareableLinkScope && (s == "" || bypass.CreatedAt > createdAt) {
+			s = key
+			createdAt = bypass.CreatedAt
+		}
+	}
+	if s == "" {
+		return s, fmt.Errorf("error creating shareable link: the response did not contain a shareable link")
+	}
+	return s, nil
+}
+
+type DeleteShareableLinkRequest struct {
+	TeamID    string
+	ProjectID string
+	Secret    string
+}
+
+// DeleteShareableLink revokes a shareable link, so that it can no longer be used to view protected deployments.
+func (c *Client) DeleteShareableLink(ctx context.Context, request DeleteShareableLinkRequest) error {
+	url := fmt.Sprintf("%s/v10/projects/%s/protection-bypass", c.baseURL, request.ProjectID)
+	if c.TeamID(request.TeamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(request.TeamID))
+	}
+
+	payload := string(mustMarshal(struct {
+		Revoke revokeBypassProtectionRequest `json:"revoke"`
+	}{
+		Revoke: revokeBypassProtectionRequest{
+			Regenerate: false,
+			Secret:     request.Secret,
+		},
+	}))
+	tflog.Info(ctx, "revoking shareable link", map[string]any{
+		"url": url,
+	})
+	return c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "PATCH",
+		url:    url,
+		body:   payload,
+	}, nil)
+}
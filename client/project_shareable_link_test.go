@@ -0,0 +1,43 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCreateShareableLink(t *testing.T) {
+	var body string
+	h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" || r.URL.Path != "/v10/projects/prj_123/protection-bypass" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		fmt.Fprintln(w, `{ "protectionBypass": {
+			"automation_secret": { "scope": "automation-bypass", "createdAt": 1700000002000 },
+			"old_share": { "scope": "shareable-link", "createdAt": 1700000000000 },
+			"new_share": { "scope": "shareable-link", "createdAt": 1700000001000, "note": "qa" }
+		} }`)
+	}))
+	defer h.Close()
+	cl := New("INVALID")
+	cl.baseURL = fmt.Sprintf("http://%s", h.Listener.Addr().String())
+
+	secret, err := cl.CreateShareableLink(context.Background(), CreateShareableLinkRequest{
+		ProjectID: "prj_123",
+		Note:      "qa",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if secret != "new_share" {
+		t.Errorf("expected the newest shareable link, got %q", secret)
+	}
+	if expected := `{"generate":{"scope":"shareable-link","note":"qa"}}`; body != expected {
+		t.Errorf("expected body %s, got %s", expected, body)
+	}
+}
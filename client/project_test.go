@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListAllProjects(t *testing.T) {
+	var queries []string
+	h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		if r.URL.Query().Get("until") == "" {
+			fmt.Fprintln(w, `{ "projects": [{ "id": "prj_1", "name": "first" }], "pagination": { "count": 1, "next": 1700000000000 } }`)
+			return
+		}
+		fmt.Fprintln(w, `{ "projects": [{ "id": "prj_2", "name": "second" }], "pagination": { "count": 1, "next": null } }`)
+	}))
+	defer h.Close()
+	cl := New("INVALID")
+	cl.baseURL = fmt.Sprintf("http://%s", h.Listener.Addr().String())
+
+	projects, err := cl.ListAllProjects(context.Background(), "team_123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(projects) != 2 || projects[0].ID != "prj_1" || projects[1].Name != "second" || projects[1].TeamID != "team_123" {
+		t.Errorf("unexpected projects %+v", projects)
+	}
+	expected := []string{"limit=100&teamId=team_123", "limit=100&teamId=team_123&until=1700000000000"}
+	if fmt.Sprint(queries) != fmt.Sprint(expected) {
+		t.Errorf("expected queries %v, got %v", expected, queries)
+	}
+}
@@ -34,6 +34,18 @@ func (e APIError) Error() string {
 	return fmt.Sprintf("%s - %s", e.Code, e.Message)
 }
 
+// UnexpectedResponseError is returned when a request fails with a response that is not in the API's error format,
+// such as a page from a proxy in front of the API. It is distinct from APIError, so that a 404 from a proxy is not
+// mistaken for an entity not existing.
+type UnexpectedResponseError struct {
+	StatusCode int
+	Body       []byte
+}
+
+func (e UnexpectedResponseError) Error() string {
+	return fmt.Sprintf("error performing API request: %d %s", e.StatusCode, string(e.Body))
+}
+
 type clientRequest struct {
 	ctx              context.Context
 	method           string
@@ -68,6 +80,7 @@ func (cr *clientRequest) toHTTPRequest() (*http.Request, error) {
 // - Unmarshaling responses
 // - Parsing a Retry-After header in the case of rate limits being hit
 // - In the case of a rate-limit being hit, trying again aftera period of time
+// - Trying again with backoff while Vercel is undergoing maintenance
 func (c *Client) doRequest(req clientRequest, v any) error {
 	r, err := req.toHTTPRequest()
 	if err != nil {
@@ -99,18 +112,55 @@ func (c *Client) doRequest(req clientRequest, v any) error {
 		}
 	}
 
+	if isMaintenance(err) {
+		return c.retryDuringMaintenance(req, v, err)
+	}
+	return err
+}
+
+// retryDuringMaintenance retries a request that failed because Vercel is undergoing maintenance, backing off between
+// attempts, until it no longer fails for that reason or the maximum wait has passed.
+func (c *Client) retryDuringMaintenance(req clientRequest, v any, err error) error {
+	start := time.Now()
+	maxWait := c.maxMaintenanceWait()
+	wait := maintenanceRetryInterval
+	for isMaintenance(err) {
+		if time.Since(start)+wait > maxWait {
+			return MaintenanceError{Waited: time.Since(start), Err: err}
+		}
+		tflog.Warn(req.ctx, "Vercel maintenance in progress, retrying request", map[string]any{
+			"url":   req.url,
+			"error": err.Error(),
+			"wait":  wait.String(),
+		})
+		select {
+		case <-req.ctx.Done():
+			return req.ctx.Err()
+		case <-time.After(wait):
+		}
+		wait = min(wait*2, maintenanceMaxRetryInterval)
+
+		r, rerr := req.toHTTPRequest()
+		if rerr != nil {
+			return rerr
+		}
+		err = c._doRequest(r, v, req.errorOnNoContent)
+	}
 	return err
 }
 
 func (c *Client) _doRequest(req *http.Request, v any, errorOnNoContent bool) error {
 	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	done := trackRequest(req.Method, req.URL.Path)
 	resp, err := c.http().Do(req)
 	if err != nil {
+		done(0, err)
 		return fmt.Errorf("error doing http request: %w", err)
 	}
 
 	defer resp.Body.Close()
 	responseBody, err := io.ReadAll(resp.Body)
+	done(resp.StatusCode, err)
 	if err != nil {
 		return fmt.Errorf("error reading response body: %w", err)
 	}
@@ -127,7 +177,10 @@ func (c *Client) _doRequest(req *http.Request, v any, errorOnNoContent bool) err
 			Error: &errorResponse,
 		})
 		if errorResponse.Code == "" && errorResponse.Message == "" {
-			return fmt.Errorf("error performing API request: %d %s", resp.StatusCode, string(responseBody))
+			return UnexpectedResponseError{
+				StatusCode: resp.StatusCode,
+				Body:       responseBody,
+			}
 		}
 		if err != nil {
 			return fmt.Errorf("error unmarshaling response for status code %d: %w: %s", resp.StatusCode, err, string(responseBody))
@@ -159,6 +212,12 @@ func (c *Client) _doRequest(req *http.Request, v any, errorOnNoContent bool) err
 		}
 	}
 
+	// Some endpoints do not respond with a single JSON document, so allow the body to be parsed by the caller.
+	if raw, ok := v.(*[]byte); ok {
+		*raw = responseBody
+		return nil
+	}
+
 	err = json.Unmarshal(responseBody, v)
 	if err != nil {
 		return fmt.Errorf("error unmarshaling response %s: %w", responseBody, err)
@@ -27,7 +27,7 @@ type SharedEnvVarRequest struct {
 
 type SharedEnvironmentVariableRequest struct {
 	Type                         string                `json:"type"`
-	ProjectIDs                   []string              `json:"projectId"`
+	ProjectIDs                   []string              `json:"projectId,omitempty"`
 	Target                       []string              `json:"target"`
 	ApplyToAllCustomEnvironments bool                  `json:"applyToAllCustomEnvironments"`
 	EnvironmentVariables         []SharedEnvVarRequest `json:"evs"`
@@ -98,6 +98,45 @@ func (c *Client) CreateSharedEnvironmentVariable(ctx context.Context, request Cr
 	return response.Created[0], err
 }
 
+// CreateSharedEnvironmentVariables creates several shared environment variables with the same type, targets and
+// linked projects in a single request.
+func (c *Client) CreateSharedEnvironmentVariables(ctx context.Context, request CreateSharedEnvironmentVariableRequest) ([]SharedEnvironmentVariableResponse, error) {
+	url := fmt.Sprintf("%s/v1/env", c.baseURL)
+	if c.TeamID(request.TeamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(request.TeamID))
+	}
+	payload := string(mustMarshal(request.EnvironmentVariable))
+	tflog.Info(ctx, "creating shared environment variables", map[string]any{
+		"url":   url,
+		"count": len(request.EnvironmentVariable.EnvironmentVariables),
+	})
+	var response struct {
+		Created []SharedEnvironmentVariableResponse `json:"created"`
+	}
+	err := c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "POST",
+		url:    url,
+		body:   payload,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Created) != len(request.EnvironmentVariable.EnvironmentVariables) {
+		return nil, fmt.Errorf("expected %d environment variables to be created, got %d", len(request.EnvironmentVariable.EnvironmentVariables), len(response.Created))
+	}
+	// Override the values, as the encrypted values are returned.
+	values := map[string]string{}
+	for _, e := range request.EnvironmentVariable.EnvironmentVariables {
+		values[e.Key] = e.Value
+	}
+	for i := range response.Created {
+		response.Created[i].Value = values[response.Created[i].Key]
+		response.Created[i].TeamID = c.TeamID(request.TeamID)
+	}
+	return response.Created, nil
+}
+
 // DeleteSharedEnvironmentVariable will remove a shared environment variable from Vercel.
 func (c *Client) DeleteSharedEnvironmentVariable(ctx context.Context, teamID, variableID string) error {
 	url := fmt.Sprintf("%s/v1/env", c.baseURL)
@@ -91,31 +91,47 @@ type Address struct {
 }
 
 type RemoteCaching struct {
-	Enabled *bool `json:"enabled"`
+	Enabled               *bool  `json:"enabled"`
+	ArtifactRetentionDays *int64 `json:"artifactRetentionDays,omitempty"`
+	MaxArtifactSizeMB     *int64 `json:"maxArtifactSizeMb,omitempty"`
 }
 
 type SpacesConfig struct {
 	Enabled bool `json:"enabled"`
 }
 
+// TeamBilling is the billing information of a team.
+type TeamBilling struct {
+	// Plan is one of "hobby", "pro" or "enterprise".
+	Plan string `json:"plan"`
+}
+
+// TeamResourceConfig is the resources a team's plan allows.
+type TeamResourceConfig struct {
+	ConcurrentBuilds *int64 `json:"concurrentBuilds,omitempty"`
+}
+
 // Team is the information returned by the vercel api when a team is created.
 type Team struct {
-	ID                                 string         `json:"id"`
-	Name                               string         `json:"name"`
-	Avatar                             *string        `json:"avatar"` // hash of uploaded image
-	Description                        *string        `json:"description"`
-	Slug                               string         `json:"slug"`
-	SensitiveEnvironmentVariablePolicy *string        `json:"sensitiveEnvironmentVariablePolicy"`
-	EmailDomain                        *string        `json:"emailDomain"`
-	Saml                               *SamlConfig    `json:"saml"`
-	InviteCode                         *string        `json:"inviteCode"`
-	PreviewDeploymentSuffix            *string        `json:"previewDeploymentSuffix"`
-	RemoteCaching                      *RemoteCaching `json:"remoteCaching"`
-	EnablePreviewFeedback              *string        `json:"enablePreviewFeedback"`
-	EnableProductionFeedback           *string        `json:"enableProductionFeedback"`
-	Spaces                             *SpacesConfig  `json:"spaces"`
-	HideIPAddresses                    *bool          `json:"hideIpAddresses"`
-	HideIPAddressesInLogDrains         *bool          `json:"hideIpAddressesInLogDrains,omitempty"`
+	ID                                 string              `json:"id"`
+	Name                               string              `json:"name"`
+	Avatar                             *string             `json:"avatar"` // hash of uploaded image
+	Description                        *string             `json:"description"`
+	Slug                               string              `json:"slug"`
+	SensitiveEnvironmentVariablePolicy *string             `json:"sensitiveEnvironmentVariablePolicy"`
+	EmailDomain                        *string             `json:"emailDomain"`
+	Saml                               *SamlConfig         `json:"saml"`
+	InviteCode                         *string             `json:"inviteCode"`
+	PreviewDeploymentSuffix            *string             `json:"previewDeploymentSuffix"`
+	RemoteCaching                      *RemoteCaching      `json:"remoteCaching"`
+	EnablePreviewFeedback              *string             `json:"enablePreviewFeedback"`
+	EnableProductionFeedback           *string             `json:"enableProductionFeedback"`
+	Spaces                             *SpacesConfig       `json:"spaces"`
+	HideIPAddresses                    *bool               `json:"hideIpAddresses"`
+	HideIPAddressesInLogDrains         *bool               `json:"hideIpAddressesInLogDrains,omitempty"`
+	EnforceTwoFactorAuthentication     *bool               `json:"enforceTwoFactorAuthentication,omitempty"`
+	Billing                            *TeamBilling        `json:"billing,omitempty"`
+	ResourceConfig                     *TeamResourceConfig `json:"resourceConfig,omitempty"`
 }
 
 // GetTeam returns information about an existing team within vercel.
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -155,3 +156,41 @@ func (c *Client) GetTeamMember(ctx context.Context, request GetTeamMemberRequest
 	response.Members[0].Projects = response2.Projects
 	return response.Members[0], err
 }
+
+// ListTeamMembers lists every member of a team, following the pagination of the API. Project roles are not
+// included.
+func (c *Client) ListTeamMembers(ctx context.Context, teamID string) ([]TeamMember, error) {
+	var members []TeamMember
+	until := ""
+	for {
+		url := fmt.Sprintf("%s/v2/teams/%s/members?limit=100", c.baseURL, teamID)
+		if until != "" {
+			url = fmt.Sprintf("%s&until=%s", url, until)
+		}
+		tflog.Info(ctx, "listing team members", map[string]any{
+			"url": url,
+		})
+
+		var response struct {
+			Members    []TeamMember `json:"members"`
+			Pagination struct {
+				HasNext bool         `json:"hasNext"`
+				Next    *json.Number `json:"next"`
+			} `json:"pagination"`
+		}
+		err := c.doRequest(clientRequest{
+			ctx:    ctx,
+			method: "GET",
+			url:    url,
+			body:   "",
+		}, &response)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, response.Members...)
+		if !response.Pagination.HasNext || response.Pagination.Next == nil || response.Pagination.Next.String() == until {
+			return members, nil
+		}
+		until = response.Pagination.Next.String()
+	}
+}
@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListTeamMembers(t *testing.T) {
+	var queries []string
+	h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		if r.URL.Query().Get("until") == "" {
+			fmt.Fprintln(w, `{ "members": [{ "uid": "usr_1", "email": "a@example.com", "role": "OWNER" }], "pagination": { "hasNext": true, "next": 1700000000000 } }`)
+			return
+		}
+		fmt.Fprintln(w, `{ "members": [{ "uid": "usr_2", "email": "b@example.com", "role": "VIEWER" }], "pagination": { "hasNext": false, "next": null } }`)
+	}))
+	defer h.Close()
+	cl := New("INVALID")
+	cl.baseURL = fmt.Sprintf("http://%s", h.Listener.Addr().String())
+
+	members, err := cl.ListTeamMembers(context.Background(), "team_123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(members) != 2 || members[0].UserID != "usr_1" || members[1].Email != "b@example.com" {
+		t.Errorf("unexpected members %+v", members)
+	}
+	expected := []string{"limit=100", "limit=100&until=1700000000000"}
+	if fmt.Sprint(queries) != fmt.Sprint(expected) {
+		t.Errorf("expected queries %v, got %v", expected, queries)
+	}
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// TokenScope describes an account that an API token has access to.
+type TokenScope struct {
+	// Type is either "user", for tokens with access to the user's personal account and all of their teams,
+	// or "team", for tokens restricted to a single team.
+	Type      string `json:"type"`
+	TeamID    string `json:"teamId"`
+	ExpiresAt *int64 `json:"expiresAt"`
+}
+
+// Token contains information about an API token.
+type Token struct {
+	ID        string       `json:"id"`
+	Name      string       `json:"name"`
+	Type      string       `json:"type"`
+	Scopes    []TokenScope `json:"scopes"`
+	ExpiresAt *int64       `json:"expiresAt"`
+}
+
+// HasTeamAccess returns whether the token can be used to manage resources within a team.
+func (t Token) HasTeamAccess(teamID string) bool {
+	// Tokens without any scopes are not restricted.
+	if len(t.Scopes) == 0 {
+		return true
+	}
+	for _, s := range t.Scopes {
+		if s.Type == "user" || s.TeamID == teamID {
+			return true
+		}
+	}
+	return false
+}
+
+// GetCurrentToken retrieves information about the API token the client is using.
+func (c *Client) GetCurrentToken(ctx context.Context) (t Token, err error) {
+	url := fmt.Sprintf("%s/v5/user/tokens/current", c.baseURL)
+	tflog.Info(ctx, "getting current token", map[string]any{
+		"url": url,
+	})
+	resp := struct {
+		Token Token `json:"token"`
+	}{}
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+		body:   "",
+	}, &resp)
+	if err != nil {
+		return t, fmt.Errorf("unable to get current token: %w", err)
+	}
+	return resp.Token, nil
+}
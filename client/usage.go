@@ -0,0 +1,84 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// UsageCharge is a single line of the billing charges of a team, in the FinOps Open Cost and Usage Specification
+// (FOCUS) format. A charge covers the usage of one service, by one project, for one charge period.
+type UsageCharge struct {
+	BilledCost        float64           `json:"BilledCost"`
+	BillingCurrency   string            `json:"BillingCurrency"`
+	ChargeCategory    string            `json:"ChargeCategory"`
+	ChargePeriodStart string            `json:"ChargePeriodStart"`
+	ChargePeriodEnd   string            `json:"ChargePeriodEnd"`
+	ConsumedQuantity  float64           `json:"ConsumedQuantity"`
+	ConsumedUnit      string            `json:"ConsumedUnit"`
+	ServiceName       string            `json:"ServiceName"`
+	Tags              map[string]string `json:"Tags"`
+}
+
+// ProjectID returns the ID of the project a charge was incurred by, or an empty string for charges that are not
+// specific to a project.
+func (u UsageCharge) ProjectID() string {
+	return u.Tags["ProjectId"]
+}
+
+// ProjectName returns the name of the project a charge was incurred by, if any.
+func (u UsageCharge) ProjectName() string {
+	return u.Tags["ProjectName"]
+}
+
+// GetUsageRequest defines the period to get the usage of a team for. From and To are timestamps in RFC 3339 format.
+type GetUsageRequest struct {
+	TeamID string
+	From   string
+	To     string
+}
+
+// GetUsage gets the billing charges of a team for a period.
+func (c *Client) GetUsage(ctx context.Context, request GetUsageRequest) ([]UsageCharge, error) {
+	query := url.Values{}
+	query.Set("from", request.From)
+	query.Set("to", request.To)
+	if c.TeamID(request.TeamID) != "" {
+		query.Set("teamId", c.TeamID(request.TeamID))
+	}
+	url := fmt.Sprintf("%s/v1/billing/charges?%s", c.baseURL, query.Encode())
+	tflog.Info(ctx, "getting usage", map[string]any{
+		"url": url,
+	})
+
+	// The charges are returned as newline delimited JSON, rather than a single JSON document.
+	var body []byte
+	err := c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+		body:   "",
+	}, &body)
+	if err != nil {
+		return nil, err
+	}
+	var charges []UsageCharge
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	for {
+		var charge UsageCharge
+		err := decoder.Decode(&charge)
+		if errors.Is(err, io.EOF) {
+			return charges, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling usage charges: %w", err)
+		}
+		charges = append(charges, charge)
+	}
+}
@@ -0,0 +1,43 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetUsage(t *testing.T) {
+	var query string
+	h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		fmt.Fprintln(w, `{"BilledCost": 1.5, "BillingCurrency": "USD", "ConsumedQuantity": 120, "ConsumedUnit": "GB", "ServiceName": "Fast Data Transfer", "Tags": {"ProjectId": "prj_1", "ProjectName": "first"}}`)
+		fmt.Fprintln(w, `{"BilledCost": 0, "BillingCurrency": "USD", "ConsumedQuantity": 30, "ConsumedUnit": "Minutes", "ServiceName": "Build Minutes", "Tags": {}}`)
+	}))
+	defer h.Close()
+	cl := New("INVALID")
+	cl.baseURL = fmt.Sprintf("http://%s", h.Listener.Addr().String())
+
+	charges, err := cl.GetUsage(context.Background(), GetUsageRequest{
+		TeamID: "team_123",
+		From:   "2024-01-01T00:00:00Z",
+		To:     "2024-02-01T00:00:00Z",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(charges) != 2 {
+		t.Fatalf("expected 2 charges, got %d", len(charges))
+	}
+	if charges[0].ProjectID() != "prj_1" || charges[0].ProjectName() != "first" || charges[0].BilledCost != 1.5 || charges[0].ConsumedQuantity != 120 {
+		t.Errorf("unexpected charge %+v", charges[0])
+	}
+	if charges[1].ProjectID() != "" || charges[1].ServiceName != "Build Minutes" {
+		t.Errorf("unexpected charge %+v", charges[1])
+	}
+	expected := "from=2024-01-01T00%3A00%3A00Z&teamId=team_123&to=2024-02-01T00%3A00%3A00Z"
+	if query != expected {
+		t.Errorf("expected query %s, got %s", expected, query)
+	}
+}
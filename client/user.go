@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// User contains information about a Vercel user.
+type User struct {
+	ID       string `json:"id"`
+	Email    string `json:"email"`
+	Username string `json:"username"`
+}
+
+// GetCurrentUser retrieves the user that owns the API token the client is using.
+func (c *Client) GetCurrentUser(ctx context.Context) (u User, err error) {
+	url := fmt.Sprintf("%s/v2/user", c.baseURL)
+	tflog.Info(ctx, "getting current user", map[string]any{
+		"url": url,
+	})
+	resp := struct {
+		User User `json:"user"`
+	}{}
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+		body:   "",
+	}, &resp)
+	if err != nil {
+		return u, fmt.Errorf("unable to get current user: %w", err)
+	}
+	return resp.User, nil
+}
@@ -0,0 +1,13 @@
+data "vercel_project" "example" {
+  name = "my-existing-project"
+}
+
+data "vercel_access_group_project_roles" "example" {
+  project_id = data.vercel_project.example.id
+}
+
+output "project_access" {
+  value = {
+    for ag in data.vercel_access_group_project_roles.example.access_groups : ag.name => ag.role
+  }
+}
@@ -0,0 +1,9 @@
+# Compare what is currently live with a candidate deployment before promoting it.
+data "vercel_deployment_diff" "example" {
+  from_deployment_id = "https://my-vercel-project.vercel.app"
+  to_deployment_id   = "dpl_xxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+}
+
+output "modified_files" {
+  value = data.vercel_deployment_diff.example.modified_files
+}
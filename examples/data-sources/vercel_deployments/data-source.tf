@@ -0,0 +1,10 @@
+data "vercel_deployments" "example" {
+  project_id = "prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  meta = {
+    terraform_workspace = terraform.workspace
+  }
+}
+
+output "latest_deployment_url" {
+  value = data.vercel_deployments.example.deployments[0].url
+}
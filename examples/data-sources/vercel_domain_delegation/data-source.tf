@@ -0,0 +1,18 @@
+data "vercel_domain_delegation" "example" {
+  domain = "example.com"
+}
+
+resource "vercel_dns_record" "www" {
+  domain = "example.com"
+  name   = "www"
+  type   = "CNAME"
+  value  = "cname.vercel-dns.com."
+  ttl    = 60
+
+  lifecycle {
+    precondition {
+      condition     = data.vercel_domain_delegation.example.delegated
+      error_message = "example.com is not delegated to Vercel. Set its nameservers to ${join(", ", data.vercel_domain_delegation.example.intended_nameservers)}."
+    }
+  }
+}
@@ -0,0 +1,20 @@
+data "vercel_drift_report" "example" {
+  project_ids = [
+    "prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx",
+    "prj_yyyyyyyyyyyyyyyyyyyyyyyyyyyy",
+  ]
+
+  baseline = {
+    framework             = "nextjs"
+    node_version          = "20.x"
+    vercel_authentication = "standard_protection"
+    environment_variable_keys = [
+      "DATABASE_URL",
+      "SENTRY_DSN",
+    ]
+  }
+}
+
+output "drifted_projects" {
+  value = data.vercel_drift_report.example.drifted_project_ids
+}
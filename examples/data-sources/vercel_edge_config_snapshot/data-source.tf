@@ -0,0 +1,7 @@
+data "vercel_edge_config_snapshot" "example" {
+  id = "ecfg_xxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+}
+
+output "feature_flags" {
+  value = { for k, v in data.vercel_edge_config_snapshot.example.items : k => jsondecode(v) }
+}
@@ -0,0 +1,12 @@
+data "vercel_firewall_insights" "example" {
+  project_id = "prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  window     = "24h"
+}
+
+# Find custom rules that blocked a large number of requests in the last day.
+output "noisy_rules" {
+  value = [
+    for r in data.vercel_firewall_insights.example.rules : r.name
+    if r.blocked_requests > 10000
+  ]
+}
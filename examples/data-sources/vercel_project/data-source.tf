@@ -1,3 +1,8 @@
 data "vercel_project" "example" {
   name = "my-existing-project"
 }
+
+# Projects can also be looked up by a domain assigned to them.
+data "vercel_project" "by_domain" {
+  domain = "www.example.com"
+}
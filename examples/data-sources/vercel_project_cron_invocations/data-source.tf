@@ -0,0 +1,18 @@
+data "vercel_project_cron_invocations" "example" {
+  project_id = "prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  path       = "/api/cron/nightly-report"
+  window     = "26h"
+}
+
+# The nightly report should have run, and succeeded, within the last 26 hours.
+check "nightly_report" {
+  assert {
+    condition     = length(data.vercel_project_cron_invocations.example.invocations) > 0
+    error_message = "The nightly report cron job has not run in the last 26 hours."
+  }
+
+  assert {
+    condition     = data.vercel_project_cron_invocations.example.failed_invocations == 0
+    error_message = "The nightly report cron job has failed in the last 26 hours."
+  }
+}
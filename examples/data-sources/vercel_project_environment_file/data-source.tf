@@ -0,0 +1,22 @@
+data "vercel_project_environment_file" "preview" {
+  project_id = "prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  target     = "preview"
+  git_branch = "staging"
+}
+
+resource "local_sensitive_file" "preview" {
+  filename = "${path.module}/${data.vercel_project_environment_file.preview.filename}"
+  content  = data.vercel_project_environment_file.preview.content
+}
+
+# Alternatively, the variables can be combined with values from elsewhere
+# and rendered with the `to_dotenv` provider function.
+resource "local_sensitive_file" "local" {
+  filename = "${path.module}/.env.local"
+  content = provider::vercel::to_dotenv(merge(
+    data.vercel_project_environment_file.preview.variables,
+    {
+      DEBUG = "true"
+    },
+  ))
+}
@@ -0,0 +1,26 @@
+data "vercel_project" "example" {
+  name = "my-existing-project"
+}
+
+data "vercel_project_environment_variables" "example" {
+  project_id = data.vercel_project.example.id
+}
+
+# List the Environment Variables that were created by someone in the dashboard,
+# rather than by the API token Terraform uses.
+output "dashboard_variables" {
+  value = [
+    for v in data.vercel_project_environment_variables.example.variables : v.key
+    if v.created_by != "xxxxxxxxxxxxxxxxxxxxxxxx"
+  ]
+}
+
+# Read a value managed by another configuration, such as the URL of an API.
+data "vercel_project_environment_variables" "production" {
+  project_id = data.vercel_project.example.id
+  target     = "production"
+}
+
+output "api_url" {
+  value = nonsensitive(data.vercel_project_environment_variables.production.values["NEXT_PUBLIC_API_URL"])
+}
@@ -0,0 +1,10 @@
+data "vercel_project_ids" "example" {
+  names = ["frontend", "backend", "docs"]
+}
+
+resource "vercel_shared_environment_variable" "example" {
+  key         = "EXAMPLE"
+  value       = "some_value"
+  target      = ["production"]
+  project_ids = values(data.vercel_project_ids.example.ids)
+}
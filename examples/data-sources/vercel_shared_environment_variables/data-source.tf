@@ -0,0 +1,16 @@
+data "vercel_project_environment_variables" "example" {
+  project_id = "prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+}
+
+data "vercel_shared_environment_variables" "example" {
+  project_id = "prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+}
+
+# The Project Environment Variables that are also shared with the project, and
+# so are candidates for removal from the project.
+output "duplicated_keys" {
+  value = setintersection(
+    [for e in data.vercel_project_environment_variables.example.variables : e.key],
+    lookup(data.vercel_shared_environment_variables.example.project_keys, "prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx", []),
+  )
+}
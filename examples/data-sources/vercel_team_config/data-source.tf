@@ -1,3 +1,14 @@
 data "vercel_team_config" "example" {
-  id   = "team_xxxxxxxxxxxxxxxxxxxxxxxx" // Replace with your team ID
+  id = "team_xxxxxxxxxxxxxxxxxxxxxxxx" // Replace with your team ID
+}
+
+// Follow the team's sensitive environment variable policy rather than
+// hard-coding `sensitive`.
+resource "vercel_project_environment_variable" "example" {
+  project_id = "prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx" // Replace with your project ID
+  team_id    = data.vercel_team_config.example.id
+  key        = "API_TOKEN"
+  value      = "example-token"
+  target     = ["production"]
+  sensitive  = data.vercel_team_config.example.sensitive_environment_variables_required
 }
@@ -0,0 +1,19 @@
+data "vercel_team_limits" "example" {
+  team_id = "team_xxxxxxxxxxxxxxxxxxxxxxxx"
+}
+
+variable "domains" {
+  type = list(string)
+}
+
+# Fail the plan early, rather than part way through an apply, if there are
+# more domains than the team's plan allows on a single project.
+check "domain_quota" {
+  assert {
+    condition = (
+      data.vercel_team_limits.example.max_domains_per_project == null ||
+      length(var.domains) <= data.vercel_team_limits.example.max_domains_per_project
+    )
+    error_message = "Too many domains for the team's ${data.vercel_team_limits.example.plan} plan."
+  }
+}
@@ -0,0 +1,15 @@
+data "vercel_usage" "example" {
+  from = "2024-01-01T00:00:00Z"
+  to   = "2024-02-01T00:00:00Z"
+}
+
+output "billed_cost" {
+  value = data.vercel_usage.example.billed_cost
+}
+
+# The Fast Data Transfer used by each Project, in GB.
+output "bandwidth_by_project" {
+  value = {
+    for p in data.vercel_usage.example.projects : p.name => p.bandwidth_gb
+  }
+}
@@ -0,0 +1,22 @@
+data "vercel_project" "example" {
+  name = "my-existing-project"
+}
+
+# Read the production value of DATABASE_URL without storing it in state.
+ephemeral "vercel_project_environment_variable" "database_url" {
+  project_id = data.vercel_project.example.id
+  key        = "DATABASE_URL"
+  target     = "production"
+}
+
+# Pass the value on to a write-only attribute of another provider.
+resource "kubernetes_secret_v1" "example" {
+  metadata {
+    name = "database"
+  }
+
+  data_wo = {
+    DATABASE_URL = ephemeral.vercel_project_environment_variable.database_url.value
+  }
+  data_wo_revision = 1
+}
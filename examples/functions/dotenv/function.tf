@@ -0,0 +1,13 @@
+resource "vercel_project" "example" {
+  name = "example-project"
+}
+
+resource "vercel_project_environment_variables" "example" {
+  project_id = vercel_project.example.id
+  variables = {
+    for key, value in provider::vercel::dotenv(file("${path.module}/.env.production")) : key => {
+      value  = value
+      target = ["production"]
+    }
+  }
+}
@@ -0,0 +1,20 @@
+variable "shared_variables" {
+  type = map(object({
+    value  = string
+    target = set(string)
+  }))
+}
+
+resource "vercel_project_environment_variables" "example" {
+  project_id = vercel_project.example.id
+  # Fails if a key in var.shared_variables is also defined below with a different value or target.
+  variables = provider::vercel::merge_env(
+    var.shared_variables,
+    {
+      API_URL = {
+        value  = "https://api.example.com"
+        target = ["production"]
+      }
+    },
+  )
+}
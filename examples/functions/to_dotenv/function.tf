@@ -0,0 +1,7 @@
+resource "local_file" "env" {
+  filename = "${path.module}/.env.local"
+  content = provider::vercel::to_dotenv({
+    API_URL   = "https://api.example.com"
+    LOG_LEVEL = "debug"
+  })
+}
@@ -17,4 +17,11 @@ provider "vercel" {
 
   # Optional default team for all resources
   team = "your_team_slug_or_id"
+
+  # Optionally opt in to changes in the provider's behaviour
+  features {
+    env_vars {
+      destructive_updates = false
+    }
+  }
 }
@@ -0,0 +1,19 @@
+resource "vercel_project" "example" {
+  name = "example-project"
+}
+
+resource "vercel_deployment" "release" {
+  project_id = vercel_project.example.id
+  ref        = "main"
+}
+
+variable "vanity_hostnames" {
+  type = set(string)
+}
+
+# Move every vanity alias to the latest release at once.
+resource "vercel_aliases" "release" {
+  aliases = {
+    for hostname in var.vanity_hostnames : hostname => vercel_deployment.release.id
+  }
+}
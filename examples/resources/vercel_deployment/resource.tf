@@ -59,3 +59,64 @@ resource "vercel_deployment" "prebuilt_example" {
   files       = data.vercel_prebuilt_project.prebuilt_example.output
   path_prefix = data.vercel_prebuilt_project.prebuilt_example.path
 }
+
+## Or building a production deployment and releasing it separately
+resource "vercel_deployment" "release_candidate" {
+  project_id             = data.vercel_project.files_example.id
+  files                  = data.vercel_project_directory.files_example.files
+  path_prefix            = data.vercel_project_directory.files_example.path
+  production             = true
+  skip_automatic_aliases = true
+}
+
+resource "vercel_alias" "release" {
+  alias         = "my-awesome-project.com"
+  deployment_id = vercel_deployment.release_candidate.id
+}
+
+## Or passing build-time secrets without storing them in state
+ephemeral "vault_kv_secret_v2" "npm" {
+  mount = "secret"
+  name  = "npm"
+}
+
+resource "vercel_deployment" "private_packages" {
+  project_id  = data.vercel_project.files_example.id
+  files       = data.vercel_project_directory.files_example.files
+  path_prefix = data.vercel_project_directory.files_example.path
+
+  build_environment = {
+    NPM_TOKEN = ephemeral.vault_kv_secret_v2.npm.data.token
+  }
+  # Increment this whenever the token is rotated.
+  build_environment_version = 1
+}
+
+## Or failing the apply when the deployment exceeds a size budget
+resource "vercel_deployment" "size_budget" {
+  project_id  = data.vercel_project.files_example.id
+  files       = data.vercel_project_directory.files_example.files
+  path_prefix = data.vercel_project_directory.files_example.path
+
+  lifecycle {
+    postcondition {
+      condition     = self.largest_function_size == null || self.largest_function_size < 50 * 1024 * 1024
+      error_message = "The largest function of the deployment is over the 50MB budget."
+    }
+  }
+}
+
+## Or only allowing production deployments during working hours
+resource "vercel_deployment" "change_window" {
+  project_id  = data.vercel_project.files_example.id
+  files       = data.vercel_project_directory.files_example.files
+  path_prefix = data.vercel_project_directory.files_example.path
+  production  = true
+
+  deployment_windows = [{
+    # 09:00 to 17:00, Monday to Thursday.
+    schedule  = "0 9 * * 1-4"
+    duration  = "8h"
+    time_zone = "Europe/London"
+  }]
+}
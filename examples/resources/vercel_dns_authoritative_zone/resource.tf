@@ -0,0 +1,24 @@
+resource "vercel_dns_record" "www" {
+  domain = "example.com"
+  name   = "www"
+  type   = "CNAME"
+  value  = "cname.vercel-dns.com."
+}
+
+resource "vercel_dns_record_set" "mail" {
+  domain = "example.com"
+  name   = ""
+  type   = "TXT"
+  values = ["v=spf1 include:_spf.google.com ~all"]
+}
+
+# Deletes every other record in example.com, such as ones added
+# in the Vercel dashboard. Records that Vercel manages itself are
+# left in place.
+resource "vercel_dns_authoritative_zone" "example" {
+  domain = "example.com"
+  managed_record_ids = concat(
+    [vercel_dns_record.www.id],
+    values(vercel_dns_record_set.mail.record_ids),
+  )
+}
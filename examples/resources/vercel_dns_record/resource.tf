@@ -1,9 +1,10 @@
 resource "vercel_dns_record" "a" {
   domain = "example.com"
-  name   = "subdomain" # for subdomain.example.com
-  type   = "A"
-  ttl    = 60
-  value  = "192.168.0.1"
+  name    = "subdomain" # for subdomain.example.com
+  type    = "A"
+  ttl     = 60
+  value   = "192.168.0.1"
+  comment = "Owned by the platform team"
 }
 
 resource "vercel_dns_record" "aaaa" {
@@ -0,0 +1,29 @@
+# Several A records for the same name, which resolvers will
+# spread traffic across. Values can be added or removed
+# without affecting the other records.
+resource "vercel_dns_record_set" "pool" {
+  domain = "example.com"
+  name   = "pool" # for pool.example.com
+  type   = "A"
+  ttl    = 60
+  values = [
+    "192.168.0.1",
+    "192.168.0.2",
+    "192.168.0.3",
+  ]
+  comment = "Owned by the platform team"
+}
+
+resource "vercel_dns_record_set" "verification" {
+  domain = "example.com"
+  name   = ""
+  type   = "TXT"
+  values = [
+    "google-site-verification=abc123",
+    "v=spf1 include:_spf.google.com ~all",
+  ]
+
+  # Delete any other TXT records on the root domain, such as
+  # ones added in the dashboard.
+  authoritative = true
+}
@@ -0,0 +1,24 @@
+resource "vercel_edge_config" "example" {
+  name = "example"
+}
+
+data "vercel_edge_config_snapshot" "current" {
+  id = vercel_edge_config.example.id
+}
+
+# Keeps the items as they were when this resource was created, as the
+# data source is read again on every plan.
+resource "terraform_data" "known_good" {
+  input = data.vercel_edge_config_snapshot.current.items
+
+  lifecycle {
+    ignore_changes = [input]
+  }
+}
+
+# Rolls the Edge Config back to the known good items. Add this resource when
+# a rollback is needed, and use `terraform apply -replace` to run it again.
+resource "vercel_edge_config_snapshot_restore" "rollback" {
+  edge_config_id = vercel_edge_config.example.id
+  items          = terraform_data.known_good.output
+}
@@ -26,3 +26,12 @@ resource "vercel_firewall_bypass" "bypass_all" {
   # for all the _production_ domains assigned to the project.
   domain = "*"
 }
+
+resource "vercel_firewall_bypass" "bypass_temporary" {
+  project_id = vercel_project.example.id
+
+  source_ip = "9.10.11.12"
+  domain    = "my-production-domain.com"
+  # The rule is removed automatically after 24 hours
+  ttl = "24h"
+}
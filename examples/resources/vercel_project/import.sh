@@ -7,3 +7,6 @@ terraform import vercel_project.example prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx
 # - team_id can be found in the team `settings` tab in the Vercel UI.
 # - project_id can be found in the project `settings` tab in the Vercel UI.
 terraform import vercel_project.example team_xxxxxxxxxxxxxxxxxxxxxxxx/prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx
+
+# Importing warns about any settings of the project that the vercel_project
+# resource does not support, and so are not managed by Terraform.
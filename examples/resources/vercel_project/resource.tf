@@ -11,6 +11,22 @@ resource "vercel_project" "with_git" {
   }
 }
 
+# A project that only builds previews for some branches.
+# The ignore command exits with code 1 to build a commit, so
+# production and branches matching the pattern are built,
+# and pushes to any other branch are skipped.
+resource "vercel_project" "with_preview_branch_filter" {
+  name      = "example-project-with-preview-branch-filter"
+  framework = "nextjs"
+
+  git_repository = {
+    type = "github"
+    repo = "vercel/some-repo"
+  }
+
+  ignore_command = "if [ \"$VERCEL_ENV\" = production ] || echo \"$VERCEL_GIT_COMMIT_REF\" | grep -qE '^(release|feature)/'; then exit 1; else exit 0; fi"
+}
+
 # A project that is not connected to a git repository.
 # Deployments will need to be created manually through
 # terraform, or via the vercel CLI.
@@ -18,3 +34,19 @@ resource "vercel_project" "example" {
   name      = "example-project"
   framework = "nextjs"
 }
+
+# A project that gradually rolls out Production Deployments,
+# sending 10% and then 50% of traffic to a new deployment
+# before promoting it to all traffic.
+resource "vercel_project" "with_rolling_release" {
+  name      = "example-project-with-rolling-release"
+  framework = "nextjs"
+
+  rolling_release = {
+    advancement_type = "automatic"
+    stages = [
+      { target_percentage = 10, duration = 30 },
+      { target_percentage = 50, duration = 60 },
+    ]
+  }
+}
@@ -18,3 +18,15 @@ resource "vercel_project_domain" "example_redirect" {
   redirect             = vercel_project_domain.example.domain
   redirect_status_code = 307
 }
+
+# A custom domain that checks its CAA records allow a
+# certificate to be issued before it is added, and waits
+# for the certificate, failing if it is not issued in time.
+resource "vercel_project_domain" "example_certificate" {
+  project_id = vercel_project.example.id
+  domain     = "www.example.com"
+
+  check_caa            = true
+  wait_for_certificate = true
+  certificate_timeout  = "15m"
+}
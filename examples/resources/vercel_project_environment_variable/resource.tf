@@ -39,3 +39,21 @@ resource "vercel_project_environment_variable" "example_sensitive" {
   comment    = "a sensitive production secret"
 }
 
+
+# An environment variable that will be created for this project for the
+# "production" environment and the "staging" custom environment. The custom
+# environment slug is resolved to its ID when planning.
+resource "vercel_custom_environment" "staging" {
+  project_id = vercel_project.example.id
+  name       = "staging"
+}
+
+resource "vercel_project_environment_variable" "example_custom_environment" {
+  project_id = vercel_project.example.id
+  key        = "foo"
+  value      = "bar-staging"
+  target     = ["production", "staging"]
+  comment    = "a secret shared with staging"
+
+  depends_on = [vercel_custom_environment.staging]
+}
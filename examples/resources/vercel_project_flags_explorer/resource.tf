@@ -0,0 +1,9 @@
+resource "vercel_project" "example" {
+  name = "example-project"
+}
+
+resource "vercel_project_flags_explorer" "example" {
+  project_id = vercel_project.example.id
+  # Generate with `node -e "console.log(crypto.randomBytes(32).toString('base64url'))"`
+  secret = var.flags_secret
+}
@@ -0,0 +1,8 @@
+# If importing with a team configured on the provider, use the project ID and header rule ID.
+# - project_id can be found in the project `settings` tab in the Vercel UI.
+terraform import vercel_project_header_rule.example prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx/rt_xxxxxxxxxxxxxxxxxxxxxxxx
+
+# Alternatively, you can import via the team_id, project_id and header rule ID.
+# - team_id can be found in the team `settings` tab in the Vercel UI.
+# - project_id can be found in the project `settings` tab in the Vercel UI.
+terraform import vercel_project_header_rule.example team_xxxxxxxxxxxxxxxxxxxxxxxx/prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx/rt_xxxxxxxxxxxxxxxxxxxxxxxx
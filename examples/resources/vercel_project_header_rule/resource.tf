@@ -0,0 +1,16 @@
+resource "vercel_project" "example" {
+  name = "example-project"
+}
+
+resource "vercel_project_header_rule" "security" {
+  project_id  = vercel_project.example.id
+  name        = "Security headers"
+  description = "Enforced for all projects by the platform team"
+  source      = "/(.*)"
+
+  response_headers = {
+    "Strict-Transport-Security" = "max-age=63072000; includeSubDomains; preload"
+    "Content-Security-Policy"   = "default-src 'self'"
+    "X-Content-Type-Options"    = "nosniff"
+  }
+}
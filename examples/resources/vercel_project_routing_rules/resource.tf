@@ -0,0 +1,31 @@
+resource "vercel_project" "example" {
+  name = "example-project"
+}
+
+resource "vercel_project_routing_rules" "example" {
+  project_id = vercel_project.example.id
+
+  # Rules are evaluated in order, so the more specific source comes first.
+  redirects = [
+    {
+      source      = "/old-blog/archive/(.*)"
+      destination = "/archive/$1"
+    },
+    {
+      source      = "/old-blog/(.*)"
+      destination = "/blog/$1"
+    },
+    {
+      source      = "/spring-sale"
+      destination = "https://example.com/promotions/spring"
+      status_code = 302
+    },
+  ]
+
+  rewrites = [
+    {
+      source      = "/docs/(.*)"
+      destination = "https://docs.example.com/$1"
+    },
+  ]
+}
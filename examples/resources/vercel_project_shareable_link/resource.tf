@@ -0,0 +1,30 @@
+resource "vercel_project" "example" {
+  name = "example-project"
+
+  vercel_authentication = {
+    deployment_type = "standard_protection"
+  }
+}
+
+data "vercel_prebuilt_project" "example" {
+  path = "${path.root}/.vercel/output"
+}
+
+resource "vercel_deployment" "example" {
+  project_id  = vercel_project.example.id
+  path_prefix = data.vercel_prebuilt_project.example.path
+  files       = data.vercel_prebuilt_project.example.output
+}
+
+# A new Shareable Link is generated for every new deployment, and the
+# previous one is revoked.
+resource "vercel_project_shareable_link" "example" {
+  project_id     = vercel_project.example.id
+  note           = "QA"
+  deployment_url = vercel_deployment.example.url
+}
+
+output "qa_link" {
+  value     = vercel_project_shareable_link.example.url
+  sensitive = true
+}
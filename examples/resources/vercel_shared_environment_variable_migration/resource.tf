@@ -0,0 +1,21 @@
+resource "vercel_project" "frontend" {
+  name = "example-frontend"
+}
+
+resource "vercel_project" "backend" {
+  name = "example-backend"
+}
+
+# Moves DATABASE_URL and REDIS_URL, which are currently defined with the same
+# values on both projects, to Shared Environment Variables linked to both.
+resource "vercel_shared_environment_variable_migration" "example" {
+  project_ids = [
+    vercel_project.frontend.id,
+    vercel_project.backend.id,
+  ]
+  keys = ["DATABASE_URL", "REDIS_URL"]
+}
+
+output "shared_environment_variable_ids" {
+  value = vercel_shared_environment_variable_migration.example.shared_environment_variable_ids
+}
@@ -0,0 +1,9 @@
+# If importing with a team configured on the provider, simply use the
+# shared environment variable ID and project ID.
+# - environment variable id can be taken from the network tab inside developer tools, while you are on the project page.
+# - project_id can be found in the project `settings` tab in the Vercel UI.
+terraform import vercel_shared_environment_variable_project_link.example env_yyyyyyyyyyyyy/prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx
+
+# Alternatively, you can import via the team_id, shared environment variable ID and project ID.
+# - team_id can be found in the team `settings` tab in the Vercel UI.
+terraform import vercel_shared_environment_variable_project_link.example team_xxxxxxxxxxxxxxxxxxxxxxxx/env_yyyyyyyyyyyyy/prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx
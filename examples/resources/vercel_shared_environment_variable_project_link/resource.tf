@@ -11,3 +11,16 @@ resource "vercel_shared_environment_variable_project_link" "example" {
   shared_environment_variable_id = data.vercel_shared_environment_variable.example.id
   project_id                     = vercel_project.example.id
 }
+
+# A shared environment variable resource that omits `project_ids`
+# leaves its project links to be managed separately.
+resource "vercel_shared_environment_variable" "platform" {
+  key    = "PLATFORM_ENV_VAR"
+  value  = "bar"
+  target = ["production", "preview"]
+}
+
+resource "vercel_shared_environment_variable_project_link" "platform" {
+  shared_environment_variable_id = vercel_shared_environment_variable.platform.id
+  project_id                     = vercel_project.example.id
+}
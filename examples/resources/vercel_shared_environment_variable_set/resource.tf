@@ -0,0 +1,28 @@
+resource "vercel_project" "frontend" {
+  name = "example-frontend"
+}
+
+resource "vercel_project" "backend" {
+  name = "example-backend"
+}
+
+# Shared environment variables that are linked to
+# both projects, for the production and preview environments.
+resource "vercel_shared_environment_variable_set" "example" {
+  target    = ["production", "preview"]
+  sensitive = true
+  project_ids = [
+    vercel_project.frontend.id,
+    vercel_project.backend.id,
+  ]
+
+  variables = {
+    "DATABASE_URL" = {
+      value   = "postgres://example"
+      comment = "The primary database"
+    }
+    "API_KEY" = {
+      value = "some_secret"
+    }
+  }
+}
@@ -10,7 +10,9 @@ resource "vercel_team_config" "example" {
   description                           = "Vercel Terraform Example"
   sensitive_environment_variable_policy = "off"
   remote_caching = {
-    enabled = true
+    enabled                 = true
+    artifact_retention_days = 30
+    max_artifact_size_mb    = 512
   }
   enable_preview_feedback         = "off"
   enable_production_feedback      = "off"
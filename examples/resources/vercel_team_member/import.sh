@@ -1,2 +1,5 @@
 # To import, use the team_id and user_id.
 terraform import vercel_team_member.example team_xxxxxxxxxxxxxxxxxxxxxxxx/uuuuuuuuuuuuuuuuuuuuuuuuuu
+
+# Alternatively, use the team_id and the email address of the member.
+terraform import vercel_team_member.example team_xxxxxxxxxxxxxxxxxxxxxxxx/someone@example.com
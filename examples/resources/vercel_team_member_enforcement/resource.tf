@@ -0,0 +1,20 @@
+resource "vercel_team_member" "developer" {
+  team_id = "team_xxxxxxxxxxxxxxxxxxxxxxxx"
+  email   = "developer@example.com"
+  role    = "DEVELOPER"
+}
+
+resource "vercel_team_member" "viewer" {
+  team_id = "team_xxxxxxxxxxxxxxxxxxxxxxxx"
+  email   = "viewer@example.com"
+  role    = "VIEWER"
+}
+
+# Any other member of the team is removed.
+resource "vercel_team_member_enforcement" "example" {
+  team_id = "team_xxxxxxxxxxxxxxxxxxxxxxxx"
+  members = [
+    vercel_team_member.developer.user_id,
+    vercel_team_member.viewer.user_id,
+  ]
+}
@@ -2,13 +2,24 @@ package main
 
 import (
 	"context"
+	_ "expvar" // Registers /debug/vars for the debug listener.
 	"log"
+	"net/http"
+	_ "net/http/pprof" // Registers /debug/pprof for the debug listener.
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/vercel/terraform-provider-vercel/v3/vercel"
 )
 
+// debugAddrEnv is the environment variable that enables the debug listener, e.g. `localhost:6060`.
+const debugAddrEnv = "VERCEL_PROVIDER_DEBUG_ADDR"
+
 func main() {
+	if addr := os.Getenv(debugAddrEnv); addr != "" {
+		go serveDebug(addr)
+	}
+
 	err := providerserver.Serve(context.Background(), vercel.New, providerserver.ServeOpts{
 		Address: "registry.terraform.io/vercel/vercel",
 	})
@@ -16,3 +27,12 @@ func main() {
 		log.Fatalf("unable to serve provider: %s", err)
 	}
 }
+
+// serveDebug serves pprof profiles and expvar metrics, including the Vercel API requests in flight, so that slow
+// applies can be inspected while they run. Failing to listen does not stop the provider from serving.
+func serveDebug(addr string) {
+	log.Printf("[INFO] serving provider debug endpoints on http://%s/debug/pprof and http://%s/debug/vars", addr, addr)
+	if err := http.ListenAndServe(addr, nil); err != nil {
+		log.Printf("[ERROR] unable to serve provider debug endpoints on %s: %s", addr, err)
+	}
+}
@@ -0,0 +1,25 @@
+package vercel
+
+import "sync"
+
+// maxConcurrentRequests is the number of API requests a single resource makes at once when working on many items.
+const maxConcurrentRequests = 10
+
+// runConcurrently calls fn for every index from 0 to n-1, running at most limit calls at once. It returns the error
+// from each call, indexed in the same way, once every call has finished.
+func runConcurrently(n, limit int, fn func(i int) error) []error {
+	errs := make([]error, n)
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = fn(i)
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
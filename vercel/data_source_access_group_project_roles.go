@@ -0,0 +1,179 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &accessGroupProjectRolesDataSource{}
+	_ datasource.DataSourceWithConfigure = &accessGroupProjectRolesDataSource{}
+)
+
+func newAccessGroupProjectRolesDataSource() datasource.DataSource {
+	return &accessGroupProjectRolesDataSource{}
+}
+
+type accessGroupProjectRolesDataSource struct {
+	client *client.Client
+}
+
+func (d *accessGroupProjectRolesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_group_project_roles"
+}
+
+func (d *accessGroupProjectRolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (r *accessGroupProjectRolesDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides the Access Groups that grant access to a Project, and the role each of them grants.
+
+This is useful for generating access reviews of a Project from Terraform.
+
+For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/accounts/team-members-and-roles/access-groups).
+`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team the Project exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The Project ID.",
+			},
+			"access_groups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The Access Groups with access to the Project, ordered by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"access_group_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The Access Group ID.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the Access Group.",
+						},
+						"role": schema.StringAttribute{
+							Computed:    true,
+							Description: "The role the Access Group grants on the Project.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type AccessGroupProjectRole struct {
+	AccessGroupID types.String `tfsdk:"access_group_id"`
+	Name          types.String `tfsdk:"name"`
+	Role          types.String `tfsdk:"role"`
+}
+
+type AccessGroupProjectRoles struct {
+	TeamID       types.String             `tfsdk:"team_id"`
+	ProjectID    types.String             `tfsdk:"project_id"`
+	AccessGroups []AccessGroupProjectRole `tfsdk:"access_groups"`
+}
+
+func (d *accessGroupProjectRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config AccessGroupProjectRoles
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accessGroups, err := d.client.ListAccessGroups(ctx, client.ListAccessGroupsRequest{
+		TeamID:    config.TeamID.ValueString(),
+		ProjectID: config.ProjectID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Access Group Project Roles",
+			fmt.Sprintf("Could not list Access Groups for project %s %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				config.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+	sort.Slice(accessGroups, func(i, j int) bool {
+		return accessGroups[i].Name < accessGroups[j].Name
+	})
+
+	roles := []AccessGroupProjectRole{}
+	for _, ag := range accessGroups {
+		out, err := d.client.GetAccessGroupProject(ctx, client.GetAccessGroupProjectRequest{
+			TeamID:        config.TeamID.ValueString(),
+			AccessGroupID: ag.ID,
+			ProjectID:     config.ProjectID.ValueString(),
+		})
+		if client.NotFound(err) {
+			// The access was removed between listing and reading.
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading Access Group Project Roles",
+				fmt.Sprintf("Could not get Access Group Project %s %s %s, unexpected error: %s",
+					config.TeamID.ValueString(),
+					ag.ID,
+					config.ProjectID.ValueString(),
+					err,
+				),
+			)
+			return
+		}
+		roles = append(roles, AccessGroupProjectRole{
+			AccessGroupID: types.StringValue(ag.ID),
+			Name:          types.StringValue(ag.Name),
+			Role:          types.StringValue(out.Role),
+		})
+	}
+
+	result := AccessGroupProjectRoles{
+		TeamID:       toTeamID(d.client.TeamID(config.TeamID.ValueString())),
+		ProjectID:    config.ProjectID,
+		AccessGroups: roles,
+	}
+	tflog.Info(ctx, "read Access Group Project Roles", map[string]any{
+		"team_id":       result.TeamID.ValueString(),
+		"project_id":    result.ProjectID.ValueString(),
+		"access_groups": len(roles),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
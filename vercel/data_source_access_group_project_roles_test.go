@@ -0,0 +1,65 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_AccessGroupProjectRolesDataSource(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccAccessGroupProjectRolesDataSource(name)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_access_group_project_roles.test", "access_groups.#", "2"),
+					resource.TestCheckResourceAttr("data.vercel_access_group_project_roles.test", "access_groups.0.name", fmt.Sprintf("test-acc-a-%s", name)),
+					resource.TestCheckResourceAttr("data.vercel_access_group_project_roles.test", "access_groups.0.role", "ADMIN"),
+					resource.TestCheckResourceAttrPair("data.vercel_access_group_project_roles.test", "access_groups.0.access_group_id", "vercel_access_group.admin", "id"),
+					resource.TestCheckResourceAttr("data.vercel_access_group_project_roles.test", "access_groups.1.name", fmt.Sprintf("test-acc-b-%s", name)),
+					resource.TestCheckResourceAttr("data.vercel_access_group_project_roles.test", "access_groups.1.role", "PROJECT_VIEWER"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccessGroupProjectRolesDataSource(name string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-%[1]s"
+}
+
+resource "vercel_access_group" "admin" {
+  name = "test-acc-a-%[1]s"
+}
+
+resource "vercel_access_group" "viewer" {
+  name = "test-acc-b-%[1]s"
+}
+
+resource "vercel_access_group_project" "admin" {
+  access_group_id = vercel_access_group.admin.id
+  project_id      = vercel_project.test.id
+  role            = "ADMIN"
+}
+
+resource "vercel_access_group_project" "viewer" {
+  access_group_id = vercel_access_group.viewer.id
+  project_id      = vercel_project.test.id
+  role            = "PROJECT_VIEWER"
+}
+
+data "vercel_access_group_project_roles" "test" {
+  project_id = vercel_project.test.id
+  depends_on = [
+    vercel_access_group_project.admin,
+    vercel_access_group_project.viewer,
+  ]
+}
+`, name)
+}
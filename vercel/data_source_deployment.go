@@ -87,18 +87,55 @@ A Deployment is the result of building your Project and making it available thro
 				Description: "The branch or commit hash that has been deployed. Note this will only work if the project is configured to use a Git repository.",
 				Computed:    true,
 			},
+			"meta": schema.MapAttribute{
+				Description: "The key/value tags attached to the deployment.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"git_metadata": schema.SingleNestedAttribute{
+				Description: "The git commit the deployment was created from. Null if the deployment was not created from a git repository.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"provider": schema.StringAttribute{
+						Description: "The git provider, one of `github`, `gitlab` or `bitbucket`.",
+						Computed:    true,
+					},
+					"repo": schema.StringAttribute{
+						Description: "The repository the commit belongs to, e.g. `vercel/next.js`.",
+						Computed:    true,
+					},
+					"ref": schema.StringAttribute{
+						Description: "The branch that was deployed.",
+						Computed:    true,
+					},
+					"sha": schema.StringAttribute{
+						Description: "The SHA of the commit that was deployed.",
+						Computed:    true,
+					},
+					"pull_request_id": schema.StringAttribute{
+						Description: "The ID of the pull request the commit was deployed from, if any.",
+						Computed:    true,
+					},
+					"commit_author": schema.StringAttribute{
+						Description: "The login, or if that is not known the name, of the author of the commit.",
+						Computed:    true,
+					},
+				},
+			},
 		},
 	}
 }
 
 type DeploymentDataSource struct {
-	Domains    types.List   `tfsdk:"domains"`
-	ID         types.String `tfsdk:"id"`
-	Production types.Bool   `tfsdk:"production"`
-	ProjectID  types.String `tfsdk:"project_id"`
-	TeamID     types.String `tfsdk:"team_id"`
-	URL        types.String `tfsdk:"url"`
-	Ref        types.String `tfsdk:"ref"`
+	Domains     types.List   `tfsdk:"domains"`
+	ID          types.String `tfsdk:"id"`
+	Production  types.Bool   `tfsdk:"production"`
+	ProjectID   types.String `tfsdk:"project_id"`
+	TeamID      types.String `tfsdk:"team_id"`
+	URL         types.String `tfsdk:"url"`
+	Ref         types.String `tfsdk:"ref"`
+	Meta        types.Map    `tfsdk:"meta"`
+	GitMetadata types.Object `tfsdk:"git_metadata"`
 }
 
 func convertResponseToDeploymentDataSource(in client.DeploymentResponse) DeploymentDataSource {
@@ -112,13 +149,15 @@ func convertResponseToDeploymentDataSource(in client.DeploymentResponse) Deploym
 		domains = append(domains, types.StringValue(a))
 	}
 	return DeploymentDataSource{
-		Domains:    types.ListValueMust(types.StringType, domains),
-		Production: types.BoolValue(in.Target != nil && *in.Target == "production"),
-		TeamID:     toTeamID(in.TeamID),
-		ProjectID:  types.StringValue(in.ProjectID),
-		ID:         types.StringValue(in.ID),
-		URL:        types.StringValue(in.URL),
-		Ref:        ref,
+		Domains:     types.ListValueMust(types.StringType, domains),
+		Production:  types.BoolValue(in.Target != nil && *in.Target == "production"),
+		TeamID:      toTeamID(in.TeamID),
+		ProjectID:   types.StringValue(in.ProjectID),
+		ID:          types.StringValue(in.ID),
+		URL:         types.StringValue(in.URL),
+		Ref:         ref,
+		Meta:        metaValue(in.Meta),
+		GitMetadata: gitMetadataValue(in.GitMetadata()),
 	}
 }
 
@@ -162,3 +201,42 @@ func (d *deploymentDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 }
+
+// metaValue converts the meta tags of a deployment to a terraform map. Deployments without any tags have an empty map.
+func metaValue(meta map[string]string) types.Map {
+	elements := map[string]attr.Value{}
+	for k, v := range meta {
+		elements[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+var gitMetadataAttrTypes = map[string]attr.Type{
+	"provider":        types.StringType,
+	"repo":            types.StringType,
+	"ref":             types.StringType,
+	"sha":             types.StringType,
+	"pull_request_id": types.StringType,
+	"commit_author":   types.StringType,
+}
+
+// gitMetadataValue converts the git commit a deployment was created from to a terraform object. Unknown fields are null.
+func gitMetadataValue(gm *client.DeploymentGitMetadata) types.Object {
+	if gm == nil {
+		return types.ObjectNull(gitMetadataAttrTypes)
+	}
+	optional := func(v string) types.String {
+		if v == "" {
+			return types.StringNull()
+		}
+		return types.StringValue(v)
+	}
+	return types.ObjectValueMust(gitMetadataAttrTypes, map[string]attr.Value{
+		"provider":        types.StringValue(gm.Provider),
+		"repo":            optional(gm.Repo),
+		"ref":             optional(gm.Ref),
+		"sha":             optional(gm.Sha),
+		"pull_request_id": optional(gm.PullRequestID),
+		"commit_author":   optional(gm.CommitAuthor),
+	})
+}
@@ -0,0 +1,240 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &deploymentDiffDataSource{}
+	_ datasource.DataSourceWithConfigure = &deploymentDiffDataSource{}
+)
+
+func newDeploymentDiffDataSource() datasource.DataSource {
+	return &deploymentDiffDataSource{}
+}
+
+type deploymentDiffDataSource struct {
+	client *client.Client
+}
+
+func (d *deploymentDiffDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_diff"
+}
+
+func (d *deploymentDiffDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Schema returns the schema information for a deployment diff data source
+func (d *deploymentDiffDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides the differences between two existing Deployments.
+
+This compares the build output and the names of the Environment Variables of each Deployment, and is useful for reviewing what will change before promoting a Deployment to production.
+
+Environment Variable values are not exposed by the Vercel API, so only added and removed variable names are reported.
+`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Description: "The Team ID the Deployments belong to. Required when reading a team resource if a default team has not been set in the provider.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"from_deployment_id": schema.StringAttribute{
+				Description: "The ID or URL of the Deployment to compare from. This is typically the Deployment currently serving traffic.",
+				Required:    true,
+			},
+			"to_deployment_id": schema.StringAttribute{
+				Description: "The ID or URL of the Deployment to compare to. This is typically the Deployment about to be promoted.",
+				Required:    true,
+			},
+			"added_files": schema.SetAttribute{
+				Description: "The paths of build output files present in `to_deployment_id` but not in `from_deployment_id`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"removed_files": schema.SetAttribute{
+				Description: "The paths of build output files present in `from_deployment_id` but not in `to_deployment_id`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"modified_files": schema.SetAttribute{
+				Description: "The paths of build output files present in both Deployments whose contents differ.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"added_environment_variables": schema.SetAttribute{
+				Description: "The names of Environment Variables available to `to_deployment_id` but not to `from_deployment_id`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"removed_environment_variables": schema.SetAttribute{
+				Description: "The names of Environment Variables available to `from_deployment_id` but not to `to_deployment_id`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+type DeploymentDiff struct {
+	TeamID                      types.String `tfsdk:"team_id"`
+	FromDeploymentID            types.String `tfsdk:"from_deployment_id"`
+	ToDeploymentID              types.String `tfsdk:"to_deployment_id"`
+	AddedFiles                  types.Set    `tfsdk:"added_files"`
+	RemovedFiles                types.Set    `tfsdk:"removed_files"`
+	ModifiedFiles               types.Set    `tfsdk:"modified_files"`
+	AddedEnvironmentVariables   types.Set    `tfsdk:"added_environment_variables"`
+	RemovedEnvironmentVariables types.Set    `tfsdk:"removed_environment_variables"`
+}
+
+// flattenDeploymentFileTree converts a nested deployment file tree into a map of file path to file UID.
+// Directories are not included, as they only exist to hold other files.
+func flattenDeploymentFileTree(entries []client.DeploymentFileTreeEntry, prefix string, out map[string]string) {
+	for _, e := range entries {
+		p := path.Join(prefix, e.Name)
+		if e.Type == "directory" {
+			flattenDeploymentFileTree(e.Children, p, out)
+			continue
+		}
+		out[p] = e.Type + ":" + e.UID
+	}
+}
+
+// diffStringSets returns the items only in `to`, and the items only in `from`.
+func diffStringSets(from, to []string) (added, removed []string) {
+	for _, v := range to {
+		if !contains(from, v) {
+			added = append(added, v)
+		}
+	}
+	for _, v := range from {
+		if !contains(to, v) {
+			removed = append(removed, v)
+		}
+	}
+	return added, removed
+}
+
+func toStringSet(items []string) types.Set {
+	values := []attr.Value{}
+	for _, v := range items {
+		values = append(values, types.StringValue(v))
+	}
+	return types.SetValueMust(types.StringType, values)
+}
+
+// Read will compare the two deployments by requesting their information from the Vercel API, and will update terraform
+// with the differences.
+// It is called by the provider whenever data source values should be read to update state.
+func (d *deploymentDiffDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DeploymentDiff
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployments := map[string]client.DeploymentResponse{}
+	files := map[string]map[string]string{}
+	for _, id := range []string{config.FromDeploymentID.ValueString(), config.ToDeploymentID.ValueString()} {
+		out, err := d.client.GetDeployment(ctx, id, config.TeamID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading deployment diff",
+				fmt.Sprintf("Could not get deployment %s %s, unexpected error: %s",
+					config.TeamID.ValueString(),
+					id,
+					err,
+				),
+			)
+			return
+		}
+		// The file tree can only be looked up by ID, not URL.
+		tree, err := d.client.GetDeploymentFileTree(ctx, out.ID, config.TeamID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading deployment diff",
+				fmt.Sprintf("Could not get build output for deployment %s %s, unexpected error: %s",
+					config.TeamID.ValueString(),
+					id,
+					err,
+				),
+			)
+			return
+		}
+		deployments[id] = out
+		files[id] = map[string]string{}
+		flattenDeploymentFileTree(tree, "", files[id])
+	}
+
+	fromFiles := files[config.FromDeploymentID.ValueString()]
+	toFiles := files[config.ToDeploymentID.ValueString()]
+	var added, removed, modified []string
+	for p, uid := range toFiles {
+		fromUID, ok := fromFiles[p]
+		if !ok {
+			added = append(added, p)
+			continue
+		}
+		if fromUID != uid {
+			modified = append(modified, p)
+		}
+	}
+	for p := range fromFiles {
+		if _, ok := toFiles[p]; !ok {
+			removed = append(removed, p)
+		}
+	}
+
+	from := deployments[config.FromDeploymentID.ValueString()]
+	to := deployments[config.ToDeploymentID.ValueString()]
+	addedEnv, removedEnv := diffStringSets(from.Environment, to.Environment)
+
+	result := DeploymentDiff{
+		TeamID:                      toTeamID(to.TeamID),
+		FromDeploymentID:            config.FromDeploymentID,
+		ToDeploymentID:              config.ToDeploymentID,
+		AddedFiles:                  toStringSet(added),
+		RemovedFiles:                toStringSet(removed),
+		ModifiedFiles:               toStringSet(modified),
+		AddedEnvironmentVariables:   toStringSet(addedEnv),
+		RemovedEnvironmentVariables: toStringSet(removedEnv),
+	}
+	tflog.Info(ctx, "read deployment diff", map[string]any{
+		"team_id":            result.TeamID.ValueString(),
+		"from_deployment_id": result.FromDeploymentID.ValueString(),
+		"to_deployment_id":   result.ToDeploymentID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,67 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DeploymentDiffDataSource(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccDeploymentDiffDataSourceConfig(name)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vercel_deployment_diff.test", "added_files.#"),
+					resource.TestCheckResourceAttrSet("data.vercel_deployment_diff.test", "removed_files.#"),
+					resource.TestCheckResourceAttr("data.vercel_deployment_diff.test", "added_environment_variables.#", "0"),
+					resource.TestCheckResourceAttr("data.vercel_deployment_diff.test", "removed_environment_variables.#", "0"),
+					resource.TestCheckResourceAttr("data.vercel_deployment_diff.same", "added_files.#", "0"),
+					resource.TestCheckResourceAttr("data.vercel_deployment_diff.same", "removed_files.#", "0"),
+					resource.TestCheckResourceAttr("data.vercel_deployment_diff.same", "modified_files.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeploymentDiffDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-deployment-diff-%[1]s"
+}
+
+data "vercel_file" "index" {
+  path = "examples/one/index.html"
+}
+
+data "vercel_prebuilt_project" "test" {
+  path = "examples/two"
+}
+
+resource "vercel_deployment" "from" {
+  project_id = vercel_project.test.id
+  files      = data.vercel_file.index.file
+}
+
+resource "vercel_deployment" "to" {
+  project_id  = vercel_project.test.id
+  files       = data.vercel_prebuilt_project.test.output
+  path_prefix = data.vercel_prebuilt_project.test.path
+}
+
+data "vercel_deployment_diff" "test" {
+  from_deployment_id = vercel_deployment.from.id
+  to_deployment_id   = vercel_deployment.to.id
+}
+
+data "vercel_deployment_diff" "same" {
+  from_deployment_id = vercel_deployment.to.id
+  to_deployment_id   = vercel_deployment.to.url
+}
+`, name)
+}
@@ -0,0 +1,199 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &deploymentsDataSource{}
+	_ datasource.DataSourceWithConfigure = &deploymentsDataSource{}
+)
+
+func newDeploymentsDataSource() datasource.DataSource {
+	return &deploymentsDataSource{}
+}
+
+type deploymentsDataSource struct {
+	client *client.Client
+}
+
+func (d *deploymentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployments"
+}
+
+func (d *deploymentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Schema returns the schema information for a deployments data source
+func (d *deploymentsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a list of the recent Deployments of a Project.
+
+Deployments can be filtered by the ` + "`meta`" + ` tags they were created with, for example to find the Deployments created by a particular Terraform workspace or for a particular git commit. At most the 100 most recent matching Deployments are returned.
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the project.",
+			},
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team the project exists under. Required when reading a team resource if a default team has not been set in the provider.",
+			},
+			"meta": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only list Deployments that have all of these key/value tags.",
+			},
+			"deployments": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching Deployments, most recent first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the Deployment.",
+						},
+						"url": schema.StringAttribute{
+							Computed:    true,
+							Description: "A unique URL that is automatically generated for the Deployment.",
+						},
+						"production": schema.BoolAttribute{
+							Computed:    true,
+							Description: "true if the Deployment is a production Deployment.",
+						},
+						"state": schema.StringAttribute{
+							Computed:    true,
+							Description: "The state of the Deployment, e.g. `BUILDING`, `READY` or `ERROR`.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The time the Deployment was created, in RFC 3339 format.",
+						},
+						"meta": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The key/value tags attached to the Deployment.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type ListedDeployment struct {
+	ID         types.String `tfsdk:"id"`
+	URL        types.String `tfsdk:"url"`
+	Production types.Bool   `tfsdk:"production"`
+	State      types.String `tfsdk:"state"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	Meta       types.Map    `tfsdk:"meta"`
+}
+
+type Deployments struct {
+	ProjectID   types.String       `tfsdk:"project_id"`
+	TeamID      types.String       `tfsdk:"team_id"`
+	Meta        types.Map          `tfsdk:"meta"`
+	Deployments []ListedDeployment `tfsdk:"deployments"`
+}
+
+// hasMeta checks whether a deployment has every one of the given meta tags.
+func hasMeta(meta, filter map[string]string) bool {
+	for k, v := range filter {
+		if got, ok := meta[k]; !ok || got != v {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *deploymentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config Deployments
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var filter map[string]types.String
+	diags = config.Meta.ElementsAs(ctx, &filter, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	meta := filterNullFromMap(filter)
+
+	out, err := d.client.ListDeployments(ctx, client.ListDeploymentsRequest{
+		ProjectID: config.ProjectID.ValueString(),
+		TeamID:    config.TeamID.ValueString(),
+		Meta:      meta,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading deployments",
+			fmt.Sprintf("Could not read deployments for project %s %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				config.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	deployments := []ListedDeployment{}
+	for _, dpl := range out {
+		// The API filters by meta already, but the filter is applied again in case a tag was ignored.
+		if !hasMeta(dpl.Meta, meta) {
+			continue
+		}
+		deployments = append(deployments, ListedDeployment{
+			ID:         types.StringValue(dpl.ID),
+			URL:        types.StringValue(dpl.URL),
+			Production: types.BoolValue(dpl.Target != nil && *dpl.Target == "production"),
+			State:      types.StringValue(dpl.State),
+			CreatedAt:  timestampValue(dpl.CreatedAt),
+			Meta:       metaValue(dpl.Meta),
+		})
+	}
+
+	result := Deployments{
+		ProjectID:   config.ProjectID,
+		TeamID:      toTeamID(d.client.TeamID(config.TeamID.ValueString())),
+		Meta:        config.Meta,
+		Deployments: deployments,
+	}
+	tflog.Info(ctx, "read deployments", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"count":      len(deployments),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,200 @@
+package vercel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+var (
+	_ datasource.DataSource              = &domainDelegationDataSource{}
+	_ datasource.DataSourceWithConfigure = &domainDelegationDataSource{}
+)
+
+func newDomainDelegationDataSource() datasource.DataSource {
+	return &domainDelegationDataSource{}
+}
+
+type domainDelegationDataSource struct {
+	client *client.Client
+}
+
+func (d *domainDelegationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_delegation"
+}
+
+func (d *domainDelegationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *domainDelegationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Checks whether a domain is delegated to Vercel's nameservers.
+
+The domain's NS records are looked up in public DNS and compared with the nameservers Vercel expects the domain to use. This can be used as a precondition before creating ` + "`vercel_dns_record`" + ` resources, which have no effect until the domain is delegated.
+`,
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				Required:    true,
+				Description: "The apex domain to check, e.g. `example.com`.",
+			},
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team the domain belongs to. Required when configuring a team data source if a default team has not been set in the provider.",
+			},
+			"resolver": schema.StringAttribute{
+				Optional:    true,
+				Description: "The address of the DNS server to query, as `host:port`, e.g. `1.1.1.1:53`. Defaults to the system resolver.",
+			},
+			"intended_nameservers": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The nameservers Vercel expects the domain to be delegated to. If the domain has not been added to Vercel, Vercel's default nameservers are used.",
+			},
+			"nameservers": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The nameservers the domain is currently delegated to, according to public DNS.",
+			},
+			"delegated": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether every nameserver the domain is delegated to is one of `intended_nameservers`.",
+			},
+		},
+	}
+}
+
+type DomainDelegation struct {
+	Domain              types.String `tfsdk:"domain"`
+	TeamID              types.String `tfsdk:"team_id"`
+	Resolver            types.String `tfsdk:"resolver"`
+	IntendedNameservers types.List   `tfsdk:"intended_nameservers"`
+	Nameservers         types.List   `tfsdk:"nameservers"`
+	Delegated           types.Bool   `tfsdk:"delegated"`
+}
+
+var defaultVercelNameservers = []string{"ns1.vercel-dns.com", "ns2.vercel-dns.com"}
+
+// normaliseNameservers lower-cases nameservers, removes the trailing dot of fully qualified names and sorts them.
+func normaliseNameservers(nameservers []string) []string {
+	out := make([]string, 0, len(nameservers))
+	for _, ns := range nameservers {
+		out = append(out, strings.TrimSuffix(strings.ToLower(ns), "."))
+	}
+	sort.Strings(out)
+	return out
+}
+
+func lookupNameservers(ctx context.Context, domain, resolver string) ([]string, error) {
+	r := net.DefaultResolver
+	if resolver != "" {
+		r = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, network, resolver)
+			},
+		}
+	}
+	records, err := r.LookupNS(ctx, domain)
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var nameservers []string
+	for _, ns := range records {
+		nameservers = append(nameservers, ns.Host)
+	}
+	return normaliseNameservers(nameservers), nil
+}
+
+func (d *domainDelegationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DomainDelegation
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	intended := defaultVercelNameservers
+	domain, err := d.client.GetDomain(ctx, config.Domain.ValueString(), config.TeamID.ValueString())
+	if err != nil && !client.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error reading domain delegation",
+			fmt.Sprintf("Could not read domain %s, unexpected error: %s", config.Domain.ValueString(), err),
+		)
+		return
+	}
+	if len(domain.IntendedNameservers) > 0 {
+		intended = domain.IntendedNameservers
+	}
+	intended = normaliseNameservers(intended)
+
+	nameservers, err := lookupNameservers(ctx, config.Domain.ValueString(), config.Resolver.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading domain delegation",
+			fmt.Sprintf("Could not look up the nameservers of %s, unexpected error: %s", config.Domain.ValueString(), err),
+		)
+		return
+	}
+
+	delegated := len(nameservers) > 0
+	for _, ns := range nameservers {
+		if !contains(intended, ns) {
+			delegated = false
+		}
+	}
+
+	intendedValue, diags := types.ListValueFrom(ctx, types.StringType, intended)
+	resp.Diagnostics.Append(diags...)
+	nameserversValue, diags := types.ListValueFrom(ctx, types.StringType, nameservers)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result := DomainDelegation{
+		Domain:              config.Domain,
+		TeamID:              toTeamID(d.client.TeamID(config.TeamID.ValueString())),
+		Resolver:            config.Resolver,
+		IntendedNameservers: intendedValue,
+		Nameservers:         nameserversValue,
+		Delegated:           types.BoolValue(delegated),
+	}
+	tflog.Info(ctx, "read domain delegation", map[string]any{
+		"domain":      config.Domain.ValueString(),
+		"nameservers": nameservers,
+		"delegated":   delegated,
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
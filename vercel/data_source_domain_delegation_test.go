@@ -0,0 +1,31 @@
+package vercel_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DomainDelegationDataSource(t *testing.T) {
+	resourceName := "data.vercel_domain_delegation.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(`
+data "vercel_domain_delegation" "test" {
+  domain   = "example.com"
+  resolver = "1.1.1.1:53"
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "delegated", "false"),
+					resource.TestCheckResourceAttr(resourceName, "intended_nameservers.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "intended_nameservers.0", "ns1.vercel-dns.com"),
+					resource.TestCheckResourceAttrSet(resourceName, "nameservers.0"),
+				),
+			},
+		},
+	})
+}
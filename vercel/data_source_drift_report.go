@@ -0,0 +1,304 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &driftReportDataSource{}
+	_ datasource.DataSourceWithConfigure = &driftReportDataSource{}
+)
+
+func newDriftReportDataSource() datasource.DataSource {
+	return &driftReportDataSource{}
+}
+
+type driftReportDataSource struct {
+	client *client.Client
+}
+
+func (d *driftReportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_drift_report"
+}
+
+func (d *driftReportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Schema returns the schema information for a drift report data source
+func (d *driftReportDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a report of how a set of Projects deviate from a baseline configuration.
+
+This is intended for governance across many Projects, such as building dashboards from Terraform outputs. Only the settings specified in the ` + "`baseline`" + ` are compared.
+`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Description: "The ID of the team the Projects exist under. Required when reading a team resource if a default team has not been set in the provider.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"project_ids": schema.SetAttribute{
+				Description: "The IDs of the Projects to compare against the baseline.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"baseline": schema.SingleNestedAttribute{
+				Description: "The expected configuration for every Project. Settings that are not specified are not compared.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"framework": schema.StringAttribute{
+						Description: "The framework every Project is expected to use.",
+						Optional:    true,
+					},
+					"node_version": schema.StringAttribute{
+						Description: "The Node.js version every Project is expected to use.",
+						Optional:    true,
+					},
+					"vercel_authentication": schema.StringAttribute{
+						Description: "The expected Vercel Authentication deployment type. Must be one of `standard_protection`, `all_deployments`, `only_preview_deployments`, or `none`.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("standard_protection", "all_deployments", "only_preview_deployments", "none"),
+						},
+					},
+					"password_protection": schema.StringAttribute{
+						Description: "The expected Password Protection deployment type. Must be one of `standard_protection`, `all_deployments`, `only_preview_deployments`, or `none`.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("standard_protection", "all_deployments", "only_preview_deployments", "none"),
+						},
+					},
+					"environment_variable_keys": schema.SetAttribute{
+						Description: "The names of Environment Variables that every Project is expected to define.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+				},
+			},
+			"deviations": schema.ListNestedAttribute{
+				Description: "Every setting that differs from the baseline, ordered by Project ID and setting.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"project_id": schema.StringAttribute{
+							Description: "The ID of the Project.",
+							Computed:    true,
+						},
+						"project_name": schema.StringAttribute{
+							Description: "The name of the Project.",
+							Computed:    true,
+						},
+						"setting": schema.StringAttribute{
+							Description: "The setting that deviates. Missing Environment Variables are reported as `environment_variable.<KEY>`.",
+							Computed:    true,
+						},
+						"expected": schema.StringAttribute{
+							Description: "The value from the baseline.",
+							Computed:    true,
+						},
+						"actual": schema.StringAttribute{
+							Description: "The value configured on the Project.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"drifted_project_ids": schema.SetAttribute{
+				Description: "The IDs of the Projects that have at least one deviation.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+type DriftReportBaseline struct {
+	Framework               types.String `tfsdk:"framework"`
+	NodeVersion             types.String `tfsdk:"node_version"`
+	VercelAuthentication    types.String `tfsdk:"vercel_authentication"`
+	PasswordProtection      types.String `tfsdk:"password_protection"`
+	EnvironmentVariableKeys types.Set    `tfsdk:"environment_variable_keys"`
+}
+
+type DriftReportDeviation struct {
+	ProjectID   types.String `tfsdk:"project_id"`
+	ProjectName types.String `tfsdk:"project_name"`
+	Setting     types.String `tfsdk:"setting"`
+	Expected    types.String `tfsdk:"expected"`
+	Actual      types.String `tfsdk:"actual"`
+}
+
+type DriftReport struct {
+	TeamID            types.String           `tfsdk:"team_id"`
+	ProjectIDs        types.Set              `tfsdk:"project_ids"`
+	Baseline          *DriftReportBaseline   `tfsdk:"baseline"`
+	Deviations        []DriftReportDeviation `tfsdk:"deviations"`
+	DriftedProjectIDs types.Set              `tfsdk:"drifted_project_ids"`
+}
+
+// projectDeviations compares a single project against the baseline.
+func projectDeviations(baseline *DriftReportBaseline, requiredKeys []string, project client.ProjectResponse, envs []client.EnvironmentVariable) []DriftReportDeviation {
+	var deviations []DriftReportDeviation
+	add := func(setting, expected, actual string) {
+		deviations = append(deviations, DriftReportDeviation{
+			ProjectID:   types.StringValue(project.ID),
+			ProjectName: types.StringValue(project.Name),
+			Setting:     types.StringValue(setting),
+			Expected:    types.StringValue(expected),
+			Actual:      types.StringValue(actual),
+		})
+	}
+	deref := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+
+	if !baseline.Framework.IsNull() && baseline.Framework.ValueString() != deref(project.Framework) {
+		add("framework", baseline.Framework.ValueString(), deref(project.Framework))
+	}
+	if !baseline.NodeVersion.IsNull() && baseline.NodeVersion.ValueString() != project.NodeVersion {
+		add("node_version", baseline.NodeVersion.ValueString(), project.NodeVersion)
+	}
+	if !baseline.VercelAuthentication.IsNull() {
+		actual := "none"
+		if project.VercelAuthentication != nil {
+			actual = fromApiDeploymentProtectionType(project.VercelAuthentication.DeploymentType).ValueString()
+		}
+		if baseline.VercelAuthentication.ValueString() != actual {
+			add("vercel_authentication", baseline.VercelAuthentication.ValueString(), actual)
+		}
+	}
+	if !baseline.PasswordProtection.IsNull() {
+		actual := "none"
+		if project.PasswordProtection != nil {
+			actual = fromApiDeploymentProtectionType(project.PasswordProtection.DeploymentType).ValueString()
+		}
+		if baseline.PasswordProtection.ValueString() != actual {
+			add("password_protection", baseline.PasswordProtection.ValueString(), actual)
+		}
+	}
+
+	keys := map[string]struct{}{}
+	for _, e := range envs {
+		keys[e.Key] = struct{}{}
+	}
+	for _, k := range requiredKeys {
+		if _, ok := keys[k]; !ok {
+			add("environment_variable."+k, "present", "missing")
+		}
+	}
+	return deviations
+}
+
+// Read will compare each project against the baseline by requesting its configuration from the Vercel API, and will update terraform
+// with the resulting report.
+// It is called by the provider whenever data source values should be read to update state.
+func (d *driftReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DriftReport
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var projectIDs []string
+	diags = config.ProjectIDs.ElementsAs(ctx, &projectIDs, false)
+	resp.Diagnostics.Append(diags...)
+	var requiredKeys []string
+	if !config.Baseline.EnvironmentVariableKeys.IsNull() {
+		diags = config.Baseline.EnvironmentVariableKeys.ElementsAs(ctx, &requiredKeys, false)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	sort.Strings(projectIDs)
+	sort.Strings(requiredKeys)
+
+	deviations := []DriftReportDeviation{}
+	drifted := []attr.Value{}
+	for _, projectID := range projectIDs {
+		project, err := d.client.GetProject(ctx, projectID, config.TeamID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading drift report",
+				fmt.Sprintf("Could not read project %s %s, unexpected error: %s",
+					config.TeamID.ValueString(),
+					projectID,
+					err,
+				),
+			)
+			return
+		}
+		var envs []client.EnvironmentVariable
+		if len(requiredKeys) > 0 {
+			envs, err = d.client.GetEnvironmentVariables(ctx, project.ID, config.TeamID.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error reading drift report",
+					fmt.Sprintf("Could not read environment variables for project %s %s, unexpected error: %s",
+						config.TeamID.ValueString(),
+						projectID,
+						err,
+					),
+				)
+				return
+			}
+		}
+
+		found := projectDeviations(config.Baseline, requiredKeys, project, envs)
+		if len(found) > 0 {
+			drifted = append(drifted, types.StringValue(projectID))
+		}
+		deviations = append(deviations, found...)
+	}
+
+	result := DriftReport{
+		TeamID:            toTeamID(d.client.TeamID(config.TeamID.ValueString())),
+		ProjectIDs:        config.ProjectIDs,
+		Baseline:          config.Baseline,
+		Deviations:        deviations,
+		DriftedProjectIDs: types.SetValueMust(types.StringType, drifted),
+	}
+	tflog.Info(ctx, "read drift report", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"projects":   len(projectIDs),
+		"deviations": len(deviations),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,65 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DriftReportDataSource(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccDriftReportDataSourceConfig(name)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_drift_report.test", "drifted_project_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("data.vercel_drift_report.test", "drifted_project_ids.*", "vercel_project.drifted", "id"),
+					resource.TestCheckResourceAttr("data.vercel_drift_report.test", "deviations.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.vercel_drift_report.test", "deviations.*", map[string]string{
+						"setting":  "framework",
+						"expected": "nextjs",
+						"actual":   "vite",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.vercel_drift_report.test", "deviations.*", map[string]string{
+						"setting":  "environment_variable.FOO",
+						"expected": "present",
+						"actual":   "missing",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDriftReportDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "compliant" {
+  name      = "test-acc-drift-report-ok-%[1]s"
+  framework = "nextjs"
+  environment = [
+    {
+      key    = "FOO"
+      value  = "bar"
+      target = ["production"]
+    }
+  ]
+}
+
+resource "vercel_project" "drifted" {
+  name      = "test-acc-drift-report-bad-%[1]s"
+  framework = "vite"
+}
+
+data "vercel_drift_report" "test" {
+  project_ids = [vercel_project.compliant.id, vercel_project.drifted.id]
+  baseline = {
+    framework                 = "nextjs"
+    environment_variable_keys = ["FOO"]
+  }
+}
+`, name)
+}
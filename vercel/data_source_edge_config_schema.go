@@ -7,7 +7,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/vercel/terraform-provider-vercel/v3/client"
 )
@@ -60,6 +59,7 @@ An Edge Config Schema provides an existing Edge Config with a JSON schema. Use s
 			},
 			"definition": schema.StringAttribute{
 				Computed:    true,
+				CustomType:  jsonStringType{},
 				Description: "A JSON schema that will be used to validate data in the Edge Config.",
 			},
 			"team_id": schema.StringAttribute{
@@ -105,7 +105,7 @@ func (d *edgeConfigSchemaDataSource) Read(ctx context.Context, req datasource.Re
 		)
 		return
 	}
-	result := responseToEdgeConfigSchema(out, types.StringValue(string(def)))
+	result := responseToEdgeConfigSchema(out, newJSONStringValue(string(def)))
 	tflog.Info(ctx, "read edge config schema", map[string]any{
 		"team_id":        result.TeamID.ValueString(),
 		"edge_config_id": result.ID.ValueString(),
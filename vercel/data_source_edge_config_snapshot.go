@@ -0,0 +1,154 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &edgeConfigSnapshotDataSource{}
+	_ datasource.DataSourceWithConfigure = &edgeConfigSnapshotDataSource{}
+)
+
+func newEdgeConfigSnapshotDataSource() datasource.DataSource {
+	return &edgeConfigSnapshotDataSource{}
+}
+
+type edgeConfigSnapshotDataSource struct {
+	client *client.Client
+}
+
+func (d *edgeConfigSnapshotDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_edge_config_snapshot"
+}
+
+func (d *edgeConfigSnapshotDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Schema returns the schema information for an edgeConfigSnapshot data source
+func (r *edgeConfigSnapshotDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a snapshot of every item within an existing Edge Config, along with the digest of the Edge Config.
+
+An Edge Config is a global data store that enables experimentation with feature flags, A/B testing, critical redirects, and more.
+
+The snapshot can be stored (for example as a Terraform output) and compared against the ` + "`digest`" + ` of a later snapshot to detect changes. To roll the Edge Config back to a stored snapshot, use the ` + "`vercel_edge_config_snapshot_restore`" + ` resource.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the Edge Config to snapshot.",
+				Required:    true,
+			},
+			"team_id": schema.StringAttribute{
+				Description: "The ID of the team the Edge Config exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"digest": schema.StringAttribute{
+				Description: "The digest of the Edge Config at the time the snapshot was taken. This changes whenever any item within the Edge Config changes.",
+				Computed:    true,
+			},
+			"items": schema.MapAttribute{
+				Description: "A map of every key within the Edge Config to its value. Values are JSON encoded, so should be decoded with `jsondecode`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+type EdgeConfigSnapshot struct {
+	ID     types.String `tfsdk:"id"`
+	TeamID types.String `tfsdk:"team_id"`
+	Digest types.String `tfsdk:"digest"`
+	Items  types.Map    `tfsdk:"items"`
+}
+
+// Read will read all of the items within an Edge Config by requesting them from the Vercel API, and will update
+// terraform with this information.
+// It is called by the provider whenever data source values should be read to update state.
+func (d *edgeConfigSnapshotDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config EdgeConfigSnapshot
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ec, err := d.client.GetEdgeConfig(ctx, config.ID.ValueString(), config.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Edge Config",
+			fmt.Sprintf("Could not get Edge Config %s %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				config.ID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	out, err := d.client.ListEdgeConfigItems(ctx, config.ID.ValueString(), config.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Edge Config Items",
+			fmt.Sprintf("Could not list Edge Config Items %s %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				config.ID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	items := map[string]string{}
+	for _, item := range out {
+		items[item.Key] = string(item.Value)
+	}
+	itemsMap, diags := types.MapValueFrom(ctx, types.StringType, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result := EdgeConfigSnapshot{
+		ID:     types.StringValue(ec.ID),
+		TeamID: toTeamID(ec.TeamID),
+		Digest: types.StringValue(ec.Digest),
+		Items:  itemsMap,
+	}
+	tflog.Info(ctx, "read edge config snapshot", map[string]any{
+		"edge_config_id": result.ID.ValueString(),
+		"team_id":        result.TeamID.ValueString(),
+		"digest":         result.Digest.ValueString(),
+		"items":          len(items),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
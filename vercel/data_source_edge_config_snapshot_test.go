@@ -0,0 +1,54 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_EdgeConfigSnapshotDataSource(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccEdgeConfigSnapshotDataSourceConfig(name)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vercel_edge_config_snapshot.test", "id"),
+					resource.TestCheckResourceAttrSet("data.vercel_edge_config_snapshot.test", "team_id"),
+					resource.TestCheckResourceAttrSet("data.vercel_edge_config_snapshot.test", "digest"),
+					resource.TestCheckResourceAttr("data.vercel_edge_config_snapshot.test", "items.%", "2"),
+					resource.TestCheckResourceAttr("data.vercel_edge_config_snapshot.test", "items.foo", `"bar"`),
+					resource.TestCheckResourceAttr("data.vercel_edge_config_snapshot.test", "items.baz", `"qux"`),
+				),
+			},
+		},
+	})
+}
+
+func testAccEdgeConfigSnapshotDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "vercel_edge_config" "test" {
+    name         = "%[1]s"
+}
+
+resource "vercel_edge_config_item" "foo" {
+    edge_config_id = vercel_edge_config.test.id
+    key = "foo"
+    value = "bar"
+}
+
+resource "vercel_edge_config_item" "baz" {
+    edge_config_id = vercel_edge_config.test.id
+    key = "baz"
+    value = "qux"
+}
+
+data "vercel_edge_config_snapshot" "test" {
+    id = vercel_edge_config.test.id
+    depends_on = [vercel_edge_config_item.foo, vercel_edge_config_item.baz]
+}
+`, name)
+}
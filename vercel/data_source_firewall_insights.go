@@ -0,0 +1,241 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &firewallInsightsDataSource{}
+	_ datasource.DataSourceWithConfigure = &firewallInsightsDataSource{}
+)
+
+func newFirewallInsightsDataSource() datasource.DataSource {
+	return &firewallInsightsDataSource{}
+}
+
+type firewallInsightsDataSource struct {
+	client *client.Client
+}
+
+func (d *firewallInsightsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_insights"
+}
+
+func (d *firewallInsightsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Schema returns the schema information for a firewall insights data source
+func (d *firewallInsightsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides the number of requests the Vercel Firewall of a Project has blocked or challenged over a recent time window.
+
+Counts are broken down by custom rule, so noisy rules can be identified and tuned. Requests blocked or challenged by managed rulesets, IP rules or system mitigations are only included in the totals.
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the project.",
+			},
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team the project exists under. Required when reading a team resource if a default team has not been set in the provider.",
+			},
+			"window": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "How far back from now to count requests, as a duration such as `1h` or `24h`. Defaults to `24h`.",
+				Validators:  []validator.String{validateDuration()},
+			},
+			"blocked_requests": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The total number of requests that were blocked within the window.",
+			},
+			"challenged_requests": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The total number of requests that were challenged within the window.",
+			},
+			"rules": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The request counts for each custom rule in the active firewall configuration, in the order the rules are evaluated.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the rule.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the rule.",
+						},
+						"active": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the rule is currently active.",
+						},
+						"blocked_requests": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of requests the rule blocked within the window.",
+						},
+						"challenged_requests": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of requests the rule challenged within the window.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type FirewallRuleInsights struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Active             types.Bool   `tfsdk:"active"`
+	BlockedRequests    types.Int64  `tfsdk:"blocked_requests"`
+	ChallengedRequests types.Int64  `tfsdk:"challenged_requests"`
+}
+
+type FirewallInsights struct {
+	ProjectID          types.String           `tfsdk:"project_id"`
+	TeamID             types.String           `tfsdk:"team_id"`
+	Window             types.String           `tfsdk:"window"`
+	BlockedRequests    types.Int64            `tfsdk:"blocked_requests"`
+	ChallengedRequests types.Int64            `tfsdk:"challenged_requests"`
+	Rules              []FirewallRuleInsights `tfsdk:"rules"`
+}
+
+type firewallActionCounts struct {
+	blocked    int64
+	challenged int64
+}
+
+func (c *firewallActionCounts) add(e client.FirewallEvent) {
+	switch e.ActionType {
+	case "deny":
+		c.blocked += e.Count
+	case "challenge":
+		c.challenged += e.Count
+	}
+}
+
+func (d *firewallInsightsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config FirewallInsights
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window := "24h"
+	if !config.Window.IsNull() {
+		window = config.Window.ValueString()
+	}
+	// The window has already been validated by the schema.
+	duration, _ := time.ParseDuration(window)
+
+	firewallConfig, err := d.client.GetFirewallConfig(ctx, config.ProjectID.ValueString(), config.TeamID.ValueString())
+	if err != nil && !client.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error reading firewall insights",
+			fmt.Sprintf("Could not read firewall configuration for project %s %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				config.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	end := time.Now()
+	events, err := d.client.GetFirewallEvents(ctx, client.GetFirewallEventsRequest{
+		ProjectID: config.ProjectID.ValueString(),
+		TeamID:    config.TeamID.ValueString(),
+		Start:     end.Add(-duration),
+		End:       end,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading firewall insights",
+			fmt.Sprintf("Could not read firewall events for project %s %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				config.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	var total firewallActionCounts
+	byRule := map[string]*firewallActionCounts{}
+	for _, e := range events {
+		total.add(e)
+		if e.RuleID == "" {
+			continue
+		}
+		if _, ok := byRule[e.RuleID]; !ok {
+			byRule[e.RuleID] = &firewallActionCounts{}
+		}
+		byRule[e.RuleID].add(e)
+	}
+
+	rules := []FirewallRuleInsights{}
+	for _, r := range firewallConfig.Rules {
+		counts := firewallActionCounts{}
+		if c, ok := byRule[r.ID]; ok {
+			counts = *c
+		}
+		rules = append(rules, FirewallRuleInsights{
+			ID:                 types.StringValue(r.ID),
+			Name:               types.StringValue(r.Name),
+			Active:             types.BoolValue(r.Active),
+			BlockedRequests:    types.Int64Value(counts.blocked),
+			ChallengedRequests: types.Int64Value(counts.challenged),
+		})
+	}
+
+	result := FirewallInsights{
+		ProjectID:          config.ProjectID,
+		TeamID:             toTeamID(d.client.TeamID(config.TeamID.ValueString())),
+		Window:             types.StringValue(window),
+		BlockedRequests:    types.Int64Value(total.blocked),
+		ChallengedRequests: types.Int64Value(total.challenged),
+		Rules:              rules,
+	}
+	tflog.Info(ctx, "read firewall insights", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"window":     window,
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
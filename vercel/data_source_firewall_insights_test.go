@@ -0,0 +1,91 @@
+package vercel_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_FirewallInsightsDataSource(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccFirewallInsightsDataSourceConfig(name)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_firewall_insights.test", "window", "1h"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_insights.test", "blocked_requests", "0"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_insights.test", "challenged_requests", "0"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_insights.test", "rules.#", "1"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_insights.test", "rules.0.name", "block-test"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_insights.test", "rules.0.blocked_requests", "0"),
+					resource.TestCheckResourceAttrSet("data.vercel_firewall_insights.test", "rules.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_FirewallInsightsDataSourceInvalidWindow(t *testing.T) {
+	config := func(window string) string {
+		return fmt.Sprintf(`
+data "vercel_firewall_insights" "test" {
+  project_id = "prj_doesnotexist"
+  window     = "%s"
+}
+`, window)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      cfg(config("a day")),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("could not be parsed"),
+			},
+			{
+				Config:      cfg(config("-1h")),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Value must be a positive duration"),
+			},
+		},
+	})
+}
+
+func testAccFirewallInsightsDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-firewall-insights-%[1]s"
+}
+
+resource "vercel_firewall_config" "test" {
+  project_id = vercel_project.test.id
+
+  rules {
+    rule {
+      name = "block-test"
+      action = {
+        action = "deny"
+      }
+      condition_group = [{
+        conditions = [{
+          type  = "path"
+          op    = "eq"
+          value = "/test"
+        }]
+      }]
+    }
+  }
+}
+
+data "vercel_firewall_insights" "test" {
+  project_id = vercel_firewall_config.test.project_id
+  window     = "1h"
+}
+`, name)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -68,15 +69,21 @@ For more detailed information, please see the [Vercel documentation](https://ver
 				Description: "The team ID the project exists beneath. Required when configuring a team resource if a default team has not been set in the provider.",
 			},
 			"name": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 52),
 					stringvalidator.RegexMatches(
 						regexp.MustCompile(`^[a-z0-9\-]{0,100}$`),
 						"The name of a Project can only contain up to 100 alphanumeric lowercase characters and hyphens",
 					),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name"), path.MatchRoot("domain")),
 				},
-				Description: "The name of the project.",
+				Description: "The name of the project. Exactly one of `name` or `domain` must be set.",
+			},
+			"domain": schema.StringAttribute{
+				Optional:    true,
+				Description: "A domain assigned to the project, such as `www.example.com`, to look the project up by instead of its `name`. The domain must be assigned to a deployment of the project. Exactly one of `name` or `domain` must be set.",
 			},
 			"build_command": schema.StringAttribute{
 				Computed:    true,
@@ -283,6 +290,16 @@ For more detailed information, please see the [Vercel documentation](https://ver
 					},
 				},
 			},
+			"git_provider_options": schema.SingleNestedAttribute{
+				Description: "Configuration for how Vercel interacts with the connected Git provider.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"create_deployments": schema.BoolAttribute{
+						Description: "Whether Vercel creates Deployments on the Git provider. These surface as deployment status updates on pull requests and commits.",
+						Computed:    true,
+					},
+				},
+			},
 			"preview_comments": schema.BoolAttribute{
 				Computed:           true,
 				DeprecationMessage: "Use `enable_preview_feedback` instead. This attribute will be removed in a future version.",
@@ -320,10 +337,23 @@ For more detailed information, please see the [Vercel documentation](https://ver
 				Computed:    true,
 				Description: "If enabled, builds for the Production environment will be prioritized over Preview environments.",
 			},
+			"cancel_outdated_builds": schema.BoolAttribute{
+				Computed:    true,
+				Description: "When enabled, a new Deployment cancels any builds for the same branch that are still queued or in progress.",
+			},
 			"directory_listing": schema.BoolAttribute{
 				Computed:    true,
 				Description: "If no index file is present within a directory, the directory contents will be displayed.",
 			},
+			"related_projects": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of other Projects in the same team that are related to this Project, such as other applications in the same monorepo.",
+			},
+			"protected_sourcemaps": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Requires visitors to be authenticated with Vercel, and have access to the project, in order to download the source maps of its Deployments.",
+			},
 			"skew_protection": schema.StringAttribute{
 				Computed:    true,
 				Description: "Ensures that outdated clients always fetch the correct version for a given deployment. This value defines how long Vercel keeps Skew Protection active.",
@@ -345,6 +375,15 @@ For more detailed information, please see the [Vercel documentation](https://ver
 						Description: "Enable fluid compute for your Vercel Functions to automatically manage concurrency and optimize performance. Vercel will handle the defaults to ensure the best experience for your workload.",
 						Computed:    true,
 					},
+					"function_default_regions": schema.SetAttribute{
+						Description: "The default regions for Serverless Functions.",
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"function_zero_config_failover": schema.BoolAttribute{
+						Description: "Whether Serverless Functions automatically fail over to the next closest region if a region becomes unavailable.",
+						Computed:    true,
+					},
 				},
 			},
 			"on_demand_concurrent_builds": schema.BoolAttribute{
@@ -371,6 +410,7 @@ type ProjectDataSource struct {
 	IgnoreCommand                       types.String          `tfsdk:"ignore_command"`
 	InstallCommand                      types.String          `tfsdk:"install_command"`
 	Name                                types.String          `tfsdk:"name"`
+	Domain                              types.String          `tfsdk:"domain"`
 	OutputDirectory                     types.String          `tfsdk:"output_directory"`
 	PublicSource                        types.Bool            `tfsdk:"public_source"`
 	RootDirectory                       types.String          `tfsdk:"root_directory"`
@@ -385,6 +425,7 @@ type ProjectDataSource struct {
 	ProtectionBypassForAutomationSecret types.String          `tfsdk:"protection_bypass_for_automation_secret"`
 	AutoExposeSystemEnvVars             types.Bool            `tfsdk:"automatically_expose_system_environment_variables"`
 	GitComments                         types.Object          `tfsdk:"git_comments"`
+	GitProviderOptions                  types.Object          `tfsdk:"git_provider_options"`
 	PreviewComments                     types.Bool            `tfsdk:"preview_comments"`
 	EnablePreviewFeedback               types.Bool            `tfsdk:"enable_preview_feedback"`
 	EnableProductionFeedback            types.Bool            `tfsdk:"enable_production_feedback"`
@@ -394,7 +435,10 @@ type ProjectDataSource struct {
 	CustomerSuccessCodeVisibility       types.Bool            `tfsdk:"customer_success_code_visibility"`
 	GitForkProtection                   types.Bool            `tfsdk:"git_fork_protection"`
 	PrioritiseProductionBuilds          types.Bool            `tfsdk:"prioritise_production_builds"`
+	CancelOutdatedBuilds                types.Bool            `tfsdk:"cancel_outdated_builds"`
 	DirectoryListing                    types.Bool            `tfsdk:"directory_listing"`
+	ProtectedSourcemaps                 types.Bool            `tfsdk:"protected_sourcemaps"`
+	RelatedProjects                     types.Set             `tfsdk:"related_projects"`
 	EnableAffectedProjectsDeployments   types.Bool            `tfsdk:"enable_affected_projects_deployments"`
 	SkewProtection                      types.String          `tfsdk:"skew_protection"`
 	ResourceConfig                      types.Object          `tfsdk:"resource_config"`
@@ -414,12 +458,21 @@ func convertResponseToProjectDataSource(ctx context.Context, response client.Pro
 			"on_commit":       types.BoolValue(response.GitComments.OnCommit),
 		})
 	}
+	plan.GitProviderOptions = types.ObjectNull(gitProviderOptionsAttrTypes)
+	if response.GitProviderOptions != nil {
+		plan.GitProviderOptions = gitProviderOptionsFromResponse(response.GitProviderOptions)
+	}
 
 	project, err := convertResponseToProject(ctx, response, plan)
 	if err != nil {
 		return ProjectDataSource{}, err
 	}
 
+	relatedProjects := types.SetNull(types.StringType)
+	if len(response.RelatedProjects) > 0 {
+		relatedProjects = toStringSet(response.RelatedProjects)
+	}
+
 	var pp *PasswordProtection
 	if project.PasswordProtection != nil {
 		pp = &PasswordProtection{
@@ -450,6 +503,7 @@ func convertResponseToProjectDataSource(ctx context.Context, response client.Pro
 		ProtectionBypassForAutomation:       project.ProtectionBypassForAutomation,
 		ProtectionBypassForAutomationSecret: project.ProtectionBypassForAutomationSecret,
 		GitComments:                         project.GitComments,
+		GitProviderOptions:                  project.GitProviderOptions,
 		PreviewComments:                     project.PreviewComments,
 		EnablePreviewFeedback:               project.EnablePreviewFeedback,
 		EnableProductionFeedback:            project.EnableProductionFeedback,
@@ -459,7 +513,10 @@ func convertResponseToProjectDataSource(ctx context.Context, response client.Pro
 		CustomerSuccessCodeVisibility:       project.CustomerSuccessCodeVisibility,
 		GitForkProtection:                   project.GitForkProtection,
 		PrioritiseProductionBuilds:          project.PrioritiseProductionBuilds,
+		CancelOutdatedBuilds:                project.CancelOutdatedBuilds,
 		DirectoryListing:                    project.DirectoryListing,
+		ProtectedSourcemaps:                 project.ProtectedSourcemaps,
+		RelatedProjects:                     relatedProjects,
 		EnableAffectedProjectsDeployments:   project.EnableAffectedProjectsDeployments,
 		SkewProtection:                      project.SkewProtection,
 		ResourceConfig:                      project.ResourceConfig,
@@ -480,13 +537,39 @@ func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	out, err := d.client.GetProject(ctx, config.Name.ValueString(), config.TeamID.ValueString())
+	projectID := config.Name.ValueString()
+	if config.Domain.ValueString() != "" {
+		alias, err := d.client.GetAlias(ctx, config.Domain.ValueString(), config.TeamID.ValueString())
+		if client.NotFound(err) || (err == nil && alias.ProjectID == "") {
+			resp.Diagnostics.AddError(
+				"Error reading project",
+				fmt.Sprintf("Could not find a project with the domain %s. The domain must be assigned to a deployment of the project.",
+					config.Domain.ValueString(),
+				),
+			)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading project",
+				fmt.Sprintf("Could not look up the project for domain %s %s, unexpected error: %s",
+					config.TeamID.ValueString(),
+					config.Domain.ValueString(),
+					err,
+				),
+			)
+			return
+		}
+		projectID = alias.ProjectID
+	}
+
+	out, err := d.client.GetProject(ctx, projectID, config.TeamID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading project",
 			fmt.Sprintf("Could not read project %s %s, unexpected error: %s",
 				config.TeamID.ValueString(),
-				config.Name.ValueString(),
+				projectID,
 				err,
 			),
 		)
@@ -501,6 +584,7 @@ func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		)
 		return
 	}
+	result.Domain = config.Domain
 	tflog.Info(ctx, "read project", map[string]any{
 		"team_id":    result.TeamID.ValueString(),
 		"project_id": result.ID.ValueString(),
@@ -0,0 +1,230 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &projectCronInvocationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectCronInvocationsDataSource{}
+)
+
+func newProjectCronInvocationsDataSource() datasource.DataSource {
+	return &projectCronInvocationsDataSource{}
+}
+
+type projectCronInvocationsDataSource struct {
+	client *client.Client
+}
+
+func (d *projectCronInvocationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_cron_invocations"
+}
+
+func (d *projectCronInvocationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Schema returns the schema information for a project cron invocations data source
+func (d *projectCronInvocationsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides the recent invocations of the cron jobs of a Project.
+
+This can be used to alert on cron jobs that failed, or that have not run within the expected window. At most the 100 most recent invocations are returned.
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the project.",
+			},
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team the project exists under. Required when reading a team resource if a default team has not been set in the provider.",
+			},
+			"window": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "How far back from now to list invocations, as a duration such as `1h` or `24h`. Defaults to `24h`.",
+			},
+			"path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list invocations of the cron job with this path, e.g. `/api/cron`.",
+			},
+			"failed_invocations": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of invocations that did not succeed.",
+			},
+			"invocations": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The invocations within the window, most recent first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the invocation.",
+						},
+						"path": schema.StringAttribute{
+							Computed:    true,
+							Description: "The path of the cron job that was invoked.",
+						},
+						"schedule": schema.StringAttribute{
+							Computed:    true,
+							Description: "The cron expression of the cron job.",
+						},
+						"deployment_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the Deployment that served the invocation.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The outcome of the invocation. One of `succeeded`, `failed` or `timed_out`.",
+						},
+						"status_code": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The HTTP status code returned by the cron job, if it responded.",
+						},
+						"started_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The time the invocation started, in RFC 3339 format.",
+						},
+						"duration_ms": schema.Int64Attribute{
+							Computed:    true,
+							Description: "How long the invocation took, in milliseconds.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type CronInvocation struct {
+	ID           types.String `tfsdk:"id"`
+	Path         types.String `tfsdk:"path"`
+	Schedule     types.String `tfsdk:"schedule"`
+	DeploymentID types.String `tfsdk:"deployment_id"`
+	Status       types.String `tfsdk:"status"`
+	StatusCode   types.Int64  `tfsdk:"status_code"`
+	StartedAt    types.String `tfsdk:"started_at"`
+	DurationMS   types.Int64  `tfsdk:"duration_ms"`
+}
+
+type ProjectCronInvocations struct {
+	ProjectID         types.String     `tfsdk:"project_id"`
+	TeamID            types.String     `tfsdk:"team_id"`
+	Window            types.String     `tfsdk:"window"`
+	Path              types.String     `tfsdk:"path"`
+	FailedInvocations types.Int64      `tfsdk:"failed_invocations"`
+	Invocations       []CronInvocation `tfsdk:"invocations"`
+}
+
+func (d *projectCronInvocationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ProjectCronInvocations
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window := "24h"
+	if !config.Window.IsNull() {
+		window = config.Window.ValueString()
+	}
+	duration, err := time.ParseDuration(window)
+	if err != nil || duration <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("window"),
+			"Invalid window",
+			fmt.Sprintf("The window %q must be a positive duration, such as `1h` or `24h`.", window),
+		)
+		return
+	}
+
+	out, err := d.client.ListCronInvocations(ctx, client.ListCronInvocationsRequest{
+		ProjectID: config.ProjectID.ValueString(),
+		TeamID:    config.TeamID.ValueString(),
+		Since:     time.Now().Add(-duration),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading project cron invocations",
+			fmt.Sprintf("Could not read cron invocations for project %s %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				config.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	var failed int64
+	invocations := []CronInvocation{}
+	for _, i := range out {
+		if !config.Path.IsNull() && i.Path != config.Path.ValueString() {
+			continue
+		}
+		if i.Status != "succeeded" {
+			failed++
+		}
+		statusCode := types.Int64Null()
+		if i.StatusCode != 0 {
+			statusCode = types.Int64Value(i.StatusCode)
+		}
+		invocations = append(invocations, CronInvocation{
+			ID:           types.StringValue(i.ID),
+			Path:         types.StringValue(i.Path),
+			Schedule:     types.StringValue(i.Schedule),
+			DeploymentID: optionalStringValue(i.DeploymentID),
+			Status:       types.StringValue(i.Status),
+			StatusCode:   statusCode,
+			StartedAt:    timestampValue(i.StartedAt),
+			DurationMS:   types.Int64Value(i.Duration),
+		})
+	}
+
+	result := ProjectCronInvocations{
+		ProjectID:         config.ProjectID,
+		TeamID:            toTeamID(d.client.TeamID(config.TeamID.ValueString())),
+		Window:            types.StringValue(window),
+		Path:              config.Path,
+		FailedInvocations: types.Int64Value(failed),
+		Invocations:       invocations,
+	}
+	tflog.Info(ctx, "read project cron invocations", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"window":     window,
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
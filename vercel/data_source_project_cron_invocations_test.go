@@ -0,0 +1,36 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ProjectCronInvocationsDataSource(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-cron-invocations-%[1]s"
+}
+
+data "vercel_project_cron_invocations" "test" {
+  project_id = vercel_project.test.id
+  path       = "/api/cron"
+}
+`, name)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_project_cron_invocations.test", "window", "24h"),
+					resource.TestCheckResourceAttr("data.vercel_project_cron_invocations.test", "path", "/api/cron"),
+					resource.TestCheckResourceAttr("data.vercel_project_cron_invocations.test", "failed_invocations", "0"),
+					resource.TestCheckResourceAttr("data.vercel_project_cron_invocations.test", "invocations.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,201 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &projectEnvironmentFileDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectEnvironmentFileDataSource{}
+)
+
+func newProjectEnvironmentFileDataSource() datasource.DataSource {
+	return &projectEnvironmentFileDataSource{}
+}
+
+type projectEnvironmentFileDataSource struct {
+	client *client.Client
+}
+
+func (d *projectEnvironmentFileDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_environment_file"
+}
+
+func (d *projectEnvironmentFileDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Schema returns the schema information for a project environment file data source
+func (d *projectEnvironmentFileDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Renders the non-sensitive Environment Variables of a Project for a single target in .env file format.
+
+This is intended for generating local development templates, such as ` + "`.env.preview`" + ` or ` + "`.env.production`" + `, from the Environment Variables managed in Terraform. The data source does not write any files itself; pass ` + "`content`" + ` to the ` + "`local_file`" + ` resource to do so.
+
+Sensitive Environment Variables are never included, as their values cannot be read back from the Vercel API. Their keys are listed in ` + "`excluded_keys`" + ` instead.
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the Vercel project.",
+				Required:    true,
+			},
+			"team_id": schema.StringAttribute{
+				Description: "The ID of the Vercel team. Required when reading a team resource if a default team has not been set in the provider.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"target": schema.StringAttribute{
+				Description: "The environment to render Environment Variables for. Must be one of `production`, `preview`, or `development`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("production", "preview", "development"),
+				},
+			},
+			"git_branch": schema.StringAttribute{
+				Description: "The git branch to render `preview` Environment Variables for. Variables scoped to this branch take precedence over variables without a branch. If not set, branch-scoped variables are excluded.",
+				Optional:    true,
+			},
+			"filename": schema.StringAttribute{
+				Description: "The conventional filename for the rendered file, e.g. `.env.production`.",
+				Computed:    true,
+			},
+			"variables": schema.MapAttribute{
+				Description: "The non-sensitive Environment Variables included in the file.",
+				Computed:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+			"excluded_keys": schema.SetAttribute{
+				Description: "The keys of sensitive Environment Variables that were excluded from the file.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"content": schema.StringAttribute{
+				Description: "The Environment Variables rendered in .env file format.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+type ProjectEnvironmentFile struct {
+	ProjectID    types.String `tfsdk:"project_id"`
+	TeamID       types.String `tfsdk:"team_id"`
+	Target       types.String `tfsdk:"target"`
+	GitBranch    types.String `tfsdk:"git_branch"`
+	Filename     types.String `tfsdk:"filename"`
+	Variables    types.Map    `tfsdk:"variables"`
+	ExcludedKeys types.Set    `tfsdk:"excluded_keys"`
+	Content      types.String `tfsdk:"content"`
+}
+
+// environmentFileVariables selects the environment variables that apply to a target and git branch.
+// Branch-scoped variables override unscoped variables with the same key.
+func environmentFileVariables(envs []client.EnvironmentVariable, target, gitBranch string) (variables map[string]string, excluded []string) {
+	variables = map[string]string{}
+	branchScoped := map[string]bool{}
+	for _, e := range envs {
+		if !contains(e.Target, target) {
+			continue
+		}
+		if e.GitBranch != nil && *e.GitBranch != "" && !sameGitBranch(e.GitBranch, &gitBranch) {
+			continue
+		}
+		if e.Type == "sensitive" || e.Type == "secret" {
+			if !contains(excluded, e.Key) {
+				excluded = append(excluded, e.Key)
+			}
+			continue
+		}
+		isBranchScoped := e.GitBranch != nil && *e.GitBranch != ""
+		if branchScoped[e.Key] && !isBranchScoped {
+			continue
+		}
+		variables[e.Key] = e.Value
+		branchScoped[e.Key] = isBranchScoped
+	}
+	return variables, excluded
+}
+
+// Read will read the environment variables of a project by requesting them from the Vercel API, and will
+// update terraform with the rendered file.
+// It is called by the provider whenever data source values should be read to update state.
+func (d *projectEnvironmentFileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ProjectEnvironmentFile
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envs, err := d.client.ListEnvironmentVariables(ctx, config.ProjectID.ValueString(), config.TeamID.ValueString(), client.EnvironmentVariableFilter{
+		Target: config.Target.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading project environment file",
+			fmt.Sprintf("Could not read environment variables for project %s %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				config.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	variables, excluded := environmentFileVariables(envs, config.Target.ValueString(), config.GitBranch.ValueString())
+	variablesValue, diags := types.MapValueFrom(ctx, types.StringType, variables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result := ProjectEnvironmentFile{
+		ProjectID:    config.ProjectID,
+		TeamID:       toTeamID(d.client.TeamID(config.TeamID.ValueString())),
+		Target:       config.Target,
+		GitBranch:    config.GitBranch,
+		Filename:     types.StringValue(".env." + config.Target.ValueString()),
+		Variables:    variablesValue,
+		ExcludedKeys: toStringSet(excluded),
+		Content:      types.StringValue(renderDotenv(variables)),
+	}
+	tflog.Info(ctx, "read project environment file", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"target":     result.Target.ValueString(),
+		"variables":  len(variables),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
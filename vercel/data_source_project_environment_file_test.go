@@ -0,0 +1,92 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ProjectEnvironmentFileDataSource(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccProjectEnvironmentFileDataSourceConfig(name, testGithubRepo(t))),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_project_environment_file.production", "filename", ".env.production"),
+					resource.TestCheckResourceAttr("data.vercel_project_environment_file.production", "variables.%", "2"),
+					resource.TestCheckResourceAttr("data.vercel_project_environment_file.production", "variables.FOO", "bar"),
+					resource.TestCheckResourceAttr("data.vercel_project_environment_file.production", "excluded_keys.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.vercel_project_environment_file.production", "excluded_keys.*", "SECRET"),
+					resource.TestCheckResourceAttr("data.vercel_project_environment_file.production", "content", "FOO=\"bar\"\nQUOTED=\"say \\\"hi\\\"\"\n"),
+					resource.TestCheckResourceAttr("data.vercel_project_environment_file.preview", "variables.%", "1"),
+					resource.TestCheckResourceAttr("data.vercel_project_environment_file.preview", "variables.FOO", "staging-bar"),
+					resource.TestCheckOutput("rendered", "A=\"1\"\nB=\"line\\nbreak\"\n"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectEnvironmentFileDataSourceConfig(name, githubRepo string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-env-file-%[1]s"
+
+  git_repository = {
+    type = "github"
+    repo = "%[2]s"
+  }
+}
+
+resource "vercel_project_environment_variables" "test" {
+  project_id = vercel_project.test.id
+  variables = {
+    FOO = {
+      value  = "bar"
+      target = ["production", "preview"]
+    }
+    FOO_STAGING = {
+      key        = "FOO"
+      value      = "staging-bar"
+      target     = ["preview"]
+      git_branch = "staging"
+    }
+    QUOTED = {
+      value  = "say \"hi\""
+      target = ["production"]
+    }
+    SECRET = {
+      value     = "shh"
+      target    = ["production"]
+      sensitive = true
+    }
+  }
+}
+
+data "vercel_project_environment_file" "production" {
+  project_id = vercel_project_environment_variables.test.project_id
+  target     = "production"
+}
+
+data "vercel_project_environment_file" "preview" {
+  project_id = vercel_project_environment_variables.test.project_id
+  target     = "preview"
+  git_branch = "staging"
+}
+
+output "rendered" {
+  value = provider::vercel::to_dotenv({
+    B = "line\nbreak"
+    A = "1"
+  })
+}
+`, name, githubRepo)
+}
@@ -0,0 +1,280 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &projectEnvironmentVariablesDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectEnvironmentVariablesDataSource{}
+)
+
+func newProjectEnvironmentVariablesDataSource() datasource.DataSource {
+	return &projectEnvironmentVariablesDataSource{}
+}
+
+type projectEnvironmentVariablesDataSource struct {
+	client *client.Client
+}
+
+func (d *projectEnvironmentVariablesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_environment_variables"
+}
+
+func (d *projectEnvironmentVariablesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Schema returns the schema information for a project environment variables data source
+func (d *projectEnvironmentVariablesDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides metadata about all of the Environment Variables of a Project, including those created outside of Terraform.
+
+This is intended for auditing, such as finding Environment Variables created in the Vercel dashboard that are not yet managed by a ` + "`vercel_project_environment_variables`" + ` resource, and for reading configuration values managed elsewhere. The Environment Variables can be filtered by ` + "`target`" + ` and ` + "`git_branch`" + `.
+
+The values of the Environment Variables are only exposed through ` + "`values`" + `, when a ` + "`target`" + ` is set. The values of sensitive Environment Variables are never exposed, as they cannot be read back from the Vercel API.
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the Vercel project.",
+				Required:    true,
+			},
+			"team_id": schema.StringAttribute{
+				Description: "The ID of the Vercel team. Required when reading a team resource if a default team has not been set in the provider.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"target": schema.StringAttribute{
+				Description: "Only list Environment Variables that are available to this environment. Must be one of `production`, `preview`, or `development`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("production", "preview", "development"),
+				},
+			},
+			"git_branch": schema.StringAttribute{
+				Description: "Only list Environment Variables that are scoped to this git branch, or that are not scoped to a branch. Variables scoped to this branch take precedence over variables without a branch in `values`. If not set, variables scoped to any branch are listed, but are excluded from `values`.",
+				Optional:    true,
+			},
+			"values": schema.MapAttribute{
+				Description: "A map of keys to values of the non-sensitive Environment Variables available to `target`. This is only set if `target` is set.",
+				Computed:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+			"variables": schema.ListNestedAttribute{
+				Description: "The Environment Variables of the Project, ordered by key.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the Environment Variable.",
+							Computed:    true,
+						},
+						"key": schema.StringAttribute{
+							Description: "The name of the Environment Variable.",
+							Computed:    true,
+						},
+						"target": schema.SetAttribute{
+							Description: "The environments that the Environment Variable is available to.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"custom_environment_ids": schema.SetAttribute{
+							Description: "The IDs of Custom Environments that the Environment Variable is available to.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"git_branch": schema.StringAttribute{
+							Description: "The git branch the Environment Variable is scoped to, if any.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the Environment Variable, e.g. `plain`, `encrypted`, `sensitive` or `system`.",
+							Computed:    true,
+						},
+						"sensitive": schema.BoolAttribute{
+							Description: "Whether the Environment Variable is sensitive.",
+							Computed:    true,
+						},
+						"comment": schema.StringAttribute{
+							Description: "The comment attached to the Environment Variable.",
+							Computed:    true,
+						},
+						"created_by": schema.StringAttribute{
+							Description: "The ID of the user that created the Environment Variable.",
+							Computed:    true,
+						},
+						"updated_by": schema.StringAttribute{
+							Description: "The ID of the user that last updated the Environment Variable.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The time the Environment Variable was created, in RFC 3339 format.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "The time the Environment Variable was last updated, in RFC 3339 format.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type ProjectEnvironmentVariableMetadata struct {
+	ID                   types.String `tfsdk:"id"`
+	Key                  types.String `tfsdk:"key"`
+	Target               types.Set    `tfsdk:"target"`
+	CustomEnvironmentIDs types.Set    `tfsdk:"custom_environment_ids"`
+	GitBranch            types.String `tfsdk:"git_branch"`
+	Type                 types.String `tfsdk:"type"`
+	Sensitive            types.Bool   `tfsdk:"sensitive"`
+	Comment              types.String `tfsdk:"comment"`
+	CreatedBy            types.String `tfsdk:"created_by"`
+	UpdatedBy            types.String `tfsdk:"updated_by"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+	UpdatedAt            types.String `tfsdk:"updated_at"`
+}
+
+type ProjectEnvironmentVariablesDataSource struct {
+	ProjectID types.String                         `tfsdk:"project_id"`
+	TeamID    types.String                         `tfsdk:"team_id"`
+	Target    types.String                         `tfsdk:"target"`
+	GitBranch types.String                         `tfsdk:"git_branch"`
+	Values    types.Map                            `tfsdk:"values"`
+	Variables []ProjectEnvironmentVariableMetadata `tfsdk:"variables"`
+}
+
+func timestampValue(ms int64) types.String {
+	if ms == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(time.UnixMilli(ms).UTC().Format(time.RFC3339))
+}
+
+func optionalStringValue(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+func environmentVariableMetadataFromResponse(e client.EnvironmentVariable) ProjectEnvironmentVariableMetadata {
+	return ProjectEnvironmentVariableMetadata{
+		ID:                   types.StringValue(e.ID),
+		Key:                  types.StringValue(e.Key),
+		Target:               toStringSet(e.Target),
+		CustomEnvironmentIDs: toStringSet(e.CustomEnvironmentIDs),
+		GitBranch:            gitBranchValue(types.StringNull(), e.GitBranch),
+		Type:                 types.StringValue(e.Type),
+		Sensitive:            types.BoolValue(e.Type == "sensitive"),
+		Comment:              types.StringValue(e.Comment),
+		CreatedBy:            optionalStringValue(e.CreatedBy),
+		UpdatedBy:            optionalStringValue(e.UpdatedBy),
+		CreatedAt:            timestampValue(e.CreatedAt),
+		UpdatedAt:            timestampValue(e.UpdatedAt),
+	}
+}
+
+// Read will read the environment variables of a project by requesting them from the Vercel API, and will
+// update terraform with their metadata.
+// It is called by the provider whenever data source values should be read to update state.
+func (d *projectEnvironmentVariablesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ProjectEnvironmentVariablesDataSource
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envs, err := d.client.ListEnvironmentVariables(ctx, config.ProjectID.ValueString(), config.TeamID.ValueString(), client.EnvironmentVariableFilter{
+		Target: config.Target.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading project environment variables",
+			fmt.Sprintf("Could not read environment variables for project %s %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				config.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+	sort.SliceStable(envs, func(i, j int) bool {
+		if envs[i].Key != envs[j].Key {
+			return envs[i].Key < envs[j].Key
+		}
+		return envs[i].ID < envs[j].ID
+	})
+
+	variables := []ProjectEnvironmentVariableMetadata{}
+	for _, e := range envs {
+		if !config.Target.IsNull() && !contains(e.Target, config.Target.ValueString()) {
+			continue
+		}
+		if !config.GitBranch.IsNull() && e.GitBranch != nil && *e.GitBranch != "" && !sameGitBranch(e.GitBranch, config.GitBranch.ValueStringPointer()) {
+			continue
+		}
+		variables = append(variables, environmentVariableMetadataFromResponse(e))
+	}
+
+	values := types.MapNull(types.StringType)
+	if !config.Target.IsNull() {
+		v, _ := environmentFileVariables(envs, config.Target.ValueString(), config.GitBranch.ValueString())
+		values, diags = types.MapValueFrom(ctx, types.StringType, v)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	result := ProjectEnvironmentVariablesDataSource{
+		ProjectID: config.ProjectID,
+		TeamID:    toTeamID(d.client.TeamID(config.TeamID.ValueString())),
+		Target:    config.Target,
+		GitBranch: config.GitBranch,
+		Values:    values,
+		Variables: variables,
+	}
+	tflog.Info(ctx, "read project environment variables", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"variables":  len(variables),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,93 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ProjectEnvironmentVariablesDataSource(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccProjectEnvironmentVariablesDataSourceConfig(name)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_project_environment_variables.test", "variables.#", "2"),
+					resource.TestCheckResourceAttr("data.vercel_project_environment_variables.test", "variables.0.key", "BAR"),
+					resource.TestCheckResourceAttr("data.vercel_project_environment_variables.test", "variables.0.sensitive", "true"),
+					resource.TestCheckResourceAttr("data.vercel_project_environment_variables.test", "variables.0.comment", "a secret"),
+					resource.TestCheckResourceAttr("data.vercel_project_environment_variables.test", "variables.1.key", "FOO"),
+					resource.TestCheckResourceAttr("data.vercel_project_environment_variables.test", "variables.1.sensitive", "false"),
+					resource.TestCheckResourceAttr("data.vercel_project_environment_variables.test", "variables.1.target.#", "2"),
+					resource.TestCheckResourceAttrSet("data.vercel_project_environment_variables.test", "variables.1.id"),
+					resource.TestCheckResourceAttrSet("data.vercel_project_environment_variables.test", "variables.1.created_at"),
+					resource.TestCheckNoResourceAttr("data.vercel_project_environment_variables.test", "variables.1.value"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectEnvironmentVariablesDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-env-vars-ds-%[1]s"
+}
+
+resource "vercel_project_environment_variables" "test" {
+  project_id = vercel_project.test.id
+  variables = {
+    FOO = {
+      value  = "foo"
+      target = ["production", "preview"]
+    }
+    BAR = {
+      value     = "bar"
+      target    = ["production"]
+      sensitive = true
+      comment   = "a secret"
+    }
+  }
+}
+
+data "vercel_project_environment_variables" "test" {
+  project_id = vercel_project_environment_variables.test.project_id
+}
+`, name)
+}
+
+func TestAcc_ProjectEnvironmentVariablesDataSourceTarget(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccProjectEnvironmentVariablesDataSourceConfig(name) + `
+data "vercel_project_environment_variables" "preview" {
+  project_id = vercel_project_environment_variables.test.project_id
+  target     = "preview"
+}
+
+data "vercel_project_environment_variables" "production" {
+  project_id = vercel_project_environment_variables.test.project_id
+  target     = "production"
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_project_environment_variables.preview", "variables.#", "1"),
+					resource.TestCheckResourceAttr("data.vercel_project_environment_variables.preview", "variables.0.key", "FOO"),
+					resource.TestCheckResourceAttr("data.vercel_project_environment_variables.preview", "values.%", "1"),
+					resource.TestCheckResourceAttr("data.vercel_project_environment_variables.preview", "values.FOO", "foo"),
+					resource.TestCheckResourceAttr("data.vercel_project_environment_variables.production", "variables.#", "2"),
+					resource.TestCheckResourceAttr("data.vercel_project_environment_variables.production", "values.%", "1"),
+					resource.TestCheckNoResourceAttr("data.vercel_project_environment_variables.production", "values.BAR"),
+					resource.TestCheckNoResourceAttr("data.vercel_project_environment_variables.test", "values.%"),
+				),
+			},
+		},
+	})
+}
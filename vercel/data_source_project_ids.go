@@ -0,0 +1,147 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &projectIDsDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectIDsDataSource{}
+)
+
+func newProjectIDsDataSource() datasource.DataSource {
+	return &projectIDsDataSource{}
+}
+
+type projectIDsDataSource struct {
+	client *client.Client
+}
+
+func (d *projectIDsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_ids"
+}
+
+func (d *projectIDsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Schema returns the schema information for a project IDs data source
+func (d *projectIDsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides the IDs of several Projects, looked up by name.
+
+The Projects of the team are listed once, so this is faster than using a ` + "`vercel_project`" + ` data source for each Project when many Projects are referenced.
+`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The team ID the Projects exist under. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"names": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The names of the Projects to look up. An error is returned if any of them does not exist.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"ids": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "A map of Project names to Project IDs.",
+			},
+		},
+	}
+}
+
+type ProjectIDs struct {
+	TeamID types.String      `tfsdk:"team_id"`
+	Names  []string          `tfsdk:"names"`
+	IDs    map[string]string `tfsdk:"ids"`
+}
+
+func (d *projectIDsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ProjectIDs
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projects, err := d.client.ListAllProjects(ctx, config.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading project IDs",
+			fmt.Sprintf("Could not list projects for team %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	byName := map[string]string{}
+	for _, p := range projects {
+		byName[p.Name] = p.ID
+	}
+	ids := map[string]string{}
+	var missing []string
+	for _, name := range config.Names {
+		id, ok := byName[name]
+		if !ok {
+			missing = append(missing, name)
+			continue
+		}
+		ids[name] = id
+	}
+	if len(missing) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("names"),
+			"Error reading project IDs",
+			fmt.Sprintf("Could not find the following projects: %s", strings.Join(missing, ", ")),
+		)
+		return
+	}
+
+	result := ProjectIDs{
+		TeamID: toTeamID(d.client.TeamID(config.TeamID.ValueString())),
+		Names:  config.Names,
+		IDs:    ids,
+	}
+	tflog.Info(ctx, "read project ids", map[string]any{
+		"team_id":  result.TeamID.ValueString(),
+		"projects": len(ids),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,47 @@
+package vercel_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ProjectIDsDataSource(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(fmt.Sprintf(`
+resource "vercel_project" "first" {
+  name = "test-acc-%[1]s-1"
+}
+
+resource "vercel_project" "second" {
+  name = "test-acc-%[1]s-2"
+}
+
+data "vercel_project_ids" "test" {
+  names = [vercel_project.first.name, vercel_project.second.name]
+}
+`, name)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_project_ids.test", "ids.%", "2"),
+					resource.TestCheckResourceAttrPair("data.vercel_project_ids.test", fmt.Sprintf("ids.test-acc-%s-1", name), "vercel_project.first", "id"),
+					resource.TestCheckResourceAttrPair("data.vercel_project_ids.test", fmt.Sprintf("ids.test-acc-%s-2", name), "vercel_project.second", "id"),
+				),
+			},
+			{
+				Config: cfg(fmt.Sprintf(`
+data "vercel_project_ids" "test" {
+  names = ["test-acc-%s-missing"]
+}
+`, name)),
+				ExpectError: regexp.MustCompile("Could not find the following projects"),
+			},
+		},
+	})
+}
@@ -43,6 +43,7 @@ func TestAcc_ProjectDataSource(t *testing.T) {
 					resource.TestCheckTypeSetElemAttr("data.vercel_project.test", "environment.0.target.*", "production"),
 					resource.TestCheckResourceAttr("data.vercel_project.test", "git_comments.on_pull_request", "true"),
 					resource.TestCheckResourceAttr("data.vercel_project.test", "git_comments.on_commit", "true"),
+					resource.TestCheckResourceAttr("data.vercel_project.test", "git_provider_options.create_deployments", "false"),
 					resource.TestCheckResourceAttr("data.vercel_project.test", "preview_comments", "true"),
 					resource.TestCheckResourceAttr("data.vercel_project.test", "enable_preview_feedback", "true"),
 					resource.TestCheckResourceAttr("data.vercel_project.test", "enable_production_feedback", "false"),
@@ -52,6 +53,7 @@ func TestAcc_ProjectDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.vercel_project.test", "customer_success_code_visibility", "true"),
 					resource.TestCheckResourceAttr("data.vercel_project.test", "git_fork_protection", "true"),
 					resource.TestCheckResourceAttr("data.vercel_project.test", "prioritise_production_builds", "true"),
+					resource.TestCheckResourceAttr("data.vercel_project.test", "cancel_outdated_builds", "false"),
 					resource.TestCheckResourceAttr("data.vercel_project.test", "directory_listing", "true"),
 					resource.TestCheckResourceAttr("data.vercel_project.test", "skew_protection", "7 days"),
 					resource.TestCheckResourceAttr("data.vercel_project.test", "resource_config.function_default_cpu_type", "standard_legacy"),
@@ -66,6 +68,45 @@ func TestAcc_ProjectDataSource(t *testing.T) {
 	})
 }
 
+func TestAcc_ProjectDataSourceByDomain(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(fmt.Sprintf(`
+resource "vercel_project" "test" {
+    name = "test-acc-%[1]s"
+    git_repository = {
+        type = "github"
+        repo = "%[2]s"
+    }
+}
+
+resource "vercel_deployment" "test" {
+    project_id = vercel_project.test.id
+    ref        = "main"
+}
+
+resource "vercel_alias" "test" {
+    alias         = "test-acc-%[1]s.vercel.app"
+    deployment_id = vercel_deployment.test.id
+}
+
+data "vercel_project" "test" {
+    domain = vercel_alias.test.alias
+}
+`, name, testGithubRepo(t))),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_project.test", "name", "test-acc-"+name),
+					resource.TestCheckResourceAttrPair("data.vercel_project.test", "id", "vercel_project.test", "id"),
+					resource.TestCheckResourceAttr("data.vercel_project.test", "domain", fmt.Sprintf("test-acc-%s.vercel.app", name)),
+				),
+			},
+		},
+	})
+}
+
 func testAccProjectDataSourceConfig(name, githubRepo string) string {
 	return fmt.Sprintf(`
 resource "vercel_project" "test" {
@@ -112,6 +153,9 @@ resource "vercel_project" "test" {
       on_pull_request = true,
       on_commit = true
   }
+  git_provider_options = {
+      create_deployments = false
+  }
   enable_preview_feedback = true
   enable_production_feedback = false
   auto_assign_custom_domains = true
@@ -120,6 +164,7 @@ resource "vercel_project" "test" {
   customer_success_code_visibility = true
   git_fork_protection = true
   prioritise_production_builds = true
+  cancel_outdated_builds = false
   directory_listing = true
   skew_protection = "7 days"
   git_repository = {
@@ -0,0 +1,195 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &sharedEnvironmentVariablesDataSource{}
+	_ datasource.DataSourceWithConfigure = &sharedEnvironmentVariablesDataSource{}
+)
+
+func newSharedEnvironmentVariablesDataSource() datasource.DataSource {
+	return &sharedEnvironmentVariablesDataSource{}
+}
+
+type sharedEnvironmentVariablesDataSource struct {
+	client *client.Client
+}
+
+func (d *sharedEnvironmentVariablesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_shared_environment_variables"
+}
+
+func (d *sharedEnvironmentVariablesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Schema returns the schema information for a shared environment variables data source
+func (d *sharedEnvironmentVariablesDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a list of the Shared Environment Variables of a team, and the Projects each of them is linked to.
+
+This can be used to plan a migration from Project Environment Variables to Shared Environment Variables, for example by comparing the keys of a Project with the keys already shared with it. The values of the Environment Variables are not included.
+`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the Vercel team. Shared environment variables require a team.",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list Shared Environment Variables that are linked to this Project.",
+			},
+			"variables": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The Shared Environment Variables, sorted by key.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the Environment Variable.",
+						},
+						"key": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the Environment Variable.",
+						},
+						"target": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The environments that the Environment Variable is present on.",
+						},
+						"project_ids": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The IDs of the Projects the Environment Variable is linked to.",
+						},
+						"sensitive": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the Environment Variable is sensitive or not.",
+						},
+						"comment": schema.StringAttribute{
+							Computed:    true,
+							Description: "A comment explaining what the environment variable is for.",
+						},
+						"apply_to_all_custom_environments": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the Environment Variable is applied to all custom environments.",
+						},
+					},
+				},
+			},
+			"project_keys": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+				Description: "A map of Project IDs to the sorted keys of the Shared Environment Variables linked to each Project.",
+			},
+		},
+	}
+}
+
+type SharedEnvironmentVariableItem struct {
+	ID                           types.String `tfsdk:"id"`
+	Key                          types.String `tfsdk:"key"`
+	Target                       types.Set    `tfsdk:"target"`
+	ProjectIDs                   types.Set    `tfsdk:"project_ids"`
+	Sensitive                    types.Bool   `tfsdk:"sensitive"`
+	Comment                      types.String `tfsdk:"comment"`
+	ApplyToAllCustomEnvironments types.Bool   `tfsdk:"apply_to_all_custom_environments"`
+}
+
+type SharedEnvironmentVariables struct {
+	TeamID      types.String                    `tfsdk:"team_id"`
+	ProjectID   types.String                    `tfsdk:"project_id"`
+	Variables   []SharedEnvironmentVariableItem `tfsdk:"variables"`
+	ProjectKeys map[string][]string             `tfsdk:"project_keys"`
+}
+
+func (d *sharedEnvironmentVariablesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config SharedEnvironmentVariables
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := d.client.ListSharedEnvironmentVariables(ctx, config.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading shared environment variables",
+			fmt.Sprintf("Could not list shared environment variables for team %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Key < out[j].Key
+	})
+
+	variables := []SharedEnvironmentVariableItem{}
+	projectKeys := map[string][]string{}
+	for _, e := range out {
+		if !config.ProjectID.IsNull() && !contains(e.ProjectIDs, config.ProjectID.ValueString()) {
+			continue
+		}
+		// The value is deliberately dropped, as this data source is intended for planning rather than reading secrets.
+		v := convertResponseToSharedEnvironmentVariable(e, types.StringNull())
+		variables = append(variables, SharedEnvironmentVariableItem{
+			ID:                           v.ID,
+			Key:                          v.Key,
+			Target:                       v.Target,
+			ProjectIDs:                   v.ProjectIDs,
+			Sensitive:                    v.Sensitive,
+			Comment:                      v.Comment,
+			ApplyToAllCustomEnvironments: v.ApplyToAllCustomEnvironments,
+		})
+		for _, p := range e.ProjectIDs {
+			if !config.ProjectID.IsNull() && p != config.ProjectID.ValueString() {
+				continue
+			}
+			projectKeys[p] = append(projectKeys[p], e.Key)
+		}
+	}
+
+	result := SharedEnvironmentVariables{
+		TeamID:      toTeamID(d.client.TeamID(config.TeamID.ValueString())),
+		ProjectID:   config.ProjectID,
+		Variables:   variables,
+		ProjectKeys: projectKeys,
+	}
+	tflog.Info(ctx, "read shared environment variables", map[string]any{
+		"team_id":   result.TeamID.ValueString(),
+		"variables": len(variables),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
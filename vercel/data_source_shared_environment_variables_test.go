@@ -0,0 +1,44 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_SharedEnvironmentVariablesDataSource(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-%[1]s"
+}
+
+resource "vercel_shared_environment_variable" "test" {
+  key         = "test_acc_%[1]s"
+  value       = "foobar"
+  target      = ["production", "preview"]
+  project_ids = [vercel_project.test.id]
+}
+
+data "vercel_shared_environment_variables" "test" {
+  project_id = vercel_project.test.id
+  depends_on = [vercel_shared_environment_variable.test]
+}
+`, name)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_shared_environment_variables.test", "variables.#", "1"),
+					resource.TestCheckResourceAttr("data.vercel_shared_environment_variables.test", "variables.0.key", "test_acc_"+name),
+					resource.TestCheckResourceAttrPair("data.vercel_shared_environment_variables.test", "variables.0.id", "vercel_shared_environment_variable.test", "id"),
+					resource.TestCheckTypeSetElemAttrPair("data.vercel_shared_environment_variables.test", "variables.0.project_ids.*", "vercel_project.test", "id"),
+					resource.TestCheckResourceAttr("data.vercel_shared_environment_variables.test", "project_keys.%", "1"),
+				),
+			},
+		},
+	})
+}
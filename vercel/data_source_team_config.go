@@ -70,7 +70,11 @@ func (d *teamConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 			},
 			"sensitive_environment_variable_policy": schema.StringAttribute{
 				Computed:    true,
-				Description: "The policy for sensitive environment variables.",
+				Description: "The policy for sensitive environment variables: one of `on`, `off` or `default`. When `on`, every environment variable created by members of the team must be sensitive, and planning a `vercel_project_environment_variable` or `vercel_project_environment_variables` resource with `sensitive = false` fails.",
+			},
+			"sensitive_environment_variables_required": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the sensitive environment variable policy requires every environment variable to be sensitive. This can be used to set `sensitive` on environment variables, rather than finding out about the policy from a plan error.",
 			},
 			"email_domain": schema.StringAttribute{
 				Computed:    true,
@@ -88,6 +92,14 @@ func (d *teamConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 						Computed:    true,
 						Description: "Indicates if Remote Caching is enabled.",
 					},
+					"artifact_retention_days": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The number of days that artifacts are kept in the Remote Cache before they expire.",
+					},
+					"max_artifact_size_mb": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The maximum size, in megabytes, of a single artifact that can be uploaded to the Remote Cache.",
+					},
 				},
 			},
 			"enable_preview_feedback": schema.StringAttribute{
@@ -132,6 +144,10 @@ func (d *teamConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				Computed:    true,
 				Description: "Configuration for SAML authentication.",
 			},
+			"two_factor_authentication_enforced": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Indicates if members of the team are required to have two-factor authentication enabled.",
+			},
 		},
 	}
 }
@@ -143,6 +159,7 @@ type TeamConfigData struct {
 	Description                        types.String `tfsdk:"description"`
 	InviteCode                         types.String `tfsdk:"invite_code"`
 	SensitiveEnvironmentVariablePolicy types.String `tfsdk:"sensitive_environment_variable_policy"`
+	SensitiveEnvironmentVariablesReq   types.Bool   `tfsdk:"sensitive_environment_variables_required"`
 	EmailDomain                        types.String `tfsdk:"email_domain"`
 	PreviewDeploymentSuffix            types.String `tfsdk:"preview_deployment_suffix"`
 	RemoteCaching                      types.Object `tfsdk:"remote_caching"`
@@ -151,6 +168,7 @@ type TeamConfigData struct {
 	HideIPAddresses                    types.Bool   `tfsdk:"hide_ip_addresses"`
 	HideIPAddressesInLogDrains         types.Bool   `tfsdk:"hide_ip_addresses_in_log_drains"`
 	Saml                               types.Object `tfsdk:"saml"`
+	TwoFactorAuthenticationEnforced    types.Bool   `tfsdk:"two_factor_authentication_enforced"`
 }
 
 func (d *teamConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -184,6 +202,7 @@ func (d *teamConfigDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		Description:                        out.Description,
 		InviteCode:                         out.InviteCode,
 		SensitiveEnvironmentVariablePolicy: out.SensitiveEnvironmentVariablePolicy,
+		SensitiveEnvironmentVariablesReq:   types.BoolValue(out.SensitiveEnvironmentVariablePolicy.ValueString() == "on"),
 		EmailDomain:                        out.EmailDomain,
 		PreviewDeploymentSuffix:            out.PreviewDeploymentSuffix,
 		EnablePreviewFeedback:              out.EnablePreviewFeedback,
@@ -192,6 +211,7 @@ func (d *teamConfigDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		HideIPAddressesInLogDrains:         out.HideIPAddressesInLogDrains,
 		RemoteCaching:                      out.RemoteCaching,
 		Saml:                               out.Saml,
+		TwoFactorAuthenticationEnforced:    out.TwoFactorAuthenticationEnforced,
 	})
 	resp.Diagnostics.Append(diags...)
 }
@@ -21,6 +21,7 @@ func TestAcc_TeamConfigDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "slug"),
 					resource.TestCheckResourceAttrSet(resourceName, "description"),
 					resource.TestCheckResourceAttrSet(resourceName, "sensitive_environment_variable_policy"),
+					resource.TestCheckResourceAttrSet(resourceName, "sensitive_environment_variables_required"),
 					resource.TestCheckResourceAttrSet(resourceName, "remote_caching.enabled"),
 					resource.TestCheckResourceAttrSet(resourceName, "enable_preview_feedback"),
 					resource.TestCheckResourceAttrSet(resourceName, "enable_production_feedback"),
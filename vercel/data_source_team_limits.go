@@ -0,0 +1,202 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+var (
+	_ datasource.DataSource              = &teamLimitsDataSource{}
+	_ datasource.DataSourceWithConfigure = &teamLimitsDataSource{}
+)
+
+func newTeamLimitsDataSource() datasource.DataSource {
+	return &teamLimitsDataSource{}
+}
+
+type teamLimitsDataSource struct {
+	client *client.Client
+}
+
+func (d *teamLimitsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_limits"
+}
+
+func (d *teamLimitsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *teamLimitsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides the plan of a Vercel Team, and the limits that apply to it.
+
+This can be used to size batches of resources, or to fail a plan early when a configuration would exceed the team's quotas.
+
+The plan and build concurrency are read from the team. The other limits are Vercel's [published limits](https://vercel.com/docs/limits) for the plan, and may be higher for Enterprise teams with a custom contract.
+`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team. Required if a default team has not been set in the provider.",
+			},
+			"plan": schema.StringAttribute{
+				Computed:    true,
+				Description: "The plan of the team. One of `hobby`, `pro` or `enterprise`.",
+			},
+			"concurrent_builds": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of builds the team can run at the same time.",
+			},
+			"max_environment_variables_per_project": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The maximum number of Environment Variables per environment of a Project.",
+			},
+			"max_domains_per_project": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The maximum number of Domains that can be added to a Project. Null if there is no limit.",
+			},
+			"function_max_duration": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The maximum duration that a Serverless Function can be configured to run for, in seconds.",
+			},
+			"function_max_memory": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The maximum memory that a Serverless Function can be configured with, in MB.",
+			},
+		},
+	}
+}
+
+type TeamLimits struct {
+	TeamID                            types.String `tfsdk:"team_id"`
+	Plan                              types.String `tfsdk:"plan"`
+	ConcurrentBuilds                  types.Int64  `tfsdk:"concurrent_builds"`
+	MaxEnvironmentVariablesPerProject types.Int64  `tfsdk:"max_environment_variables_per_project"`
+	MaxDomainsPerProject              types.Int64  `tfsdk:"max_domains_per_project"`
+	FunctionMaxDuration               types.Int64  `tfsdk:"function_max_duration"`
+	FunctionMaxMemory                 types.Int64  `tfsdk:"function_max_memory"`
+}
+
+// planLimits are Vercel's published limits for a plan. A nil limit means there is no limit.
+type planLimits struct {
+	concurrentBuilds        int64
+	maxEnvironmentVariables int64
+	maxDomainsPerProject    *int64
+	functionMaxDuration     int64
+	functionMaxMemory       int64
+	edgeConfigMaxSize       int
+}
+
+var hobbyMaxDomainsPerProject = int64(50)
+
+var publishedPlanLimits = map[string]planLimits{
+	"hobby": {
+		concurrentBuilds:        1,
+		maxEnvironmentVariables: 1000,
+		maxDomainsPerProject:    &hobbyMaxDomainsPerProject,
+		functionMaxDuration:     60,
+		functionMaxMemory:       2048,
+		edgeConfigMaxSize:       8 * 1024,
+	},
+	"pro": {
+		concurrentBuilds:        1,
+		maxEnvironmentVariables: 1000,
+		functionMaxDuration:     800,
+		functionMaxMemory:       4096,
+		edgeConfigMaxSize:       64 * 1024,
+	},
+	"enterprise": {
+		concurrentBuilds:        1,
+		maxEnvironmentVariables: 1000,
+		functionMaxDuration:     900,
+		functionMaxMemory:       4096,
+		edgeConfigMaxSize:       edgeConfigMaxSizeBytes,
+	},
+}
+
+// teamPlan returns the plan a team is on. Teams without billing information are treated as being on the hobby plan.
+func teamPlan(team client.Team) string {
+	if team.Billing != nil && team.Billing.Plan != "" {
+		return team.Billing.Plan
+	}
+	return "hobby"
+}
+
+func (d *teamLimitsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config TeamLimits
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID := d.client.TeamID(config.TeamID.ValueString())
+	if teamID == "" {
+		resp.Diagnostics.AddError(
+			"Error reading team limits",
+			"No team_id was specified, and no default team has been set in the provider.",
+		)
+		return
+	}
+
+	team, err := d.client.GetTeam(ctx, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading team limits",
+			fmt.Sprintf("Could not read team %s, unexpected error: %s", teamID, err),
+		)
+		return
+	}
+
+	plan := teamPlan(team)
+	limits, ok := publishedPlanLimits[plan]
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error reading team limits",
+			fmt.Sprintf("The team %s is on the %q plan, which the provider does not know the limits of.", teamID, plan),
+		)
+		return
+	}
+	concurrentBuilds := limits.concurrentBuilds
+	if team.ResourceConfig != nil && team.ResourceConfig.ConcurrentBuilds != nil {
+		concurrentBuilds = *team.ResourceConfig.ConcurrentBuilds
+	}
+
+	result := TeamLimits{
+		TeamID:                            types.StringValue(team.ID),
+		Plan:                              types.StringValue(plan),
+		ConcurrentBuilds:                  types.Int64Value(concurrentBuilds),
+		MaxEnvironmentVariablesPerProject: types.Int64Value(limits.maxEnvironmentVariables),
+		MaxDomainsPerProject:              types.Int64PointerValue(limits.maxDomainsPerProject),
+		FunctionMaxDuration:               types.Int64Value(limits.functionMaxDuration),
+		FunctionMaxMemory:                 types.Int64Value(limits.functionMaxMemory),
+	}
+	tflog.Info(ctx, "read team limits", map[string]any{
+		"team_id": result.TeamID.ValueString(),
+		"plan":    plan,
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
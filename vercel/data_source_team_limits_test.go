@@ -0,0 +1,30 @@
+package vercel_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_TeamLimitsDataSource(t *testing.T) {
+	resourceName := "data.vercel_team_limits.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(`
+data "vercel_team_limits" "test" {}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "team_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "plan"),
+					resource.TestCheckResourceAttrSet(resourceName, "concurrent_builds"),
+					resource.TestCheckResourceAttr(resourceName, "max_environment_variables_per_project", "1000"),
+					resource.TestCheckResourceAttrSet(resourceName, "function_max_duration"),
+					resource.TestCheckResourceAttrSet(resourceName, "function_max_memory"),
+				),
+			},
+		},
+	})
+}
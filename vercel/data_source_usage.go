@@ -0,0 +1,298 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &usageDataSource{}
+	_ datasource.DataSourceWithConfigure = &usageDataSource{}
+)
+
+func newUsageDataSource() datasource.DataSource {
+	return &usageDataSource{}
+}
+
+type usageDataSource struct {
+	client *client.Client
+}
+
+func (d *usageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usage"
+}
+
+func (d *usageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Schema returns the schema information for a usage data source
+func (d *usageDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides the usage and cost of a team for a period, broken down by Project.
+
+This is intended for driving cost dashboards and budget alerts from Terraform outputs. Usage is read from the billing charges of the team, so it may take some time for recent usage to be included.
+`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the Vercel team. Required when reading a team resource if a default team has not been set in the provider.",
+			},
+			"from": schema.StringAttribute{
+				Required:    true,
+				Description: "The start of the period, as a timestamp in RFC 3339 format, e.g. `2024-01-01T00:00:00Z`.",
+				Validators:  []validator.String{validateTimestamp()},
+			},
+			"to": schema.StringAttribute{
+				Required:    true,
+				Description: "The end of the period, as a timestamp in RFC 3339 format, e.g. `2024-02-01T00:00:00Z`.",
+				Validators:  []validator.String{validateTimestamp()},
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only include the usage of this Project.",
+			},
+			"currency": schema.StringAttribute{
+				Computed:    true,
+				Description: "The currency that costs are billed in, e.g. `USD`.",
+			},
+			"billed_cost": schema.Float64Attribute{
+				Computed:    true,
+				Description: "The total cost billed for the period, including charges that are not specific to a Project.",
+			},
+			"projects": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The usage of each Project that incurred charges during the period, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"project_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the Project.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the Project.",
+						},
+						"billed_cost": schema.Float64Attribute{
+							Computed:    true,
+							Description: "The cost billed for the Project.",
+						},
+						"bandwidth_gb": schema.Float64Attribute{
+							Computed:    true,
+							Description: "The Fast Data Transfer used by the Project, in GB.",
+						},
+						"function_invocations": schema.Float64Attribute{
+							Computed:    true,
+							Description: "The number of Function Invocations of the Project.",
+						},
+						"build_minutes": schema.Float64Attribute{
+							Computed:    true,
+							Description: "The build minutes used by the Project.",
+						},
+						"services": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "The usage of every service by the Project, sorted by name.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Computed:    true,
+										Description: "The name of the service, e.g. `Fast Data Transfer`.",
+									},
+									"quantity": schema.Float64Attribute{
+										Computed:    true,
+										Description: "The amount of the service used.",
+									},
+									"unit": schema.StringAttribute{
+										Computed:    true,
+										Description: "The unit that `quantity` is measured in.",
+									},
+									"billed_cost": schema.Float64Attribute{
+										Computed:    true,
+										Description: "The cost billed for the service.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type UsageService struct {
+	Name       types.String  `tfsdk:"name"`
+	Quantity   types.Float64 `tfsdk:"quantity"`
+	Unit       types.String  `tfsdk:"unit"`
+	BilledCost types.Float64 `tfsdk:"billed_cost"`
+}
+
+type ProjectUsage struct {
+	ProjectID           types.String   `tfsdk:"project_id"`
+	Name                types.String   `tfsdk:"name"`
+	BilledCost          types.Float64  `tfsdk:"billed_cost"`
+	BandwidthGB         types.Float64  `tfsdk:"bandwidth_gb"`
+	FunctionInvocations types.Float64  `tfsdk:"function_invocations"`
+	BuildMinutes        types.Float64  `tfsdk:"build_minutes"`
+	Services            []UsageService `tfsdk:"services"`
+}
+
+type Usage struct {
+	TeamID     types.String   `tfsdk:"team_id"`
+	From       types.String   `tfsdk:"from"`
+	To         types.String   `tfsdk:"to"`
+	ProjectID  types.String   `tfsdk:"project_id"`
+	Currency   types.String   `tfsdk:"currency"`
+	BilledCost types.Float64  `tfsdk:"billed_cost"`
+	Projects   []ProjectUsage `tfsdk:"projects"`
+}
+
+// The names of the services that the summary attributes of a Project's usage are taken from.
+const (
+	usageServiceBandwidth           = "Fast Data Transfer"
+	usageServiceFunctionInvocations = "Function Invocations"
+	usageServiceBuildMinutes        = "Build Minutes"
+)
+
+// summariseUsage totals the charges of each Project by service. Charges that are not specific to a Project are
+// only included in the returned total.
+func summariseUsage(charges []client.UsageCharge, projectID string) (projects []ProjectUsage, total float64, currency string) {
+	type serviceTotal struct {
+		quantity float64
+		unit     string
+		cost     float64
+	}
+	names := map[string]string{}
+	costs := map[string]float64{}
+	services := map[string]map[string]*serviceTotal{}
+	for _, c := range charges {
+		if projectID != "" && c.ProjectID() != projectID {
+			continue
+		}
+		total += c.BilledCost
+		if c.BillingCurrency != "" {
+			currency = c.BillingCurrency
+		}
+		if c.ProjectID() == "" {
+			continue
+		}
+		if c.ProjectName() != "" {
+			names[c.ProjectID()] = c.ProjectName()
+		}
+		costs[c.ProjectID()] += c.BilledCost
+		if services[c.ProjectID()] == nil {
+			services[c.ProjectID()] = map[string]*serviceTotal{}
+		}
+		s, ok := services[c.ProjectID()][c.ServiceName]
+		if !ok {
+			s = &serviceTotal{unit: c.ConsumedUnit}
+			services[c.ProjectID()][c.ServiceName] = s
+		}
+		s.quantity += c.ConsumedQuantity
+		s.cost += c.BilledCost
+	}
+
+	projects = []ProjectUsage{}
+	for _, id := range sortedKeys(services) {
+		p := ProjectUsage{
+			ProjectID:           types.StringValue(id),
+			Name:                optionalStringValue(names[id]),
+			BilledCost:          types.Float64Value(costs[id]),
+			BandwidthGB:         types.Float64Value(0),
+			FunctionInvocations: types.Float64Value(0),
+			BuildMinutes:        types.Float64Value(0),
+			Services:            []UsageService{},
+		}
+		for _, name := range sortedKeys(services[id]) {
+			s := services[id][name]
+			p.Services = append(p.Services, UsageService{
+				Name:       types.StringValue(name),
+				Quantity:   types.Float64Value(s.quantity),
+				Unit:       optionalStringValue(s.unit),
+				BilledCost: types.Float64Value(s.cost),
+			})
+			switch name {
+			case usageServiceBandwidth:
+				p.BandwidthGB = types.Float64Value(s.quantity)
+			case usageServiceFunctionInvocations:
+				p.FunctionInvocations = types.Float64Value(s.quantity)
+			case usageServiceBuildMinutes:
+				p.BuildMinutes = types.Float64Value(s.quantity)
+			}
+		}
+		projects = append(projects, p)
+	}
+	sort.SliceStable(projects, func(i, j int) bool {
+		return projects[i].Name.ValueString() < projects[j].Name.ValueString()
+	})
+	return projects, total, currency
+}
+
+func (d *usageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config Usage
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	charges, err := d.client.GetUsage(ctx, client.GetUsageRequest{
+		TeamID: config.TeamID.ValueString(),
+		From:   config.From.ValueString(),
+		To:     config.To.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading usage",
+			fmt.Sprintf("Could not read usage for team %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	projects, total, currency := summariseUsage(charges, config.ProjectID.ValueString())
+	result := Usage{
+		TeamID:     toTeamID(d.client.TeamID(config.TeamID.ValueString())),
+		From:       config.From,
+		To:         config.To,
+		ProjectID:  config.ProjectID,
+		Currency:   optionalStringValue(currency),
+		BilledCost: types.Float64Value(total),
+		Projects:   projects,
+	}
+	tflog.Info(ctx, "read usage", map[string]any{
+		"team_id":  result.TeamID.ValueString(),
+		"charges":  len(charges),
+		"projects": len(projects),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
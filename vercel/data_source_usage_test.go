@@ -0,0 +1,40 @@
+package vercel_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_UsageDataSource(t *testing.T) {
+	resourceName := "data.vercel_usage.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(`
+data "vercel_usage" "test" {
+  from = "2024-01-01T00:00:00Z"
+  to   = "2024-01-02T00:00:00Z"
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "team_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "billed_cost"),
+					resource.TestCheckResourceAttrSet(resourceName, "projects.#"),
+				),
+			},
+			{
+				Config: cfg(`
+data "vercel_usage" "test" {
+  from = "last month"
+  to   = "2024-01-02T00:00:00Z"
+}
+`),
+				ExpectError: regexp.MustCompile("RFC 3339"),
+			},
+		},
+	})
+}
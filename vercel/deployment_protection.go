@@ -10,8 +10,10 @@ type PasswordProtection struct {
 	DeploymentType types.String `tfsdk:"deployment_type"`
 }
 type PasswordProtectionWithPassword struct {
-	DeploymentType types.String `tfsdk:"deployment_type"`
-	Password       types.String `tfsdk:"password"`
+	DeploymentType  types.String `tfsdk:"deployment_type"`
+	Password        types.String `tfsdk:"password"`
+	PasswordWO      types.String `tfsdk:"password_wo"`
+	PasswordVersion types.String `tfsdk:"password_wo_version"`
 }
 
 type TrustedIpAddress struct {
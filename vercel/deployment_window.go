@@ -0,0 +1,242 @@
+package vercel
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	// Embed the IANA time zone database, so deployment window time zones can be
+	// resolved on hosts without one installed (e.g. Windows).
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DeploymentWindow represents a single entry of the `deployment_windows` attribute on a deployment.
+type DeploymentWindow struct {
+	Schedule types.String `tfsdk:"schedule"`
+	Duration types.String `tfsdk:"duration"`
+	TimeZone types.String `tfsdk:"time_zone"`
+}
+
+var deploymentWindowElemType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"schedule":  types.StringType,
+		"duration":  types.StringType,
+		"time_zone": types.StringType,
+	},
+}
+
+// cronField is a bitmask of the values a single field of a cron expression matches.
+type cronField uint64
+
+func (f cronField) has(v int) bool {
+	return f&(1<<uint(v)) != 0
+}
+
+// covers reports whether the field matches every value from min to max.
+func (f cronField) covers(min, max int) bool {
+	for v := min; v <= max; v++ {
+		if !f.has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// cronSchedule is a parsed five field cron expression: minute, hour, day of month, month and day of week.
+type cronSchedule struct {
+	minute, hour, dom, month, dow cronField
+	// Following cron, when both the day of month and day of week are restricted, a time
+	// matches if either of them matches. A field is restricted if it does not match every
+	// value, so `*/1` is not restricted.
+	domRestricted, dowRestricted bool
+}
+
+var cronFieldBounds = []struct {
+	name     string
+	min, max int
+}{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7},
+}
+
+// parseCronSchedule parses a standard five field cron expression, such as `0 9 * * 1-5`. Each field supports
+// `*`, single values, ranges (`1-5`), steps (`*/15`, `0-30/10`) and comma separated lists of these.
+func parseCronSchedule(expr string) (cronSchedule, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != len(cronFieldBounds) {
+		return cronSchedule{}, fmt.Errorf("expected 5 fields (minute, hour, day of month, month, day of week), got %d", len(parts))
+	}
+
+	fields := make([]cronField, len(parts))
+	for i, part := range parts {
+		b := cronFieldBounds[i]
+		f, err := parseCronField(part, b.min, b.max)
+		if err != nil {
+			return cronSchedule{}, fmt.Errorf("invalid %s field %q: %w", b.name, part, err)
+		}
+		fields[i] = f
+	}
+
+	// Sunday can be written as either 0 or 7.
+	if fields[4].has(7) {
+		fields[4] |= 1
+	}
+
+	return cronSchedule{
+		minute:        fields[0],
+		hour:          fields[1],
+		dom:           fields[2],
+		month:         fields[3],
+		dow:           fields[4],
+		domRestricted: !fields[2].covers(1, 31),
+		dowRestricted: !fields[4].covers(0, 6),
+	}, nil
+}
+
+func parseCronField(field string, min, max int) (cronField, error) {
+	var f cronField
+	for _, item := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			s, err := strconv.Atoi(stepPart)
+			if err != nil || s <= 0 {
+				return 0, fmt.Errorf("step %q must be a positive number", stepPart)
+			}
+			step = s
+		}
+
+		lo, hi := min, max
+		if rangePart != "*" {
+			from, to, isRange := strings.Cut(rangePart, "-")
+			var err error
+			lo, err = strconv.Atoi(from)
+			if err != nil {
+				return 0, fmt.Errorf("%q is not a number", from)
+			}
+			hi = lo
+			if isRange {
+				hi, err = strconv.Atoi(to)
+				if err != nil {
+					return 0, fmt.Errorf("%q is not a number", to)
+				}
+			} else if hasStep {
+				// `5/15` means every 15 starting at 5.
+				hi = max
+			}
+		}
+		if lo < min || hi > max {
+			return 0, fmt.Errorf("values must be between %d and %d", min, max)
+		}
+		if lo > hi {
+			return 0, fmt.Errorf("range %q must not start after it ends", rangePart)
+		}
+		for v := lo; v <= hi; v += step {
+			f |= 1 << uint(v)
+		}
+	}
+	return f, nil
+}
+
+// matches reports whether the minute containing t is matched by the schedule.
+func (c cronSchedule) matches(t time.Time) bool {
+	if !c.minute.has(t.Minute()) || !c.hour.has(t.Hour()) || !c.month.has(int(t.Month())) {
+		return false
+	}
+	dom := c.dom.has(t.Day())
+	dow := c.dow.has(int(t.Weekday()))
+	if c.domRestricted && c.dowRestricted {
+		return dom || dow
+	}
+	return dom && dow
+}
+
+// deploymentWindow is a parsed DeploymentWindow.
+type deploymentWindow struct {
+	schedule cronSchedule
+	duration time.Duration
+	location *time.Location
+}
+
+func (w DeploymentWindow) parse() (deploymentWindow, error) {
+	schedule, err := parseCronSchedule(w.Schedule.ValueString())
+	if err != nil {
+		return deploymentWindow{}, fmt.Errorf("invalid schedule %q: %w", w.Schedule.ValueString(), err)
+	}
+	duration, err := time.ParseDuration(w.Duration.ValueString())
+	if err != nil {
+		return deploymentWindow{}, fmt.Errorf("invalid duration %q: %w", w.Duration.ValueString(), err)
+	}
+	location := time.UTC
+	if !w.TimeZone.IsNull() && !w.TimeZone.IsUnknown() {
+		location, err = time.LoadLocation(w.TimeZone.ValueString())
+		if err != nil {
+			return deploymentWindow{}, fmt.Errorf("invalid time zone %q: %w", w.TimeZone.ValueString(), err)
+		}
+	}
+	return deploymentWindow{
+		schedule: schedule,
+		duration: duration,
+		location: location,
+	}, nil
+}
+
+// open reports whether the window is open at now, i.e. whether the schedule matched at some
+// minute in the `duration` before now.
+func (w deploymentWindow) open(now time.Time) bool {
+	start := now.In(w.location).Truncate(time.Minute)
+	for ; now.Sub(start) < w.duration; start = start.Add(-time.Minute) {
+		if w.schedule.matches(start) {
+			return true
+		}
+	}
+	return false
+}
+
+// next returns the next time after now that the window opens, looking up to a year ahead.
+func (w deploymentWindow) next(now time.Time) (time.Time, bool) {
+	start := now.In(w.location).Truncate(time.Minute).Add(time.Minute)
+	for limit := start.AddDate(1, 0, 0); start.Before(limit); start = start.Add(time.Minute) {
+		if w.schedule.matches(start) {
+			return start, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// checkDeploymentWindows returns an error if now falls outside all of the given deployment windows.
+func checkDeploymentWindows(windows []DeploymentWindow, now time.Time) error {
+	if len(windows) == 0 {
+		return nil
+	}
+
+	var next time.Time
+	for _, w := range windows {
+		window, err := w.parse()
+		if err != nil {
+			return err
+		}
+		if window.open(now) {
+			return nil
+		}
+		if n, ok := window.next(now); ok && (next.IsZero() || n.Before(next)) {
+			next = n
+		}
+	}
+
+	if next.IsZero() {
+		return fmt.Errorf("production deployments are not allowed at %s, as it is outside all of the configured `deployment_windows`", now.UTC().Format(time.RFC3339))
+	}
+	return fmt.Errorf(
+		"production deployments are not allowed at %s, as it is outside all of the configured `deployment_windows`. The next window opens at %s",
+		now.UTC().Format(time.RFC3339),
+		next.Format(time.RFC3339),
+	)
+}
@@ -0,0 +1,89 @@
+package vercel
+
+import (
+	"net"
+	"strings"
+
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// normalizeDNSName normalizes a hostname so that case differences and a trailing dot,
+// which are both insignificant in DNS, are ignored.
+func normalizeDNSName(v string) string {
+	return strings.ToLower(strings.TrimSuffix(strings.TrimSpace(v), "."))
+}
+
+// unquoteTXT converts a TXT record value in zone file format, such as `"v=spf1 " "-all"`, into the
+// plain text it represents. Values that are not quoted are returned unchanged.
+func unquoteTXT(v string) string {
+	if !strings.HasPrefix(v, `"`) || !strings.HasSuffix(v, `"`) || len(v) < 2 {
+		return v
+	}
+	var b strings.Builder
+	inQuotes := false
+	for i := 0; i < len(v); i++ {
+		c := v[i]
+		switch {
+		case c == '\\' && inQuotes && i+1 < len(v):
+			i++
+			b.WriteByte(v[i])
+		case c == '"':
+			inQuotes = !inQuotes
+		case inQuotes:
+			b.WriteByte(c)
+		case c == ' ' || c == '\t':
+			// Whitespace between character-strings is not part of the value.
+		default:
+			// Not a sequence of quoted strings, so treat the value as literal text.
+			return v
+		}
+	}
+	if inQuotes {
+		return v
+	}
+	return b.String()
+}
+
+// normalizeDNSValue returns a canonical form of a DNS record value for the given record type.
+func normalizeDNSValue(recordType, v string) string {
+	v = strings.TrimSpace(v)
+	switch recordType {
+	case "A", "AAAA":
+		if ip := net.ParseIP(v); ip != nil {
+			return ip.String()
+		}
+	case "ALIAS", "CNAME", "MX", "NS":
+		return normalizeDNSName(v)
+	case "TXT":
+		return unquoteTXT(v)
+	case "CAA":
+		// CAA values are `{flags} {tag} {value}`, where the tag is case-insensitive and the value may be quoted.
+		fields := strings.Fields(v)
+		if len(fields) >= 3 {
+			return strings.Join([]string{
+				fields[0],
+				strings.ToLower(fields[1]),
+				strings.Trim(strings.Join(fields[2:], " "), `"`),
+			}, " ")
+		}
+	}
+	return v
+}
+
+// dnsValuesEquivalent reports whether two DNS record values are semantically the same. This is used to
+// keep the configured value in state when the API returns it in a different, but equivalent, format.
+func dnsValuesEquivalent(recordType, a, b string) bool {
+	return normalizeDNSValue(recordType, a) == normalizeDNSValue(recordType, b)
+}
+
+// isSystemDNSRecord returns whether a DNS record is managed by Vercel itself, rather than by a user. These are the
+// records Vercel creates by default for a domain using its nameservers, and the TXT records used to verify domains
+// added to projects. Vercel recreates them whenever they are deleted, so they should not be treated as drift.
+func isSystemDNSRecord(record client.DNSRecord) bool {
+	if record.Creator == "system" {
+		return true
+	}
+	return record.RecordType == "TXT" &&
+		normalizeDNSName(record.Name) == "_vercel" &&
+		strings.HasPrefix(unquoteTXT(record.Value), "vc-domain-verify=")
+}
@@ -1,35 +1,52 @@
 package vercel
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
 )
 
 // EnvironmentItem reflects the state terraform stores internally for a project's environment variable.
 type EnvironmentItem struct {
+	Key                  types.String `tfsdk:"key"`
 	Target               types.Set    `tfsdk:"target"`
 	CustomEnvironmentIDs types.Set    `tfsdk:"custom_environment_ids"`
 	GitBranch            types.String `tfsdk:"git_branch"`
 	Value                types.String `tfsdk:"value"`
 	ID                   types.String `tfsdk:"id"`
 	Sensitive            types.Bool   `tfsdk:"sensitive"`
+	Type                 types.String `tfsdk:"type"`
 	Comment              types.String `tfsdk:"comment"`
+	ValueVersion         types.String `tfsdk:"value_version"`
+	EdgeConfigItem       types.Object `tfsdk:"edge_config_item"`
 }
 
 func (e *EnvironmentItem) toAttrValue() attr.Value {
 	return types.ObjectValueMust(EnvVariableElemType.AttrTypes, map[string]attr.Value{
 		"id":                     e.ID,
+		"key":                    e.Key,
 		"value":                  e.Value,
 		"target":                 e.Target,
 		"custom_environment_ids": e.CustomEnvironmentIDs,
 		"git_branch":             e.GitBranch,
 		"sensitive":              e.Sensitive,
+		"type":                   e.Type,
 		"comment":                e.Comment,
+		"value_version":          e.ValueVersion,
+		"edge_config_item":       e.EdgeConfigItem,
 	})
 }
 
 var EnvVariableElemType = types.ObjectType{
 	AttrTypes: map[string]attr.Type{
+		"key":   types.StringType,
 		"value": types.StringType,
 		"target": types.SetType{
 			ElemType: types.StringType,
@@ -37,9 +54,311 @@ var EnvVariableElemType = types.ObjectType{
 		"custom_environment_ids": types.SetType{
 			ElemType: types.StringType,
 		},
-		"git_branch": types.StringType,
-		"id":         types.StringType,
-		"sensitive":  types.BoolType,
-		"comment":    types.StringType,
+		"git_branch":       types.StringType,
+		"id":               types.StringType,
+		"sensitive":        types.BoolType,
+		"type":             types.StringType,
+		"comment":          types.StringType,
+		"value_version":    types.StringType,
+		"edge_config_item": edgeConfigItemReferenceType,
+	},
+}
+
+// EdgeConfigItemReference identifies the Edge Config item that an environment variable takes its value from.
+type EdgeConfigItemReference struct {
+	EdgeConfigID types.String `tfsdk:"edge_config_id"`
+	Key          types.String `tfsdk:"key"`
+}
+
+var edgeConfigItemReferenceType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"edge_config_id": types.StringType,
+		"key":            types.StringType,
 	},
+}
+
+// name returns the name of the environment variable with the given map key. This is the map key itself, unless
+// `key` is set so that the same name can be used more than once.
+func (e EnvironmentItem) name(key string) string {
+	if !e.Key.IsNull() && !e.Key.IsUnknown() {
+		return e.Key.ValueString()
+	}
+	return key
+}
+
+// envVariableType returns the type an environment variable should be created or updated with. A configured `type`
+// is used as is, otherwise it is derived from `sensitive`.
+func (e *EnvironmentItem) envVariableType() string {
+	if !e.Type.IsNull() && !e.Type.IsUnknown() {
+		return e.Type.ValueString()
+	}
+	if e.Sensitive.ValueBool() {
+		return "sensitive"
+	}
+	return "encrypted"
+}
+
+// sameGitBranch returns true if two git branches refer to the same branch. Vercel returns either no branch or an
+// empty string for variables that are not scoped to a branch, and may not preserve the case of the branch name.
+func sameGitBranch(a, b *string) bool {
+	var x, y string
+	if a != nil {
+		x = *a
+	}
+	if b != nil {
+		y = *b
+	}
+	return strings.EqualFold(x, y)
+}
+
+// gitBranchValue returns the git branch to store in state for a variable. If the branch returned by the API is the
+// same as the configured one, the configured value is kept, so that differences in case or between an empty string
+// and null are not reported as drift.
+func gitBranchValue(configured types.String, fromAPI *string) types.String {
+	if !configured.IsUnknown() && sameGitBranch(configured.ValueStringPointer(), fromAPI) {
+		return configured
+	}
+	if fromAPI == nil || *fromAPI == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(*fromAPI)
+}
+
+// withoutDevelopmentTarget returns a copy of the environment variable with the `development` target removed.
+// This is used when development values are not managed by Terraform, so that changes made with
+// `vercel env` are not reported as drift.
+func withoutDevelopmentTarget(e client.EnvironmentVariable) client.EnvironmentVariable {
+	target := make([]string, 0, len(e.Target))
+	for _, t := range e.Target {
+		if t != "development" {
+			target = append(target, t)
+		}
+	}
+	e.Target = target
+	return e
+}
+
+// isDevelopmentOnly returns whether an environment variable only targets the `development` environment.
+func isDevelopmentOnly(e client.EnvironmentVariable) bool {
+	return len(e.Target) == 1 && e.Target[0] == "development" && len(e.CustomEnvironmentIDs) == 0
+}
+
+// targetsDevelopment returns whether a planned or configured target set includes `development`.
+func targetsDevelopment(target types.Set) bool {
+	for _, t := range target.Elements() {
+		if t.Equal(types.StringValue("development")) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateCustomEnvironmentIDs checks that every custom environment ID referenced by the given environment variables
+// exists on the project. The API rejects unknown IDs without saying which variable is at fault, so an attribute
+// error is returned against the attribute returned by pathFor, given the index of the variable, for each invalid ID
+// instead.
+func validateCustomEnvironmentIDs(ctx context.Context, c *client.Client, projectID, teamID string, envs []client.EnvironmentVariableRequest, pathFor func(i int) path.Path) (diags diag.Diagnostics) {
+	hasCustomEnvironments := false
+	for _, e := range envs {
+		if len(e.CustomEnvironmentIDs) > 0 {
+			hasCustomEnvironments = true
+			break
+		}
+	}
+	if !hasCustomEnvironments {
+		return nil
+	}
+
+	customEnvironments, err := c.ListCustomEnvironments(ctx, client.ListCustomEnvironmentsRequest{
+		ProjectID: projectID,
+		TeamID:    teamID,
+	})
+	if err != nil {
+		diags.AddError(
+			"Error validating custom environments",
+			"Could not list project custom environments, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+	existing := map[string]struct{}{}
+	for _, ce := range customEnvironments {
+		existing[ce.ID] = struct{}{}
+	}
+
+	for i, e := range envs {
+		for _, id := range e.CustomEnvironmentIDs {
+			if _, ok := existing[id]; ok {
+				continue
+			}
+			diags.AddAttributeError(
+				pathFor(i),
+				"Invalid custom environment ID",
+				fmt.Sprintf("The custom environment %q does not exist on project %s, so the Environment Variable %s could not be configured.", id, projectID, e.Key),
+			)
+		}
+	}
+	return diags
+}
+
+// newCustomEnvironmentIDs returns the known custom environment IDs in a planned set that are not in the prior set.
+// These are the references that need validating at plan time: unknown IDs, such as those of Custom Environments
+// created in the same apply, cannot be checked yet, and the prior IDs were already checked when they were applied.
+func newCustomEnvironmentIDs(planned, prior types.Set) []string {
+	existing := map[string]struct{}{}
+	for _, v := range prior.Elements() {
+		if s, ok := v.(types.String); ok {
+			existing[s.ValueString()] = struct{}{}
+		}
+	}
+	var ids []string
+	for _, v := range planned.Elements() {
+		s, ok := v.(types.String)
+		if !ok || s.IsNull() || s.IsUnknown() {
+			continue
+		}
+		if _, ok := existing[s.ValueString()]; !ok {
+			ids = append(ids, s.ValueString())
+		}
+	}
+	return ids
+}
+
+// isBuiltInTarget returns whether a target is one of the environments that every project has, rather than the
+// slug of a Custom Environment.
+func isBuiltInTarget(t string) bool {
+	return t == "production" || t == "preview" || t == "development"
+}
+
+// splitTargets separates the built-in environments in a target from the Custom Environment slugs.
+func splitTargets(target []string) (builtIn []string, slugs []string) {
+	for _, t := range target {
+		if isBuiltInTarget(t) {
+			builtIn = append(builtIn, t)
+		} else {
+			slugs = append(slugs, t)
+		}
+	}
+	return builtIn, slugs
+}
+
+// customEnvironmentSlugs returns the Custom Environment slugs in a planned or configured target set.
+func customEnvironmentSlugs(target types.Set) []string {
+	var slugs []string
+	for _, t := range target.Elements() {
+		s, ok := t.(types.String)
+		if !ok || s.IsNull() || s.IsUnknown() || isBuiltInTarget(s.ValueString()) {
+			continue
+		}
+		slugs = append(slugs, s.ValueString())
+	}
+	return slugs
+}
+
+// resolveCustomEnvironmentSlugs looks up the IDs of the Custom Environments with the given slugs. An attribute
+// error is returned against p for any slug that does not exist on the project.
+func resolveCustomEnvironmentSlugs(ctx context.Context, c *client.Client, projectID, teamID string, slugs []string, p path.Path) (ids []string, diags diag.Diagnostics) {
+	if len(slugs) == 0 {
+		return nil, nil
+	}
+	customEnvironments, err := c.ListCustomEnvironments(ctx, client.ListCustomEnvironmentsRequest{
+		ProjectID: projectID,
+		TeamID:    teamID,
+	})
+	if err != nil {
+		diags.AddError(
+			"Error resolving custom environments",
+			"Could not list project custom environments, unexpected error: "+err.Error(),
+		)
+		return nil, diags
+	}
+	bySlug := map[string]string{}
+	for _, ce := range customEnvironments {
+		bySlug[ce.Slug] = ce.ID
+	}
+	for _, slug := range slugs {
+		id, ok := bySlug[slug]
+		if !ok {
+			diags.AddAttributeError(
+				p,
+				"Invalid target",
+				fmt.Sprintf("The target %q is not `production`, `preview`, `development` or the slug of a custom environment on project %s.", slug, projectID),
+			)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, diags
+}
+
+// privateState is implemented by the private state of resource requests and responses.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// envVariableWrite is kept in private state for each environment variable that Terraform writes. The values of
+// sensitive environment variables cannot be read back, so an `updatedAt` later than the one recorded here is the only
+// way to tell that they have been changed outside of Terraform.
+type envVariableWrite struct {
+	UpdatedAt      int64 `json:"updated_at"`
+	ChangedOutside bool  `json:"changed_outside,omitempty"`
+}
+
+func envVariableWriteKey(projectID, teamID, key string) string {
+	return fmt.Sprintf("vercel_env_updated_at_%s_%s_%s", projectID, teamID, key)
+}
+
+// recordEnvVariableWrite records when Terraform last wrote an environment variable, under the key it is managed with.
+func recordEnvVariableWrite(ctx context.Context, private privateState, projectID, teamID, key string, e client.EnvironmentVariable) diag.Diagnostics {
+	if e.UpdatedAt == 0 {
+		return private.SetKey(ctx, envVariableWriteKey(projectID, teamID, key), nil)
+	}
+	w, _ := json.Marshal(envVariableWrite{UpdatedAt: e.UpdatedAt})
+	return private.SetKey(ctx, envVariableWriteKey(projectID, teamID, key), w)
+}
+
+// detectEnvVariableChangedOutside marks an environment variable whose value cannot be read as changed outside of
+// Terraform, if it has been updated since Terraform last wrote it.
+func detectEnvVariableChangedOutside(ctx context.Context, private privateState, projectID, teamID, key string, e client.EnvironmentVariable) diag.Diagnostics {
+	if e.UpdatedAt == 0 || (e.Type != "sensitive" && (e.Decrypted == nil || *e.Decrypted)) {
+		return nil
+	}
+	privateKey := envVariableWriteKey(projectID, teamID, key)
+	stored, diags := private.GetKey(ctx, privateKey)
+	if diags.HasError() || len(stored) == 0 {
+		return diags
+	}
+	var w envVariableWrite
+	if err := json.Unmarshal(stored, &w); err != nil || w.ChangedOutside || e.UpdatedAt <= w.UpdatedAt {
+		return nil
+	}
+	w.ChangedOutside = true
+	updated, _ := json.Marshal(w)
+	return private.SetKey(ctx, privateKey, updated)
+}
+
+// envVariableChangedOutside returns whether an environment variable was found to have been changed outside of
+// Terraform when it was last read.
+func envVariableChangedOutside(ctx context.Context, private privateState, projectID, teamID, key string) bool {
+	stored, _ := private.GetKey(ctx, envVariableWriteKey(projectID, teamID, key))
+	var w envVariableWrite
+	return len(stored) > 0 && json.Unmarshal(stored, &w) == nil && w.ChangedOutside
+}
+
+// moveEnvVariablePrivateState copies what is kept in private state about an environment variable when it is moved
+// between the project environment variable resources, as it is stored under the key the variable is managed with.
+func moveEnvVariablePrivateState(ctx context.Context, source, target privateState, projectID, teamID, fromKey, toKey string) (diags diag.Diagnostics) {
+	prefix := fmt.Sprintf("vercel_env_%s_%s_", projectID, teamID)
+	for from, to := range map[string]string{
+		prefix + fromKey: prefix + toKey,
+		envVariableWriteKey(projectID, teamID, fromKey): envVariableWriteKey(projectID, teamID, toKey),
+	} {
+		value, d := source.GetKey(ctx, from)
+		diags.Append(d...)
+		if len(value) == 0 {
+			continue
+		}
+		diags.Append(target.SetKey(ctx, to, value)...)
+	}
+	return diags
 }
\ No newline at end of file
@@ -0,0 +1,197 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &projectEnvironmentVariableEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &projectEnvironmentVariableEphemeralResource{}
+)
+
+func newProjectEnvironmentVariableEphemeralResource() ephemeral.EphemeralResource {
+	return &projectEnvironmentVariableEphemeralResource{}
+}
+
+type projectEnvironmentVariableEphemeralResource struct {
+	client *client.Client
+}
+
+func (r *projectEnvironmentVariableEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_environment_variable"
+}
+
+func (r *projectEnvironmentVariableEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *projectEnvironmentVariableEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides the decrypted value of a Project Environment Variable, without storing it in Terraform state or plan files.
+
+This can be used to pass the value of an Environment Variable to another provider, such as into a Kubernetes Secret, or into a write-only attribute.
+
+~> Ephemeral resources are supported in Terraform 1.10 and later. The values of ` + "`sensitive`" + ` Environment Variables cannot be decrypted, so they cannot be read.
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the Vercel project.",
+				Required:    true,
+			},
+			"team_id": schema.StringAttribute{
+				Description: "The ID of the Vercel team. Required when reading a team resource if a default team has not been set in the provider.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The ID of the Environment Variable. Exactly one of `id` or `key` must be set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("key")),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "The name of the Environment Variable. Exactly one of `id` or `key` must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"target": schema.StringAttribute{
+				Description: "When looking up by `key`, only match an Environment Variable available to this environment: one of `production`, `preview` or `development`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("production", "preview", "development"),
+					stringvalidator.ConflictsWith(path.MatchRoot("id")),
+				},
+			},
+			"git_branch": schema.StringAttribute{
+				Description: "When looking up by `key`, only match an Environment Variable scoped to this git branch. Otherwise, Environment Variables that are scoped to a git branch are not matched.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("id")),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "The decrypted value of the Environment Variable.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"comment": schema.StringAttribute{
+				Description: "The comment attached to the Environment Variable.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+type ProjectEnvironmentVariableEphemeral struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	TeamID    types.String `tfsdk:"team_id"`
+	ID        types.String `tfsdk:"id"`
+	Key       types.String `tfsdk:"key"`
+	Target    types.String `tfsdk:"target"`
+	GitBranch types.String `tfsdk:"git_branch"`
+	Value     types.String `tfsdk:"value"`
+	Comment   types.String `tfsdk:"comment"`
+}
+
+// findEnvironmentVariable looks up the environment variable described by the configuration, either by its ID, or by
+// its key, target and git branch.
+func (r *projectEnvironmentVariableEphemeralResource) findEnvironmentVariable(ctx context.Context, config ProjectEnvironmentVariableEphemeral) (client.EnvironmentVariable, error) {
+	projectID, teamID := config.ProjectID.ValueString(), config.TeamID.ValueString()
+	if config.ID.ValueString() != "" {
+		return r.client.GetEnvironmentVariable(ctx, projectID, teamID, config.ID.ValueString())
+	}
+
+	envs, err := r.client.ListEnvironmentVariables(ctx, projectID, teamID, client.EnvironmentVariableFilter{
+		Target: config.Target.ValueString(),
+	})
+	if err != nil {
+		return client.EnvironmentVariable{}, err
+	}
+	var matches []client.EnvironmentVariable
+	for _, e := range envs {
+		if e.Key == config.Key.ValueString() && sameGitBranch(config.GitBranch.ValueStringPointer(), e.GitBranch) {
+			matches = append(matches, e)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return client.EnvironmentVariable{}, fmt.Errorf("no environment variable found with key %s", config.Key.ValueString())
+	case 1:
+		// The list may not include the decrypted value, so read the variable on its own.
+		return r.client.GetEnvironmentVariable(ctx, projectID, teamID, matches[0].ID)
+	default:
+		return client.EnvironmentVariable{}, fmt.Errorf("%d environment variables found with key %s, please set `target` or `git_branch` to select one, or use `id`", len(matches), config.Key.ValueString())
+	}
+}
+
+func (r *projectEnvironmentVariableEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config ProjectEnvironmentVariableEphemeral
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	env, err := r.findEnvironmentVariable(ctx, config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading project environment variable",
+			fmt.Sprintf("Could not read environment variable for project %s, unexpected error: %s", config.ProjectID.ValueString(), err),
+		)
+		return
+	}
+	if env.Type == "sensitive" || (env.Decrypted != nil && !*env.Decrypted) {
+		resp.Diagnostics.AddError(
+			"Error reading project environment variable",
+			fmt.Sprintf("The value of environment variable %s (%s) cannot be decrypted. The values of sensitive environment variables can only be read by Vercel's deployment system.", env.Key, env.ID),
+		)
+		return
+	}
+
+	result := ProjectEnvironmentVariableEphemeral{
+		ProjectID: config.ProjectID,
+		TeamID:    types.StringValue(r.client.TeamID(config.TeamID.ValueString())),
+		ID:        types.StringValue(env.ID),
+		Key:       types.StringValue(env.Key),
+		Target:    config.Target,
+		GitBranch: types.StringPointerValue(env.GitBranch),
+		Value:     types.StringValue(env.Value),
+		Comment:   types.StringValue(env.Comment),
+	}
+	tflog.Info(ctx, "opened project environment variable", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"env_id":     result.ID.ValueString(),
+	})
+
+	diags = resp.Result.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
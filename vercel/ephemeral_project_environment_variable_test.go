@@ -0,0 +1,66 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAcc_ProjectEnvironmentVariableEphemeral(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccProjectDestroy(testClient(t), "vercel_project.example", testTeam(t)),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(fmt.Sprintf(`
+resource "vercel_project" "example" {
+  name = "test-acc-env-ephemeral-%[1]s"
+}
+
+resource "vercel_project_environment_variable" "source" {
+  project_id = vercel_project.example.id
+  key        = "SOURCE"
+  value      = "ephemeral-value"
+  target     = ["production"]
+  sensitive  = false
+}
+
+ephemeral "vercel_project_environment_variable" "source" {
+  project_id = vercel_project.example.id
+  key        = vercel_project_environment_variable.source.key
+  target     = "production"
+}
+
+resource "vercel_project_environment_variable" "copy" {
+  project_id = vercel_project.example.id
+  key        = "COPY"
+  value      = ephemeral.vercel_project_environment_variable.source.value
+  target     = ["production"]
+  sensitive  = false
+}
+`, nameSuffix)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						rs, ok := s.RootModule().Resources["vercel_project_environment_variable.copy"]
+						if !ok {
+							return fmt.Errorf("not found: vercel_project_environment_variable.copy")
+						}
+						env, err := testClient(t).GetEnvironmentVariable(context.TODO(), rs.Primary.Attributes["project_id"], testTeam(t), rs.Primary.ID)
+						if err != nil {
+							return err
+						}
+						if env.Value != "ephemeral-value" {
+							return fmt.Errorf("expected the copied value to be %q, got %q", "ephemeral-value", env.Value)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,140 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &dotenvFunction{}
+
+func newDotenvFunction() function.Function {
+	return &dotenvFunction{}
+}
+
+type dotenvFunction struct{}
+
+func (f *dotenvFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dotenv"
+}
+
+func (f *dotenvFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parses the contents of a .env file into a map of Environment Variables.",
+		MarkdownDescription: "Parses the contents of a .env file, such as one written by `vercel env pull` or the `to_dotenv` function, into a map of Environment Variable names to values. " +
+			"Blank lines, `#` comments and a leading `export` are ignored. Double quoted values may span multiple lines, and `\\n`, `\\r`, `\\t`, `\\\"` and `\\\\` within them are unescaped. " +
+			"Single quoted and backtick quoted values are used literally, and may also span multiple lines. Unquoted values end at the first ` #`, and surrounding whitespace is trimmed. " +
+			"Variable references such as `${OTHER}` are not expanded. If a key is defined more than once, the last definition is used.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "file_contents",
+				Description: "The contents of a .env file, for example from the `file` function.",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *dotenvFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var contents string
+	resp.Error = req.Arguments.Get(ctx, &contents)
+	if resp.Error != nil {
+		return
+	}
+	variables, err := parseDotenv(contents)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, variables)
+}
+
+var dotenvKeyRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*$`)
+
+var dotenvValueUnescaper = strings.NewReplacer(
+	`\\`, `\`,
+	`\"`, `"`,
+	`\n`, "\n",
+	`\r`, "\r",
+	`\t`, "\t",
+)
+
+// parseDotenv parses .env file contents in the same way as the dotenv package used by the Vercel CLI. Errors
+// include the line number they occurred on, but never any values.
+func parseDotenv(contents string) (map[string]string, error) {
+	lines := strings.Split(strings.ReplaceAll(contents, "\r\n", "\n"), "\n")
+	variables := map[string]string{}
+	for i := 0; i < len(lines); i++ {
+		lineNumber := i + 1
+		line := strings.TrimSpace(lines[i])
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimSpace(strings.TrimPrefix(line, "export "))
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d is not in KEY=value format", lineNumber)
+		}
+		key = strings.TrimSpace(key)
+		if !dotenvKeyRe.MatchString(key) {
+			return nil, fmt.Errorf("line %d has an invalid key %q", lineNumber, key)
+		}
+		value = strings.TrimSpace(value)
+
+		if value == "" || !strings.ContainsAny(value[:1], "\"'`") {
+			// Unquoted values end at an inline comment.
+			if idx := strings.Index(value, " #"); idx >= 0 {
+				value = value[:idx]
+			}
+			variables[key] = strings.TrimSpace(value)
+			continue
+		}
+
+		// Quoted values continue until the closing quote, which may be on a later line.
+		quote := value[:1]
+		value = value[1:]
+		for {
+			end := closingQuote(value, quote)
+			if end >= 0 {
+				rest := strings.TrimSpace(value[end+1:])
+				if rest != "" && !strings.HasPrefix(rest, "#") {
+					return nil, fmt.Errorf("line %d has unexpected characters after the closing quote of %s", i+1, key)
+				}
+				value = value[:end]
+				break
+			}
+			i++
+			if i >= len(lines) {
+				return nil, fmt.Errorf("line %d has an unterminated quoted value for %s", lineNumber, key)
+			}
+			value += "\n" + lines[i]
+		}
+		if quote == `"` {
+			value = dotenvValueUnescaper.Replace(value)
+		}
+		variables[key] = value
+	}
+	return variables, nil
+}
+
+// closingQuote returns the index of the quote that ends a quoted value, or -1 if the value does not end. Within
+// double quotes, quotes escaped with a backslash do not end the value.
+func closingQuote(value, quote string) int {
+	for i := 0; i < len(value); i++ {
+		if quote == `"` && value[i] == '\\' {
+			i++
+			continue
+		}
+		if value[i] == quote[0] {
+			return i
+		}
+	}
+	return -1
+}
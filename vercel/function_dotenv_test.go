@@ -0,0 +1,76 @@
+package vercel_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_DotenvFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(`
+locals {
+  parsed = provider::vercel::dotenv(<<-EOT
+    # A comment
+    export API_URL=https://api.example.com # inline comment
+    GREETING="hello\nworld"
+    LITERAL='$NOT_EXPANDED'
+    MULTILINE="first
+    second"
+  EOT
+  )
+  round_trip = provider::vercel::dotenv(provider::vercel::to_dotenv({ QUOTED = "a \"quoted\" \\ value" }))
+}
+
+output "keys" {
+  value = join(",", sort(keys(local.parsed)))
+}
+
+output "api_url" {
+  value = local.parsed.API_URL
+}
+
+output "greeting" {
+  value = local.parsed.GREETING
+}
+
+output "literal" {
+  value = local.parsed.LITERAL
+}
+
+output "multiline" {
+  value = local.parsed.MULTILINE
+}
+
+output "round_trip" {
+  value = local.round_trip.QUOTED
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("keys", "API_URL,GREETING,LITERAL,MULTILINE"),
+					resource.TestCheckOutput("api_url", "https://api.example.com"),
+					resource.TestCheckOutput("greeting", "hello\nworld"),
+					resource.TestCheckOutput("literal", "$NOT_EXPANDED"),
+					resource.TestCheckOutput("multiline", "first\nsecond"),
+					resource.TestCheckOutput("round_trip", `a "quoted" \ value`),
+				),
+			},
+			{
+				Config: cfg(`
+output "parsed" {
+  value = provider::vercel::dotenv("FOO=\"unterminated")
+}
+`),
+				ExpectError: regexp.MustCompile(`unterminated\s+quoted\s+value\s+for\s+FOO`),
+			},
+		},
+	})
+}
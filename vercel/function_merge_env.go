@@ -0,0 +1,160 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &mergeEnvFunction{}
+
+func newMergeEnvFunction() function.Function {
+	return &mergeEnvFunction{}
+}
+
+type mergeEnvFunction struct{}
+
+func (f *mergeEnvFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "merge_env"
+}
+
+func (f *mergeEnvFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Merges maps of Environment Variables, failing if the same key is defined differently.",
+		MarkdownDescription: "Merges maps of Environment Variables, such as the `variables` of a `vercel_project_environment_variables` resource, into a single map. " +
+			"Unlike the built-in `merge` function, a key defined by more than one map must have the same value in each, including its `target` and any other settings; otherwise an error is returned naming the key and the conflicting arguments. " +
+			"Values are never included in error messages. Elements may either be plain strings or objects, and null arguments are ignored.",
+		VariadicParameter: function.DynamicParameter{
+			Name:           "maps",
+			Description:    "Maps of Environment Variable names to values or to Environment Variable objects.",
+			AllowNullValue: true,
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+func (f *mergeEnvFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var maps []types.Dynamic
+	resp.Error = req.Arguments.Get(ctx, &maps)
+	if resp.Error != nil {
+		return
+	}
+
+	merged := map[string]attr.Value{}
+	mergedTypes := map[string]attr.Type{}
+	definedIn := map[string]int{}
+	for i, m := range maps {
+		if m.IsNull() || m.IsUnderlyingValueNull() {
+			continue
+		}
+		if m.IsUnknown() || m.IsUnderlyingValueUnknown() {
+			resp.Error = resp.Result.Set(ctx, types.DynamicUnknown())
+			return
+		}
+
+		var elements map[string]attr.Value
+		switch v := m.UnderlyingValue().(type) {
+		case types.Map:
+			elements = v.Elements()
+		case types.Object:
+			elements = v.Attributes()
+		default:
+			resp.Error = function.NewArgumentFuncError(int64(i), fmt.Sprintf("Argument %d must be a map, got %s.", i+1, v.Type(ctx)))
+			return
+		}
+
+		keys := make([]string, 0, len(elements))
+		for k := range elements {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			v := elements[k]
+			existing, ok := merged[k]
+			if !ok {
+				merged[k] = v
+				mergedTypes[k] = v.Type(ctx)
+				definedIn[k] = i
+				continue
+			}
+			if canonicalEnvValue(existing) != canonicalEnvValue(v) {
+				resp.Error = function.NewArgumentFuncError(int64(i), fmt.Sprintf(
+					"Environment Variable %s is defined differently in arguments %d and %d.",
+					k,
+					definedIn[k]+1,
+					i+1,
+				))
+				return
+			}
+		}
+	}
+
+	result, diags := types.ObjectValue(mergedTypes, merged)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, types.DynamicValue(result))
+}
+
+// canonicalEnvValue renders a value so that two values can be compared regardless of how they were written. Lists,
+// tuples and sets are compared without regard to order, as environment variable targets are sets.
+func canonicalEnvValue(v attr.Value) string {
+	if v.IsNull() {
+		return "null"
+	}
+	if v.IsUnknown() {
+		return "unknown"
+	}
+
+	switch t := v.(type) {
+	case types.String:
+		return strconv.Quote(t.ValueString())
+	case types.Dynamic:
+		return canonicalEnvValue(t.UnderlyingValue())
+	case types.Object:
+		return canonicalEnvMap(t.Attributes())
+	case types.Map:
+		return canonicalEnvMap(t.Elements())
+	case types.List:
+		return canonicalEnvList(t.Elements())
+	case types.Set:
+		return canonicalEnvList(t.Elements())
+	case types.Tuple:
+		return canonicalEnvList(t.Elements())
+	}
+	return v.String()
+}
+
+func canonicalEnvMap(elements map[string]attr.Value) string {
+	keys := make([]string, 0, len(elements))
+	for k, e := range elements {
+		// A null attribute is the same as one that is not set.
+		if e.IsNull() {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, strconv.Quote(k)+"="+canonicalEnvValue(elements[k]))
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+func canonicalEnvList(elements []attr.Value) string {
+	parts := make([]string, 0, len(elements))
+	for _, e := range elements {
+		parts = append(parts, canonicalEnvValue(e))
+	}
+	sort.Strings(parts)
+	return "[" + strings.Join(parts, ",") + "]"
+}
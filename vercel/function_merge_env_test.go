@@ -0,0 +1,59 @@
+package vercel_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_MergeEnvFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(`
+locals {
+  merged = provider::vercel::merge_env(
+    {
+      FOO = { value = "foo", target = ["production", "preview"] }
+    },
+    {
+      FOO = { value = "foo", target = ["preview", "production"] }
+      BAR = { value = "bar", target = ["production"] }
+    },
+    null,
+  )
+}
+
+output "keys" {
+  value = join(",", sort(keys(local.merged)))
+}
+
+output "bar" {
+  value = local.merged.BAR.value
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("keys", "BAR,FOO"),
+					resource.TestCheckOutput("bar", "bar"),
+				),
+			},
+			{
+				Config: cfg(`
+output "merged" {
+  value = provider::vercel::merge_env(
+    { FOO = { value = "foo", target = ["production"] } },
+    { FOO = { value = "foo", target = ["preview"] } },
+  )
+}
+`),
+				ExpectError: regexp.MustCompile(`FOO\s+is\s+defined\s+differently`),
+			},
+		},
+	})
+}
@@ -0,0 +1,73 @@
+package vercel
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &toDotenvFunction{}
+
+func newToDotenvFunction() function.Function {
+	return &toDotenvFunction{}
+}
+
+type toDotenvFunction struct{}
+
+func (f *toDotenvFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "to_dotenv"
+}
+
+func (f *toDotenvFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Renders a map of Environment Variables in .env file format.",
+		MarkdownDescription: "Renders a map of Environment Variables in .env file format, suitable for writing to a `.env.production` or `.env.preview` file with the `local_file` resource. " +
+			"Variables are written one per line as `KEY=\"value\"`, sorted by key. Backslashes, double quotes and newlines within values are escaped.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:        "variables",
+				Description: "A map of Environment Variable names to values.",
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *toDotenvFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var variables map[string]string
+	resp.Error = req.Arguments.Get(ctx, &variables)
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, renderDotenv(variables))
+}
+
+var dotenvValueReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	"\r", `\r`,
+	"\n", `\n`,
+)
+
+// renderDotenv renders variables in the same format as `vercel env pull`. Keys are sorted so the
+// output is stable between runs.
+func renderDotenv(variables map[string]string) string {
+	keys := make([]string, 0, len(variables))
+	for k := range variables {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		b.WriteString(k)
+		b.WriteString(`="`)
+		b.WriteString(dotenvValueReplacer.Replace(variables[k]))
+		b.WriteString("\"\n")
+	}
+	return b.String()
+}
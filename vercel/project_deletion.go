@@ -0,0 +1,30 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// projectPendingDeletion returns whether a project is being deleted by Vercel. Resources that belong to such a
+// project may already be reported as not found, but should not be removed from state until the project itself is
+// gone, as the project may still be restored and removing them would plan to recreate all of them.
+func projectPendingDeletion(ctx context.Context, c *client.Client, projectID, teamID string) bool {
+	out, err := c.GetProject(ctx, projectID, teamID)
+	return err == nil && out.PendingDeletion()
+}
+
+// addProjectPendingDeletionWarning warns that a resource has been kept in state because its project is being
+// deleted.
+func addProjectPendingDeletionWarning(diags *diag.Diagnostics, resource, projectID string) {
+	diags.AddWarning(
+		"Project is pending deletion",
+		fmt.Sprintf(
+			"The project %s is being deleted by Vercel, so the %s could not be read. It has been left unchanged in state until the deletion completes. If the project was deleted deliberately, remove it and its resources from your configuration.",
+			projectID,
+			resource,
+		),
+	)
+}
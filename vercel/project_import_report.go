@@ -0,0 +1,127 @@
+package vercel
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// projectMetadataFields are fields returned for a project that describe it, rather than configure it, or that are
+// managed by other resources. These are never reported as unmanaged.
+var projectMetadataFields = []string{
+	"accountId",
+	"analytics",
+	"connectBuildsEnabled",
+	"connectConfigurationId",
+	"connectConfigurations",
+	"createdAt",
+	"crons",
+	"deletedAt",
+	"deploymentCount",
+	"deploymentExpiration",
+	"env",
+	"features",
+	"hasActiveBranches",
+	"hasFloatingAliases",
+	"lastAliasRequest",
+	"lastRollbackTarget",
+	"latestDeployments",
+	"live",
+	"permissions",
+	"security",
+	"speedInsights",
+	"tier",
+	"transferCompletedAt",
+	"transferStartedAt",
+	"transferToAccountId",
+	"transferredFromAccountId",
+	"updatedAt",
+	"webAnalytics",
+}
+
+// projectFieldAttributes maps the fields of a project, as returned by the API, to the vercel_project attribute that
+// manages them, where the attribute is not named after the field.
+var projectFieldAttributes = map[string]string{
+	"autoExposeSystemEnvs":                 "automatically_expose_system_environment_variables",
+	"autoJobCancelation":                   "cancel_outdated_builds",
+	"commandForIgnoringBuildStep":          "ignore_command",
+	"customerSupportCodeVisibility":        "customer_success_code_visibility",
+	"gitLFS":                               "git_lfs",
+	"link":                                 "git_repository",
+	"productionDeploymentsFastLane":        "prioritise_production_builds",
+	"protectionBypass":                     "protection_bypass_for_automation",
+	"serverlessFunctionZeroConfigFailover": "function_failover",
+	"skewProtectionMaxAge":                 "skew_protection",
+	"ssoProtection":                        "vercel_authentication",
+	"targets":                              "latest_production_deployment",
+}
+
+// projectFieldAttribute returns the name of the vercel_project attribute for a field of a project. Unless the
+// field is in projectFieldAttributes, this is the field name in snake case.
+func projectFieldAttribute(field string) string {
+	if name, ok := projectFieldAttributes[field]; ok {
+		return name
+	}
+	var b strings.Builder
+	for _, r := range field {
+		if unicode.IsUpper(r) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// onlyAutomationBypass returns whether every protection bypass of a project is for automation. Other kinds of
+// protection bypass, such as shareable links, are not managed by protection_bypass_for_automation.
+func onlyAutomationBypass(raw json.RawMessage) bool {
+	var bypasses map[string]client.ProtectionBypass
+	if err := json.Unmarshal(raw, &bypasses); err != nil {
+		return false
+	}
+	for _, b := range bypasses {
+		if b.Scope != "automation-bypass" {
+			return false
+		}
+	}
+	return true
+}
+
+// isEmptyJSON returns whether a raw JSON value is null, or the zero value of its type. Fields with these values
+// have not been configured, so are not worth reporting.
+func isEmptyJSON(raw json.RawMessage) bool {
+	switch string(bytes.TrimSpace(raw)) {
+	case "", "null", "false", "0", `""`, "[]", "{}":
+		return true
+	}
+	return false
+}
+
+// unmanagedProjectFields returns the fields of a project, as returned by the API, that are set but not represented
+// by any of the attributes of the vercel_project resource. These are sorted by name.
+func unmanagedProjectFields(attributes map[string]schema.Attribute, fields map[string]json.RawMessage) []string {
+	metadata := map[string]bool{}
+	for _, name := range projectMetadataFields {
+		metadata[name] = true
+	}
+
+	var unmanaged []string
+	for name, value := range fields {
+		if metadata[name] || isEmptyJSON(value) {
+			continue
+		}
+		if _, ok := attributes[projectFieldAttribute(name)]; ok {
+			if name != "protectionBypass" || onlyAutomationBypass(value) {
+				continue
+			}
+		}
+		unmanaged = append(unmanaged, name)
+	}
+	sort.Strings(unmanaged)
+	return unmanaged
+}
@@ -0,0 +1,63 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestUnmanagedProjectFields(t *testing.T) {
+	var projectSchema resource.SchemaResponse
+	newProjectResource().Schema(context.Background(), resource.SchemaRequest{}, &projectSchema)
+
+	for _, tc := range []struct {
+		name   string
+		fields map[string]string
+		want   []string
+	}{
+		{
+			name:   "empty values are ignored",
+			fields: map[string]string{"microfrontends": `{}`, "buildCommand": `null`},
+		},
+		{
+			name:   "fields named after an attribute are managed",
+			fields: map[string]string{"buildCommand": `"npm run build"`, "trustedIps": `{"deploymentType":"all"}`},
+		},
+		{
+			name:   "fields mapped to an attribute are managed",
+			fields: map[string]string{"ssoProtection": `{"deploymentType":"preview"}`, "gitLFS": `true`},
+		},
+		{
+			name:   "metadata is not reported",
+			fields: map[string]string{"latestDeployments": `[{"id":"dpl_123"}]`, "crons": `{"enabledAt":1}`},
+		},
+		{
+			name:   "fields without an attribute are reported in order",
+			fields: map[string]string{"microfrontends": `{"enabled":true}`, "customEnvironments": `[{"slug":"staging"}]`},
+			want:   []string{"customEnvironments", "microfrontends"},
+		},
+		{
+			name:   "automation bypass is managed",
+			fields: map[string]string{"protectionBypass": `{"secret":{"scope":"automation-bypass"}}`},
+		},
+		{
+			name:   "other protection bypasses are reported",
+			fields: map[string]string{"protectionBypass": `{"secret":{"scope":"automation-bypass"},"link":{"scope":"shareable-link"}}`},
+			want:   []string{"protectionBypass"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fields := map[string]json.RawMessage{}
+			for k, v := range tc.fields {
+				fields[k] = json.RawMessage(v)
+			}
+			got := unmanagedProjectFields(projectSchema.Schema.Attributes, fields)
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package vercel
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+const (
+	rollingReleaseAutomatic      = "automatic"
+	rollingReleaseManualApproval = "manual-approval"
+)
+
+// RollingRelease represents the `rolling_release` attribute of a project.
+type RollingRelease struct {
+	AdvancementType types.String          `tfsdk:"advancement_type"`
+	Stages          []RollingReleaseStage `tfsdk:"stages"`
+}
+
+type RollingReleaseStage struct {
+	TargetPercentage types.Int64 `tfsdk:"target_percentage"`
+	Duration         types.Int64 `tfsdk:"duration"`
+}
+
+// validate checks the stages are consistent with the advancement type, and roll out to an increasing percentage of
+// traffic. Unknown values are skipped, as they are checked again once known.
+func (r *RollingRelease) validate() (diags diag.Diagnostics) {
+	if r == nil {
+		return nil
+	}
+	stagesPath := path.Root("rolling_release").AtName("stages")
+	var previous int64
+	for i, stage := range r.Stages {
+		stagePath := stagesPath.AtListIndex(i)
+		switch r.AdvancementType.ValueString() {
+		case rollingReleaseAutomatic:
+			if stage.Duration.IsNull() {
+				diags.AddAttributeError(
+					stagePath.AtName("duration"),
+					"Invalid rolling release stage",
+					"Each stage must set `duration` when `advancement_type` is `automatic`.",
+				)
+			}
+		case rollingReleaseManualApproval:
+			if !stage.Duration.IsNull() && !stage.Duration.IsUnknown() {
+				diags.AddAttributeError(
+					stagePath.AtName("duration"),
+					"Invalid rolling release stage",
+					"`duration` can only be set when `advancement_type` is `automatic`, as stages advance when they are approved.",
+				)
+			}
+		}
+		if stage.TargetPercentage.IsUnknown() {
+			continue
+		}
+		if stage.TargetPercentage.ValueInt64() <= previous {
+			diags.AddAttributeError(
+				stagePath.AtName("target_percentage"),
+				"Invalid rolling release stage",
+				fmt.Sprintf("Each stage must target a higher percentage of traffic than the stage before it, but %d is not higher than %d.", stage.TargetPercentage.ValueInt64(), previous),
+			)
+		}
+		previous = stage.TargetPercentage.ValueInt64()
+	}
+	return diags
+}
+
+func (r *RollingRelease) equal(other *RollingRelease) bool {
+	if r == nil || other == nil {
+		return r == other
+	}
+	if !r.AdvancementType.Equal(other.AdvancementType) || len(r.Stages) != len(other.Stages) {
+		return false
+	}
+	for i, stage := range r.Stages {
+		if !stage.TargetPercentage.Equal(other.Stages[i].TargetPercentage) || !stage.Duration.Equal(other.Stages[i].Duration) {
+			return false
+		}
+	}
+	return true
+}
+
+// toClientRollingRelease converts the configured stages into the API representation, adding the final stage that
+// sends all traffic to the new deployment.
+func (r *RollingRelease) toClientRollingRelease() client.RollingRelease {
+	requireApproval := r.AdvancementType.ValueString() == rollingReleaseManualApproval
+	var stages []client.RollingReleaseStage
+	for _, stage := range r.Stages {
+		stages = append(stages, client.RollingReleaseStage{
+			TargetPercentage: stage.TargetPercentage.ValueInt64(),
+			Duration:         stage.Duration.ValueInt64Pointer(),
+			RequireApproval:  requireApproval,
+		})
+	}
+	stages = append(stages, client.RollingReleaseStage{
+		TargetPercentage: 100,
+	})
+	return client.RollingRelease{
+		Target: "production",
+		Stages: stages,
+	}
+}
+
+func rollingReleaseFromResponse(response *client.RollingRelease) *RollingRelease {
+	if response == nil || len(response.Stages) == 0 {
+		return nil
+	}
+
+	advancementType := rollingReleaseAutomatic
+	var stages []RollingReleaseStage
+	for _, stage := range response.Stages {
+		// The final stage always sends all traffic to the new deployment, so is not part of the configuration.
+		if stage.TargetPercentage >= 100 {
+			continue
+		}
+		duration := types.Int64PointerValue(stage.Duration)
+		if stage.RequireApproval {
+			advancementType = rollingReleaseManualApproval
+			duration = types.Int64Null()
+		}
+		stages = append(stages, RollingReleaseStage{
+			TargetPercentage: types.Int64Value(stage.TargetPercentage),
+			Duration:         duration,
+		})
+	}
+	if len(stages) == 0 {
+		return nil
+	}
+	return &RollingRelease{
+		AdvancementType: types.StringValue(advancementType),
+		Stages:          stages,
+	}
+}
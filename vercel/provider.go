@@ -2,19 +2,32 @@ package vercel
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"regexp"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/vercel/terraform-provider-vercel/v3/client"
 )
 
+var (
+	_ provider.ProviderWithFunctions          = &vercelProvider{}
+	_ provider.ProviderWithEphemeralResources = &vercelProvider{}
+)
+
 type vercelProvider struct{}
 
 // New instantiates a new instance of a vercel terraform provider.
@@ -43,7 +56,62 @@ Use the navigation to the left to read about the available resources.
 			},
 			"team": schema.StringAttribute{
 				Optional:    true,
-				Description: "The default Vercel Team to use when creating resources or reading data sources. This can be provided as either a team slug, or team ID. The slug and ID are both available from the Team Settings page in the Vercel dashboard.",
+				Description: "The default Vercel Team to use when creating resources or reading data sources. This can be provided as either a team slug, or team ID. The slug and ID are both available from the Team Settings page in the Vercel dashboard. The `api_token` must be scoped to this team, or have full account access.",
+			},
+			"maintenance_max_wait_minutes": schema.Int64Attribute{
+				Optional:    true,
+				Description: "How long to keep retrying requests while Vercel is undergoing maintenance, in minutes, before failing with a `Vercel maintenance in progress` error. Defaults to `15`. Set to `0` to fail immediately.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"token_expiry_warning_days": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Emit a warning when the `api_token` expires within this many days. Defaults to `14`. Set to `0` to disable the warning, which also avoids looking up the `api_token` unless the configured `team` cannot be accessed.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"features": schema.SingleNestedBlock{
+				Description: "Opt in or out of changes to the behaviour of the provider. These allow improved behaviour to be adopted without changing existing configurations.",
+				Blocks: map[string]schema.Block{
+					"env_vars": schema.SingleNestedBlock{
+						Description: "Configures how `vercel_project_environment_variables` manages Environment Variables.",
+						Attributes: map[string]schema.Attribute{
+							"destructive_updates": schema.BoolAttribute{
+								Optional:    true,
+								Description: "When `true`, Environment Variables whose value changed are deleted and re-created. When `false`, they are updated in place, so their IDs are kept and there is no window where the variable does not exist. Changes that leave the value as it is, such as to the target, git branch or comment, are always made in place. Changes to `sensitive` always re-create the variable. Defaults to `true`.",
+							},
+							"parallelism": schema.Int64Attribute{
+								Optional:    true,
+								Description: "The number of Environment Variables that are deleted or updated at once. Defaults to `10`.",
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+						},
+					},
+					"secrets": schema.SingleNestedBlock{
+						Description: "Configures how secret values, such as Log Drain secrets and Preview Deployment passwords, are managed.",
+						Attributes: map[string]schema.Attribute{
+							"write_only": schema.BoolAttribute{
+								Optional:    true,
+								Description: "When `true`, the write-only variants of secret attributes can be used: `secret_wo` on `vercel_log_drain`, and `password_wo` in the `password_protection` of `vercel_project`. Write-only values are never stored in the Terraform state or plan, so a new value is only applied when the matching `_wo_version` attribute is changed. Webhook secrets and deploy hook URLs are generated by Vercel, so they have no write-only variant. Requires Terraform 1.11 or later. Defaults to `false`.",
+							},
+						},
+					},
+					"deployments": schema.SingleNestedBlock{
+						Description: "Configures how `vercel_deployment` creates Deployments.",
+						Attributes: map[string]schema.Attribute{
+							"wait_for_ready": schema.BoolAttribute{
+								Optional:    true,
+								Description: "When `true`, creating a Deployment waits until it has finished building and has been aliased. When `false`, the apply continues as soon as the Deployment has been created, and any build failure is not reported by Terraform. Defaults to `true`.",
+							},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -54,14 +122,18 @@ func (p *vercelProvider) Resources(_ context.Context) []func() resource.Resource
 		newAccessGroupProjectResource,
 		newAccessGroupResource,
 		newAliasResource,
+		newAliasesResource,
 		newAttackChallengeModeResource,
 		newCustomCertificateResource,
 		newCustomEnvironmentResource,
 		newDeploymentResource,
+		newDNSAuthoritativeZoneResource,
 		newDNSRecordResource,
+		newDNSRecordSetResource,
 		newEdgeConfigItemResource,
 		newEdgeConfigResource,
 		newEdgeConfigSchemaResource,
+		newEdgeConfigSnapshotRestoreResource,
 		newEdgeConfigTokenResource,
 		newFirewallBypassResource,
 		newFirewallConfigResource,
@@ -74,12 +146,19 @@ func (p *vercelProvider) Resources(_ context.Context) []func() resource.Resource
 		newProjectDomainResource,
 		newProjectEnvironmentVariableResource,
 		newProjectEnvironmentVariablesResource,
+		newProjectFlagsExplorerResource,
+		newProjectHeaderRuleResource,
 		newProjectMembersResource,
 		newProjectResource,
+		newProjectRoutingRulesResource,
+		newProjectShareableLinkResource,
+		newSharedEnvironmentVariableMigrationResource,
 		newSharedEnvironmentVariableProjectLinkResource,
 		newSharedEnvironmentVariableResource,
+		newSharedEnvironmentVariableSetResource,
 		newTeamConfigResource,
 		newTeamMemberResource,
+		newTeamMemberEnforcementResource,
 		newWebhookResource,
 	}
 }
@@ -88,39 +167,125 @@ func (p *vercelProvider) DataSources(_ context.Context) []func() datasource.Data
 	return []func() datasource.DataSource{
 		newAccessGroupDataSource,
 		newAccessGroupProjectDataSource,
+		newAccessGroupProjectRolesDataSource,
 		newAliasDataSource,
 		newAttackChallengeModeDataSource,
 		newCustomEnvironmentDataSource,
 		newDeploymentDataSource,
+		newDeploymentDiffDataSource,
+		newDeploymentsDataSource,
+		newDomainDelegationDataSource,
+		newDriftReportDataSource,
 		newEdgeConfigDataSource,
 		newEdgeConfigItemDataSource,
 		newEdgeConfigSchemaDataSource,
+		newEdgeConfigSnapshotDataSource,
 		newEdgeConfigTokenDataSource,
 		newEndpointVerificationDataSource,
 		newFileDataSource,
+		newFirewallInsightsDataSource,
 		newLogDrainDataSource,
 		newPrebuiltProjectDataSource,
+		newProjectCronInvocationsDataSource,
 		newProjectDataSource,
 		newProjectDeploymentRetentionDataSource,
 		newProjectDirectoryDataSource,
+		newProjectEnvironmentFileDataSource,
+		newProjectEnvironmentVariablesDataSource,
+		newProjectIDsDataSource,
 		newProjectMembersDataSource,
 		newSharedEnvironmentVariableDataSource,
+		newSharedEnvironmentVariablesDataSource,
 		newTeamConfigDataSource,
+		newTeamLimitsDataSource,
 		newTeamMemberDataSource,
+		newUsageDataSource,
 		newMicrofrontendGroupDataSource,
 		newMicrofrontendGroupMembershipDataSource,
 	}
 }
 
+func (p *vercelProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newProjectEnvironmentVariableEphemeralResource,
+	}
+}
+
+func (p *vercelProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		newDotenvFunction,
+		newMergeEnvFunction,
+		newToDotenvFunction,
+	}
+}
+
 type providerData struct {
-	APIToken types.String `tfsdk:"api_token"`
-	Team     types.String `tfsdk:"team"`
+	APIToken               types.String      `tfsdk:"api_token"`
+	Team                   types.String      `tfsdk:"team"`
+	MaintenanceMaxWait     types.Int64       `tfsdk:"maintenance_max_wait_minutes"`
+	TokenExpiryWarningDays types.Int64       `tfsdk:"token_expiry_warning_days"`
+	Features               *providerFeatures `tfsdk:"features"`
+}
+
+type providerFeatures struct {
+	EnvVars     *envVarsFeatures     `tfsdk:"env_vars"`
+	Deployments *deploymentsFeatures `tfsdk:"deployments"`
+	Secrets     *secretsFeatures     `tfsdk:"secrets"`
+}
+
+type envVarsFeatures struct {
+	DestructiveUpdates types.Bool  `tfsdk:"destructive_updates"`
+	Parallelism        types.Int64 `tfsdk:"parallelism"`
+}
+
+type deploymentsFeatures struct {
+	WaitForReady types.Bool `tfsdk:"wait_for_ready"`
+}
+
+type secretsFeatures struct {
+	WriteOnly types.Bool `tfsdk:"write_only"`
+}
+
+// toClientFeatures applies any configured features over the defaults.
+func (f *providerFeatures) toClientFeatures() client.Features {
+	features := client.DefaultFeatures()
+	if f == nil {
+		return features
+	}
+	if f.EnvVars != nil && !f.EnvVars.DestructiveUpdates.IsNull() && !f.EnvVars.DestructiveUpdates.IsUnknown() {
+		features.EnvVars.DestructiveUpdates = f.EnvVars.DestructiveUpdates.ValueBool()
+	}
+	if f.EnvVars != nil && !f.EnvVars.Parallelism.IsNull() && !f.EnvVars.Parallelism.IsUnknown() {
+		features.EnvVars.Parallelism = int(f.EnvVars.Parallelism.ValueInt64())
+	}
+	if f.Deployments != nil && !f.Deployments.WaitForReady.IsNull() && !f.Deployments.WaitForReady.IsUnknown() {
+		features.Deployments.WaitForReady = f.Deployments.WaitForReady.ValueBool()
+	}
+	if f.Secrets != nil && !f.Secrets.WriteOnly.IsNull() && !f.Secrets.WriteOnly.IsUnknown() {
+		features.Secrets.WriteOnly = f.Secrets.WriteOnly.ValueBool()
+	}
+	return features
 }
 
+// defaultTokenExpiryWarningDays is how far ahead of an api_token expiring a warning is emitted, if
+// token_expiry_warning_days is not configured.
+const defaultTokenExpiryWarningDays = 14
+
 // apiTokenRe is a regex for an API access token. We use this to validate that the
 // token provided matches the expected format.
 var apiTokenRe = regexp.MustCompile("[0-9a-zA-Z]{24}")
 
+// tokenTeamScopes returns the IDs of the teams an api_token is scoped to.
+func tokenTeamScopes(token client.Token) []string {
+	var teams []string
+	for _, s := range token.Scopes {
+		if s.Type == "team" {
+			teams = append(teams, s.TeamID)
+		}
+	}
+	return teams
+}
+
 // Configure takes a provider and applies any configuration. In the context of Vercel
 // this allows us to set up an API token.
 func (p *vercelProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
@@ -163,9 +328,69 @@ func (p *vercelProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	vercelClient := client.New(apiToken)
+	vercelClient := client.New(apiToken).WithFeatures(config.Features.toClientFeatures())
+	if !config.MaintenanceMaxWait.IsNull() && !config.MaintenanceMaxWait.IsUnknown() {
+		vercelClient = vercelClient.WithMaintenanceMaxWait(time.Duration(config.MaintenanceMaxWait.ValueInt64()) * time.Minute)
+	}
+
+	// The api_token is only looked up when it is needed, either to check when it expires or to explain
+	// why the team cannot be accessed. Failing to look it up only means it cannot be checked.
+	var token client.Token
+	var tokenRead bool
+	readToken := func() {
+		if tokenRead {
+			return
+		}
+		var err error
+		token, err = vercelClient.GetCurrentToken(ctx)
+		if err != nil {
+			tflog.Info(ctx, "could not read api_token information", map[string]any{"error": err.Error()})
+			return
+		}
+		tokenRead = true
+	}
+
+	warningDays := int64(defaultTokenExpiryWarningDays)
+	if !config.TokenExpiryWarningDays.IsNull() && !config.TokenExpiryWarningDays.IsUnknown() {
+		warningDays = config.TokenExpiryWarningDays.ValueInt64()
+	}
+	if warningDays > 0 {
+		readToken()
+	}
+	if tokenRead && token.ExpiresAt != nil && warningDays > 0 {
+		expiresAt := time.UnixMilli(*token.ExpiresAt)
+		if time.Until(expiresAt) < time.Duration(warningDays)*24*time.Hour {
+			resp.Diagnostics.AddWarning(
+				"api_token expires soon",
+				fmt.Sprintf(
+					"The api_token %q expires at %s. Please create a new token and update your configuration before then to avoid interruptions.",
+					token.Name,
+					expiresAt.UTC().Format(time.RFC3339),
+				),
+			)
+		}
+	}
+
 	if config.Team.ValueString() != "" {
 		res, err := vercelClient.GetTeam(ctx, config.Team.ValueString())
+		var apiErr client.APIError
+		noAccess := client.NotFound(err) || (errors.As(err, &apiErr) && apiErr.StatusCode == 403)
+		if noAccess {
+			// Check whether the api_token is scoped to the team, so that a token without access to it
+			// is reported clearly, rather than as the team not being found.
+			readToken()
+		}
+		if noAccess && tokenRead && !token.HasTeamAccess(config.Team.ValueString()) {
+			resp.Diagnostics.AddError(
+				"Insufficient api_token scope",
+				fmt.Sprintf(
+					"You provided a `team` field on the Vercel provider, but the api_token is only scoped to the following teams: %s. The api_token is missing the scope for team %s. Please create a token scoped to this team, or with full account access.",
+					strings.Join(tokenTeamScopes(token), ", "),
+					config.Team.ValueString(),
+				),
+			)
+			return
+		}
 		if client.NotFound(err) {
 			resp.Diagnostics.AddError(
 				"Vercel Team not found",
@@ -185,4 +410,5 @@ func (p *vercelProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 	resp.DataSourceData = vercelClient
 	resp.ResourceData = vercelClient
+	resp.EphemeralResourceData = vercelClient
 }
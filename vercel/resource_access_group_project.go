@@ -20,6 +20,7 @@ var (
 	_ resource.Resource                = &accessGroupProjectResource{}
 	_ resource.ResourceWithConfigure   = &accessGroupProjectResource{}
 	_ resource.ResourceWithImportState = &accessGroupProjectResource{}
+	_ resource.ResourceWithModifyPlan  = &accessGroupProjectResource{}
 )
 
 func newAccessGroupProjectResource() resource.Resource {
@@ -96,6 +97,26 @@ type AccessGroupProject struct {
 	Role          types.String `tfsdk:"role"`
 }
 
+// ModifyPlan checks that the access group and project belong to the configured team, as IDs from other teams are
+// reported by the API as not found.
+func (r *accessGroupProjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || !referencesChanged(ctx, req, "access_group_id", "project_id", "team_id") {
+		return
+	}
+	var plan AccessGroupProject
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.AccessGroupID.IsUnknown() {
+		resp.Diagnostics.Append(checkAccessGroupTeam(ctx, r.client, plan.AccessGroupID.ValueString(), plan.TeamID.ValueString())...)
+	}
+	if !plan.ProjectID.IsUnknown() {
+		resp.Diagnostics.Append(checkProjectTeam(ctx, r.client, plan.ProjectID.ValueString(), plan.TeamID.ValueString())...)
+	}
+}
+
 func (r *accessGroupProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan AccessGroupProject
 	diags := req.Plan.Get(ctx, &plan)
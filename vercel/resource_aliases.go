@@ -0,0 +1,390 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &aliasesResource{}
+	_ resource.ResourceWithConfigure = &aliasesResource{}
+)
+
+func newAliasesResource() resource.Resource {
+	return &aliasesResource{}
+}
+
+type aliasesResource struct {
+	client *client.Client
+}
+
+func (r *aliasesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_aliases"
+}
+
+func (r *aliasesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema returns the schema information for an aliases resource.
+func (r *aliasesResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a resource for managing many Aliases at once.
+
+An Alias allows a ` + "`vercel_deployment`" + ` to be accessed through a different URL. This resource manages a map of Aliases to Deployments, which is useful when many vanity Aliases are moved to each new release. Aliases are created, moved and removed in parallel.
+
+~> Aliases managed by this resource should not also be managed by a ` + "`vercel_alias`" + ` resource.
+`,
+		Attributes: map[string]schema.Attribute{
+			"aliases": schema.MapAttribute{
+				Description: "A map of Aliases to the id of the Deployment each Alias should be associated with.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"alias_ids": schema.MapAttribute{
+				Description: "A map of Aliases to their IDs.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the team the Aliases and Deployments exist under. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// Aliases represents the terraform state for an aliases resource.
+type Aliases struct {
+	Aliases  types.Map    `tfsdk:"aliases"`
+	AliasIDs types.Map    `tfsdk:"alias_ids"`
+	TeamID   types.String `tfsdk:"team_id"`
+}
+
+// aliasesState tracks the aliases that are known to exist while changes are applied, so that state can be saved
+// even if only some of the changes succeed.
+type aliasesState struct {
+	mu          sync.Mutex
+	deployments map[string]string
+	ids         map[string]string
+}
+
+func (s *aliasesState) set(alias, deploymentID, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deployments[alias] = deploymentID
+	s.ids[alias] = id
+}
+
+func (s *aliasesState) remove(alias string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.deployments, alias)
+	delete(s.ids, alias)
+}
+
+func (s *aliasesState) toModel(ctx context.Context, teamID string) (Aliases, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	aliases, d := types.MapValueFrom(ctx, types.StringType, s.deployments)
+	diags.Append(d...)
+	ids, d := types.MapValueFrom(ctx, types.StringType, s.ids)
+	diags.Append(d...)
+	return Aliases{
+		Aliases:  aliases,
+		AliasIDs: ids,
+		TeamID:   toTeamID(teamID),
+	}, diags
+}
+
+func (a Aliases) state(ctx context.Context) (*aliasesState, diag.Diagnostics) {
+	s := &aliasesState{
+		deployments: map[string]string{},
+		ids:         map[string]string{},
+	}
+	var diags diag.Diagnostics
+	if !a.Aliases.IsNull() && !a.Aliases.IsUnknown() {
+		diags.Append(a.Aliases.ElementsAs(ctx, &s.deployments, false)...)
+	}
+	if !a.AliasIDs.IsNull() && !a.AliasIDs.IsUnknown() {
+		diags.Append(a.AliasIDs.ElementsAs(ctx, &s.ids, false)...)
+	}
+	return s, diags
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// upsertAliases assigns each alias to its deployment in parallel, recording the successful ones in s.
+func (r *aliasesResource) upsertAliases(ctx context.Context, teamID string, aliases map[string]string, s *aliasesState, diags *diag.Diagnostics) {
+	keys := sortedKeys(aliases)
+	errs := runConcurrently(len(keys), maxConcurrentRequests, func(i int) error {
+		out, err := r.client.UpsertAlias(ctx, client.UpsertAliasRequest{
+			Alias:        keys[i],
+			DeploymentID: aliases[keys[i]],
+			TeamID:       teamID,
+		})
+		if err != nil {
+			return err
+		}
+		s.set(keys[i], out.DeploymentID, out.UID)
+		return nil
+	})
+	for i, err := range errs {
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("aliases").AtMapKey(keys[i]),
+				"Error assigning alias",
+				fmt.Sprintf("Could not assign alias %s to deployment %s, unexpected error: %s", keys[i], aliases[keys[i]], err),
+			)
+		}
+	}
+}
+
+// deleteAliases deletes each alias in parallel, removing the successful ones from s.
+func (r *aliasesResource) deleteAliases(ctx context.Context, teamID string, ids map[string]string, s *aliasesState, diags *diag.Diagnostics) {
+	// Take a copy of the IDs first, as s may be the map that is being deleted from.
+	keys := sortedKeys(ids)
+	aliasIDs := make([]string, len(keys))
+	for i, k := range keys {
+		aliasIDs[i] = ids[k]
+	}
+	errs := runConcurrently(len(keys), maxConcurrentRequests, func(i int) error {
+		_, err := r.client.DeleteAlias(ctx, aliasIDs[i], teamID)
+		if err != nil && !client.NotFound(err) {
+			return err
+		}
+		s.remove(keys[i])
+		return nil
+	})
+	for i, err := range errs {
+		if err != nil {
+			diags.AddError(
+				"Error deleting alias",
+				fmt.Sprintf("Could not delete alias %s, unexpected error: %s", keys[i], err),
+			)
+		}
+	}
+}
+
+// Create will assign all of the aliases within Vercel.
+func (r *aliasesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Aliases
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned, diags := plan.state(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID := r.client.TeamID(plan.TeamID.ValueString())
+	s := &aliasesState{deployments: map[string]string{}, ids: map[string]string{}}
+	r.upsertAliases(ctx, teamID, planned.deployments, s, &resp.Diagnostics)
+
+	result, diags := s.toModel(ctx, teamID)
+	resp.Diagnostics.Append(diags...)
+	tflog.Info(ctx, "created aliases", map[string]any{
+		"team_id": teamID,
+		"aliases": len(s.ids),
+	})
+
+	// Save whatever was created, even on a partial failure, so that it is not orphaned.
+	if len(s.ids) == 0 && resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read will read the aliases from the Vercel API, and update terraform with this information.
+func (r *aliasesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Aliases
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := state.state(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID := r.client.TeamID(state.TeamID.ValueString())
+	keys := sortedKeys(current.ids)
+	aliasIDs := make([]string, len(keys))
+	for i, k := range keys {
+		aliasIDs[i] = current.ids[k]
+	}
+	errs := runConcurrently(len(keys), maxConcurrentRequests, func(i int) error {
+		out, err := r.client.GetAlias(ctx, aliasIDs[i], teamID)
+		if client.NotFound(err) {
+			current.remove(keys[i])
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		current.set(keys[i], out.DeploymentID, out.UID)
+		return nil
+	})
+	for i, err := range errs {
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading aliases",
+				fmt.Sprintf("Could not get alias %s, unexpected error: %s", keys[i], err),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(current.ids) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	result, diags := current.toModel(ctx, teamID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "read aliases", map[string]any{
+		"team_id": teamID,
+		"aliases": len(current.ids),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update moves aliases that point at a different deployment, assigns new aliases and deletes removed ones.
+func (r *aliasesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Aliases
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state Aliases
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned, diags := plan.state(ctx)
+	resp.Diagnostics.Append(diags...)
+	current, d := state.state(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	toUpsert := map[string]string{}
+	for alias, deploymentID := range planned.deployments {
+		if existing, ok := current.deployments[alias]; !ok || existing != deploymentID {
+			toUpsert[alias] = deploymentID
+		}
+	}
+	toDelete := map[string]string{}
+	for alias, id := range current.ids {
+		if _, ok := planned.deployments[alias]; !ok {
+			toDelete[alias] = id
+		}
+	}
+
+	teamID := r.client.TeamID(plan.TeamID.ValueString())
+	tflog.Info(ctx, "updating aliases", map[string]any{
+		"team_id":   teamID,
+		"to_upsert": len(toUpsert),
+		"to_delete": len(toDelete),
+	})
+	r.upsertAliases(ctx, teamID, toUpsert, current, &resp.Diagnostics)
+	r.deleteAliases(ctx, teamID, toDelete, current, &resp.Diagnostics)
+
+	// Save the aliases as they now are, even on a partial failure.
+	result, diags := current.toModel(ctx, teamID)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes all of the aliases.
+func (r *aliasesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Aliases
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := state.state(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID := r.client.TeamID(state.TeamID.ValueString())
+	r.deleteAliases(ctx, teamID, current.ids, current, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		// Keep the aliases that could not be deleted in state.
+		result, diags := current.toModel(ctx, teamID)
+		resp.Diagnostics.Append(diags...)
+		diags = resp.State.Set(ctx, result)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	tflog.Info(ctx, "deleted aliases", map[string]any{
+		"team_id": teamID,
+	})
+}
@@ -0,0 +1,77 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_AliasesResource(t *testing.T) {
+	name := acctest.RandString(16)
+	one := fmt.Sprintf("test-acc-%s-one.vercel.app", name)
+	two := fmt.Sprintf("test-acc-%s-two.vercel.app", name)
+	three := fmt.Sprintf("test-acc-%s-three.vercel.app", name)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccAliasesResourceConfig(name, testGithubRepo(t), fmt.Sprintf(`
+    "%s" = vercel_deployment.test.id
+    "%s" = vercel_deployment.test.id
+`, one, two))),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckAliasExists(testClient(t), testTeam(t), one),
+					testCheckAliasExists(testClient(t), testTeam(t), two),
+					resource.TestCheckResourceAttr("vercel_aliases.test", "aliases.%", "2"),
+					resource.TestCheckResourceAttrPair("vercel_aliases.test", "aliases."+one, "vercel_deployment.test", "id"),
+					resource.TestCheckResourceAttr("vercel_aliases.test", "alias_ids.%", "2"),
+				),
+			},
+			{
+				// Move one alias, remove one and add one.
+				Config: cfg(testAccAliasesResourceConfig(name, testGithubRepo(t), fmt.Sprintf(`
+    "%s" = vercel_deployment.test_two.id
+    "%s" = vercel_deployment.test.id
+`, one, three))),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckAliasExists(testClient(t), testTeam(t), one),
+					testCheckAliasExists(testClient(t), testTeam(t), three),
+					resource.TestCheckResourceAttr("vercel_aliases.test", "aliases.%", "2"),
+					resource.TestCheckResourceAttrPair("vercel_aliases.test", "aliases."+one, "vercel_deployment.test_two", "id"),
+					resource.TestCheckNoResourceAttr("vercel_aliases.test", "aliases."+two),
+					resource.TestCheckResourceAttr("vercel_aliases.test", "alias_ids.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAliasesResourceConfig(name, githubRepo, aliases string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+    name = "test-acc-%[1]s"
+    git_repository = {
+        type = "github"
+        repo = "%[2]s"
+    }
+}
+
+resource "vercel_deployment" "test" {
+    project_id = vercel_project.test.id
+    ref        = "main"
+}
+
+resource "vercel_deployment" "test_two" {
+    project_id = vercel_project.test.id
+    ref        = "main"
+}
+
+resource "vercel_aliases" "test" {
+  aliases = {
+%[3]s
+  }
+}
+`, name, githubRepo, aliases)
+}
@@ -3,6 +3,8 @@ package vercel
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -57,12 +59,15 @@ Provides a Custom Certificate Resource, allowing Custom Certificates to be uploa
 By default, Vercel provides all domains with a custom SSL certificates. However, Enterprise teams can upload their own custom SSL certificate.
 
 For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/domains/custom-SSL-certificate).
+
+Changing the certificate rotates it without TLS downtime. The new certificate is uploaded first, so Vercel starts serving it for the domains it covers, and only then is the previous certificate deleted.
 `,
 		Attributes: map[string]schema.Attribute{
+			// The ID is deliberately left unknown on changes, as the only in-place update is a
+			// rotation, which uploads a new certificate with a new ID.
 			"id": schema.StringAttribute{
-				Description:   "The ID of the Custom Certificate.",
-				Computed:      true,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description: "The ID of the Custom Certificate.",
+				Computed:    true,
 			},
 			"team_id": schema.StringAttribute{
 				Optional:      true,
@@ -71,19 +76,16 @@ For more detailed information, please see the [Vercel documentation](https://ver
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
 			},
 			"private_key": schema.StringAttribute{
-				Description:   "The private key of the Certificate. Should be in PEM format.",
-				Required:      true,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description: "The private key of the Certificate. Should be in PEM format.",
+				Required:    true,
 			},
 			"certificate": schema.StringAttribute{
-				Description:   "The certificate itself. Should be in PEM format.",
-				Required:      true,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description: "The certificate itself. Should be in PEM format. Changing this rotates the certificate, uploading the new certificate before deleting the previous one.",
+				Required:    true,
 			},
 			"certificate_authority_certificate": schema.StringAttribute{
-				Description:   "The Certificate Authority root certificate such as one of Let's Encrypt's ISRG root certificates. This will be provided by your certificate issuer and is different to the core certificate. This may be included in their download process or available for download on their website. Should be in PEM format.",
-				Required:      true,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description: "The Certificate Authority root certificate such as one of Let's Encrypt's ISRG root certificates. This will be provided by your certificate issuer and is different to the core certificate. This may be included in their download process or available for download on their website. Should be in PEM format.",
+				Required:    true,
 			},
 		},
 	}
@@ -174,11 +176,109 @@ func (r *customCertificateResource) Read(ctx context.Context, req resource.ReadR
 	}
 }
 
+// Update rotates the certificate. The replacement is uploaded before the previous certificate is deleted, so
+// that the domains it covers are never left without a certificate.
 func (r *customCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Updating a Custom Certificate is not supported",
-		"Updating a Custom Certificate is not supported",
-	)
+	var plan, state CustomCertificate
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previous, err := r.client.GetCustomCertificate(ctx, client.GetCustomCertificateRequest{
+		ID:     state.ID.ValueString(),
+		TeamID: state.TeamID.ValueString(),
+	})
+	if err != nil && !client.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error reading Custom Certificate",
+			fmt.Sprintf("Could not get Custom Certificate %s %s, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.ID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	out, err := r.client.UploadCustomCertificate(ctx, client.UploadCustomCertificateRequest{
+		TeamID:                          plan.TeamID.ValueString(),
+		PrivateKey:                      plan.PrivateKey.ValueString(),
+		Certificate:                     plan.Certificate.ValueString(),
+		CertificateAuthorityCertificate: plan.CertificateAuthorityCertificate.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error uploading Custom Certificate",
+			"Could not upload the rotated Custom Certificate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	plan.ID = types.StringValue(out.ID)
+	plan.TeamID = types.StringValue(r.client.TeamID(plan.TeamID.ValueString()))
+
+	tflog.Info(ctx, "uploaded rotated custom certificate", map[string]any{
+		"team_id":     plan.TeamID.ValueString(),
+		"id":          out.ID,
+		"previous_id": state.ID.ValueString(),
+	})
+
+	var uncovered []string
+	for _, cn := range previous.CNs {
+		if !slices.Contains(out.CNs, cn) {
+			uncovered = append(uncovered, cn)
+		}
+	}
+	if len(uncovered) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Rotated Custom Certificate does not cover all domains",
+			fmt.Sprintf(
+				"The new Custom Certificate %s does not cover %s, which the previous certificate %s covered. These domains will no longer be served with a custom certificate.",
+				out.ID,
+				strings.Join(uncovered, ", "),
+				state.ID.ValueString(),
+			),
+		)
+	}
+
+	// Save the new certificate before removing the previous one, so it is tracked even if the delete fails.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = r.client.DeleteCustomCertificate(ctx, client.DeleteCustomCertificateRequest{
+		TeamID: state.TeamID.ValueString(),
+		ID:     state.ID.ValueString(),
+	})
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting previous Custom Certificate",
+			fmt.Sprintf(
+				"The Custom Certificate was rotated to %s, but the previous Custom Certificate %s %s could not be deleted and should be removed manually, unexpected error: %s",
+				out.ID,
+				state.TeamID.ValueString(),
+				state.ID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "deleted previous custom certificate", map[string]any{
+		"team_id": state.TeamID.ValueString(),
+		"id":      state.ID.ValueString(),
+	})
 }
 
 func (r *customCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/vercel/terraform-provider-vercel/v3/client"
 )
@@ -53,13 +54,70 @@ func testCertKey(t *testing.T) string {
 	return v
 }
 
+func testCheckCustomCertificateID(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+func testCheckCustomCertificateRotated(testClient *client.Client, teamID string, n string, previousID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == *previousID {
+			return fmt.Errorf("expected the certificate to be rotated, but the ID is still %s", *previousID)
+		}
+
+		_, err := testClient.GetCustomCertificate(context.TODO(), client.GetCustomCertificateRequest{
+			TeamID: teamID,
+			ID:     *previousID,
+		})
+		if err == nil {
+			return fmt.Errorf("expected the previous certificate %s to be deleted", *previousID)
+		}
+		if !client.NotFound(err) {
+			return fmt.Errorf("Unexpected error checking for deleted certificate: %s", err)
+		}
+		return nil
+	}
+}
+
 func TestAcc_CustomCertificateResource(t *testing.T) {
+	var id string
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testCheckCustomCertificateDoesNotExist(testClient(t), testTeam(t), "vercel_custom_certificate.test"),
 		Steps: []resource.TestStep{
 			{
-				Config: cfg(fmt.Sprintf(`
+				Config: cfg(testAccCustomCertificateConfig(testCertKey(t), testCert(t), testCert(t))),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("vercel_custom_certificate.test", "id"),
+					testCheckCustomCertificateID("vercel_custom_certificate.test", &id),
+				),
+			},
+			{
+				// Any change to the certificate material rotates the certificate in place.
+				Config: cfg(testAccCustomCertificateConfig(testCertKey(t), testCert(t), testCert(t)+"\n")),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("vercel_custom_certificate.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testCheckCustomCertificateRotated(testClient(t), testTeam(t), "vercel_custom_certificate.test", &id),
+			},
+		},
+	})
+}
+
+func testAccCustomCertificateConfig(key, cert, ca string) string {
+	return fmt.Sprintf(`
 resource "vercel_custom_certificate" "test" {
 	private_key = <<EOT
 %[1]s
@@ -68,12 +126,8 @@ EOT
 %[2]s
 EOT
 	certificate_authority_certificate = <<EOT
-%[2]s
+%[3]s
 EOT
-				}
-				`, testCertKey(t), testCert(t))),
-				Check: resource.TestCheckResourceAttrSet("vercel_custom_certificate.test", "id"),
-			},
-		},
-	})
+}
+`, key, cert, ca)
 }
@@ -66,6 +66,8 @@ By default, all teams use three environments when developing their project: Prod
 Custom environments allow you to configure customized, pre-production environments for your project, such as staging or QA, with branch rules that will automatically deploy your branch when the branch name matches the rule. With custom environments you can also attach a domain to your environment, set environment variables, or import environment variables from another environment.
 
 Custom environments are designed as pre-production environments intended for long-running use. This contrasts with regular preview environments, which are designed for creating ephemeral, short-lived deployments.
+
+~> Build settings such as the root directory and build command are set for the whole project, and cannot be overridden for a custom environment. To build a different app for a custom environment, either use a separate ` + "`vercel_project`" + `, or create deployments with a ` + "`vercel_deployment`" + ` resource, whose ` + "`project_settings`" + ` override the project's build settings for that deployment.
 `,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -2,18 +2,24 @@ package vercel
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -27,8 +33,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &deploymentResource{}
-	_ resource.ResourceWithConfigure = &deploymentResource{}
+	_ resource.Resource               = &deploymentResource{}
+	_ resource.ResourceWithConfigure  = &deploymentResource{}
+	_ resource.ResourceWithModifyPlan = &deploymentResource{}
 )
 
 func newDeploymentResource() resource.Resource {
@@ -92,6 +99,55 @@ terraform to your Deployment.
 				PlanModifiers: []planmodifier.Map{mapplanmodifier.RequiresReplace()},
 				ElementType:   types.StringType,
 			},
+			"build_environment": schema.MapAttribute{
+				Description: "A map of environment variable names to values that are only available while the Deployment is being built, such as tokens for private package registries. These values are write-only, and are never stored in the Terraform state, so changing them does not create a new Deployment on its own; change `build_environment_version` as well. Requires Terraform 1.11 or later.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				ElementType: types.StringType,
+			},
+			"build_environment_version": schema.Int64Attribute{
+				Description:   "An arbitrary number that should be changed whenever `build_environment` changes, to create a new Deployment using the new values.",
+				Optional:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"meta": schema.MapAttribute{
+				Description:   "A map of key/value tags to attach to the deployment, such as the Terraform workspace, a git commit SHA or a ticket ID. These can be used to find the deployment with the `vercel_deployments` data source.",
+				Optional:      true,
+				PlanModifiers: []planmodifier.Map{mapplanmodifier.RequiresReplace()},
+				ElementType:   types.StringType,
+			},
+			"git_metadata": schema.SingleNestedAttribute{
+				Description:   "The git commit the deployment was created from. Null if the deployment was not created from a git repository.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.Object{objectplanmodifier.UseStateForUnknown()},
+				Attributes: map[string]schema.Attribute{
+					"provider": schema.StringAttribute{
+						Description: "The git provider, one of `github`, `gitlab` or `bitbucket`.",
+						Computed:    true,
+					},
+					"repo": schema.StringAttribute{
+						Description: "The repository the commit belongs to, e.g. `vercel/next.js`.",
+						Computed:    true,
+					},
+					"ref": schema.StringAttribute{
+						Description: "The branch that was deployed.",
+						Computed:    true,
+					},
+					"sha": schema.StringAttribute{
+						Description: "The SHA of the commit that was deployed.",
+						Computed:    true,
+					},
+					"pull_request_id": schema.StringAttribute{
+						Description: "The ID of the pull request the commit was deployed from, if any.",
+						Computed:    true,
+					},
+					"commit_author": schema.StringAttribute{
+						Description: "The login, or if that is not known the name, of the author of the commit.",
+						Computed:    true,
+					},
+				},
+			},
 			"team_id": schema.StringAttribute{
 				Description:   "The team ID to add the deployment to. Required when configuring a team resource if a default team has not been set in the provider.",
 				Optional:      true,
@@ -123,6 +179,11 @@ terraform to your Deployment.
 				Computed:      true,
 				PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
 			},
+			"skip_automatic_aliases": schema.BoolAttribute{
+				Description:   "Set to true to create a production deployment without assigning the project's production domains to it. The domains can then be assigned separately, for example with a `vercel_alias` resource. Only valid when `production` is true.",
+				Optional:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+			},
 			"files": schema.MapAttribute{
 				Description:   "A map of files to be uploaded for the deployment. This should be provided by a `vercel_project_directory` or `vercel_file` data source. Required if `git_source` is not set.",
 				Optional:      true,
@@ -176,6 +237,65 @@ terraform to your Deployment.
 				Description: "Set to true to hard delete the Vercel deployment when destroying the Terraform resource. If unspecified, deployments are retained indefinitely. Note that deleted deployments are not recoverable.",
 				Optional:    true,
 			},
+			"deployment_windows": schema.ListNestedAttribute{
+				Description: "A list of windows that production deployments are allowed to be created within. If set, creating a production deployment outside all of the windows fails with an error. Preview deployments are not affected. Changing this value does not recreate the deployment.",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"schedule": schema.StringAttribute{
+							Description: "A five field cron expression for when the window opens, such as `0 9 * * 1-5` for 09:00 every weekday.",
+							Required:    true,
+							Validators: []validator.String{
+								validateCronSchedule(),
+							},
+						},
+						"duration": schema.StringAttribute{
+							Description: "How long the window stays open after each time the `schedule` matches, such as `8h`.",
+							Required:    true,
+							Validators: []validator.String{
+								validateDuration(),
+							},
+						},
+						"time_zone": schema.StringAttribute{
+							Description: "The IANA time zone the `schedule` is evaluated in, such as `Europe/London`. Defaults to `UTC`.",
+							Optional:    true,
+							Validators: []validator.String{
+								validateTimeZone(),
+							},
+						},
+					},
+				},
+			},
+			"upload_size": schema.Int64Attribute{
+				Description:   "The total size of the files uploaded for the deployment, in bytes. Zero if the deployment was created from a git `ref`.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"function_count": schema.Int64Attribute{
+				Description:   "The number of Serverless Functions in the build output of the deployment. Null until the deployment is ready, or if the build output could not be read.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"edge_function_count": schema.Int64Attribute{
+				Description:   "The number of Edge Functions in the build output of the deployment. Null until the deployment is ready, or if the build output could not be read.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"largest_function_size": schema.Int64Attribute{
+				Description:   "The size of the largest Serverless or Edge Function in the build output of the deployment, in bytes. Zero if there are no functions, and null until the deployment is ready, or if the build output could not be read.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"archive_path": schema.StringAttribute{
+				Description: "A local file path to write a JSON archive of the deployment to once it has completed successfully. The archive contains the deployment metadata, the source files that were uploaded, and the build output manifest, and can be kept as an audit trail or reproducibility record. Changing this value writes a new archive without recreating the deployment.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -192,18 +312,29 @@ type ProjectSettings struct {
 
 // Deployment represents the terraform state for a deployment resource.
 type Deployment struct {
-	Domains         types.List       `tfsdk:"domains"`
-	Environment     types.Map        `tfsdk:"environment"`
-	Files           types.Map        `tfsdk:"files"`
-	ID              types.String     `tfsdk:"id"`
-	Production      types.Bool       `tfsdk:"production"`
-	ProjectID       types.String     `tfsdk:"project_id"`
-	PathPrefix      types.String     `tfsdk:"path_prefix"`
-	ProjectSettings *ProjectSettings `tfsdk:"project_settings"`
-	TeamID          types.String     `tfsdk:"team_id"`
-	URL             types.String     `tfsdk:"url"`
-	DeleteOnDestroy types.Bool       `tfsdk:"delete_on_destroy"`
-	Ref             types.String     `tfsdk:"ref"`
+	Domains                 types.List       `tfsdk:"domains"`
+	Environment             types.Map        `tfsdk:"environment"`
+	BuildEnvironment        types.Map        `tfsdk:"build_environment"`
+	BuildEnvironmentVersion types.Int64      `tfsdk:"build_environment_version"`
+	Files                   types.Map        `tfsdk:"files"`
+	Meta                    types.Map        `tfsdk:"meta"`
+	GitMetadata             types.Object     `tfsdk:"git_metadata"`
+	ID                      types.String     `tfsdk:"id"`
+	Production              types.Bool       `tfsdk:"production"`
+	SkipAutoAliases         types.Bool       `tfsdk:"skip_automatic_aliases"`
+	ProjectID               types.String     `tfsdk:"project_id"`
+	PathPrefix              types.String     `tfsdk:"path_prefix"`
+	ProjectSettings         *ProjectSettings `tfsdk:"project_settings"`
+	TeamID                  types.String     `tfsdk:"team_id"`
+	URL                     types.String     `tfsdk:"url"`
+	DeleteOnDestroy         types.Bool       `tfsdk:"delete_on_destroy"`
+	Ref                     types.String     `tfsdk:"ref"`
+	ArchivePath             types.String     `tfsdk:"archive_path"`
+	DeploymentWindows       types.List       `tfsdk:"deployment_windows"`
+	UploadSize              types.Int64      `tfsdk:"upload_size"`
+	FunctionCount           types.Int64      `tfsdk:"function_count"`
+	EdgeFunctionCount       types.Int64      `tfsdk:"edge_function_count"`
+	LargestFunctionSize     types.Int64      `tfsdk:"largest_function_size"`
 }
 
 // setIfNotUnknown is a helper function to set a value in a map if it is not unknown.
@@ -372,27 +503,163 @@ func convertResponseToDeployment(response client.DeploymentResponse, plan Deploy
 		plan.Files = types.MapNull(types.StringType)
 	}
 
+	if plan.Meta.IsUnknown() || plan.Meta.IsNull() {
+		plan.Meta = types.MapNull(types.StringType)
+	}
+
+	if plan.DeploymentWindows.IsUnknown() || plan.DeploymentWindows.IsNull() {
+		plan.DeploymentWindows = types.ListNull(deploymentWindowElemType)
+	}
+
+	// The size metrics are only known once the deployment has been created, and do not change afterwards.
+	for _, v := range []*types.Int64{&plan.UploadSize, &plan.FunctionCount, &plan.EdgeFunctionCount, &plan.LargestFunctionSize} {
+		if v.IsUnknown() {
+			*v = types.Int64Null()
+		}
+	}
+
 	ref := types.StringNull()
 	if response.GitSource.Ref != "" {
 		ref = types.StringValue(response.GitSource.Ref)
 	}
 
 	return Deployment{
-		Domains:         types.ListValueMust(types.StringType, domains),
-		TeamID:          toTeamID(response.TeamID),
-		Environment:     plan.Environment,
-		ProjectID:       types.StringValue(response.ProjectID),
-		ID:              types.StringValue(response.ID),
-		URL:             types.StringValue(response.URL),
-		Production:      production,
-		Files:           plan.Files,
-		PathPrefix:      fillStringNull(plan.PathPrefix),
-		ProjectSettings: plan.ProjectSettings.fillNulls(),
-		DeleteOnDestroy: plan.DeleteOnDestroy,
-		Ref:             ref,
+		Domains:                 types.ListValueMust(types.StringType, domains),
+		TeamID:                  toTeamID(response.TeamID),
+		Environment:             plan.Environment,
+		BuildEnvironment:        types.MapNull(types.StringType), // write-only, so never saved.
+		BuildEnvironmentVersion: plan.BuildEnvironmentVersion,
+		ProjectID:               types.StringValue(response.ProjectID),
+		ID:                      types.StringValue(response.ID),
+		URL:                     types.StringValue(response.URL),
+		Production:              production,
+		SkipAutoAliases:         plan.SkipAutoAliases,
+		Files:                   plan.Files,
+		Meta:                    plan.Meta,
+		GitMetadata:             gitMetadataValue(response.GitMetadata()),
+		PathPrefix:              fillStringNull(plan.PathPrefix),
+		ProjectSettings:         plan.ProjectSettings.fillNulls(),
+		DeleteOnDestroy:         plan.DeleteOnDestroy,
+		Ref:                     ref,
+		ArchivePath:             plan.ArchivePath,
+		DeploymentWindows:       plan.DeploymentWindows,
+		UploadSize:              plan.UploadSize,
+		FunctionCount:           plan.FunctionCount,
+		EdgeFunctionCount:       plan.EdgeFunctionCount,
+		LargestFunctionSize:     plan.LargestFunctionSize,
 	}
 }
 
+// uploadSize returns the total size of the files of a deployment, in bytes.
+func uploadSize(files []client.DeploymentFile) int64 {
+	var size int64
+	for _, f := range files {
+		size += int64(f.Size)
+	}
+	return size
+}
+
+// functionMetrics counts the Serverless and Edge Functions in the output of the builds of a deployment, and finds
+// the size of the largest of them.
+func functionMetrics(builds []client.DeploymentBuild) (functions, edgeFunctions, largest int64) {
+	for _, b := range builds {
+		for _, o := range b.Output {
+			switch o.Type {
+			case "lambda":
+				functions++
+			case "edge":
+				edgeFunctions++
+			default:
+				continue
+			}
+			largest = max(largest, o.Size)
+		}
+	}
+	return functions, edgeFunctions, largest
+}
+
+// readFunctionMetrics sets the function metrics of a deployment from the output of its builds. The output is only
+// complete once the deployment is ready, so until then the metrics are left null.
+func readFunctionMetrics(ctx context.Context, c *client.Client, d *Deployment, readyState string) error {
+	if readyState != "READY" {
+		return nil
+	}
+	builds, err := c.GetDeploymentBuilds(ctx, d.ID.ValueString(), d.TeamID.ValueString())
+	if err != nil {
+		return err
+	}
+	functions, edgeFunctions, largest := functionMetrics(builds)
+	d.FunctionCount = types.Int64Value(functions)
+	d.EdgeFunctionCount = types.Int64Value(edgeFunctions)
+	d.LargestFunctionSize = types.Int64Value(largest)
+	return nil
+}
+
+// deploymentArchive is the document written to `archive_path` once a deployment has completed.
+type deploymentArchive struct {
+	ArchivedAt  time.Time                        `json:"archivedAt"`
+	ID          string                           `json:"id"`
+	URL         string                           `json:"url"`
+	ProjectID   string                           `json:"projectId"`
+	TeamID      string                           `json:"teamId,omitempty"`
+	Target      string                           `json:"target,omitempty"`
+	ReadyState  string                           `json:"readyState"`
+	Creator     string                           `json:"creator,omitempty"`
+	Ref         string                           `json:"ref,omitempty"`
+	Aliases     []string                         `json:"aliases"`
+	SourceFiles map[string]string                `json:"sourceFiles,omitempty"`
+	Output      []client.DeploymentFileTreeEntry `json:"output"`
+}
+
+// writeDeploymentArchive fetches the latest metadata and build output manifest for a deployment and
+// writes them as JSON to the given path, creating any parent directories as needed.
+func writeDeploymentArchive(ctx context.Context, c *client.Client, d Deployment, path string) error {
+	out, err := c.GetDeployment(ctx, d.ID.ValueString(), d.TeamID.ValueString())
+	if err != nil {
+		return fmt.Errorf("could not get deployment: %w", err)
+	}
+	tree, err := c.GetDeploymentFileTree(ctx, d.ID.ValueString(), d.TeamID.ValueString())
+	if err != nil {
+		return fmt.Errorf("could not get deployment build output: %w", err)
+	}
+
+	archive := deploymentArchive{
+		ArchivedAt: time.Now().UTC(),
+		ID:         out.ID,
+		URL:        out.URL,
+		ProjectID:  out.ProjectID,
+		TeamID:     out.TeamID,
+		ReadyState: out.ReadyState,
+		Creator:    out.Creator.Username,
+		Ref:        out.GitSource.Ref,
+		Aliases:    out.Aliases,
+		Output:     tree,
+	}
+	if out.Target != nil {
+		archive.Target = *out.Target
+	}
+	if !d.Files.IsNull() && !d.Files.IsUnknown() {
+		var files map[string]string
+		diags := d.Files.ElementsAs(ctx, &files, false)
+		if diags.HasError() {
+			return fmt.Errorf("could not read deployment files")
+		}
+		archive.SourceFiles = files
+	}
+
+	content, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode archive: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("could not create archive directory: %w", err)
+	}
+	if err := os.WriteFile(path, content, 0o644); err != nil {
+		return fmt.Errorf("could not write archive: %w", err)
+	}
+	return nil
+}
+
 // ValidateConfig allows additional validation (specifically cross-field validation) to be added.
 func (r *deploymentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var config Deployment
@@ -416,6 +683,13 @@ func (r *deploymentResource) ValidateConfig(ctx context.Context, req resource.Va
 		)
 		return
 	}
+	if config.SkipAutoAliases.ValueBool() && !config.Production.IsUnknown() && !config.Production.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deployment Invalid",
+			"`skip_automatic_aliases` can only be set on a production deployment. Preview deployments are never assigned production domains.",
+		)
+		return
+	}
 }
 
 func validatePrebuiltBuilds(diags AddErrorer, config Deployment, files []client.DeploymentFile) {
@@ -477,6 +751,51 @@ func filterNullFromMap(m map[string]types.String) map[string]string {
 	return out
 }
 
+// ModifyPlan checks that a new production deployment falls within its `deployment_windows`, so that a deployment
+// outside of them is rejected when planning, rather than part way through an apply. Create checks them again, as
+// time passes between the plan and the apply.
+func (r *deploymentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	if !req.State.Raw.IsNull() && len(resp.RequiresReplace) == 0 {
+		// The deployment already exists, and is not being re-created.
+		return
+	}
+
+	var production types.Bool
+	diags := req.Plan.GetAttribute(ctx, path.Root("production"), &production)
+	resp.Diagnostics.Append(diags...)
+	var deploymentWindows types.List
+	diags = req.Plan.GetAttribute(ctx, path.Root("deployment_windows"), &deploymentWindows)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !production.ValueBool() || deploymentWindows.IsUnknown() || deploymentWindows.IsNull() {
+		return
+	}
+
+	var windows []DeploymentWindow
+	diags = deploymentWindows.ElementsAs(ctx, &windows, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, w := range windows {
+		if w.Schedule.IsUnknown() || w.Duration.IsUnknown() || w.TimeZone.IsUnknown() {
+			return
+		}
+	}
+	if err := checkDeploymentWindows(windows, time.Now()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("deployment_windows"),
+			"Error planning deployment",
+			fmt.Sprintf("Could not plan deployment: %s.", err),
+		)
+	}
+}
+
 // Create will create a deployment within Vercel. This is done by first attempting to trigger a deployment, seeing what
 // files are required, uploading those files, and then attempting to create a deployment again.
 // This is called automatically by the provider when a new resource should be created.
@@ -512,6 +831,23 @@ func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	if plan.Production.ValueBool() {
+		var windows []DeploymentWindow
+		diags = plan.DeploymentWindows.ElementsAs(ctx, &windows, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := checkDeploymentWindows(windows, time.Now()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("deployment_windows"),
+				"Error creating deployment",
+				fmt.Sprintf("Could not create deployment: %s.", err),
+			)
+			return
+		}
+	}
+
 	var environment map[string]types.String
 	diags = plan.Environment.ElementsAs(ctx, &environment, false)
 	resp.Diagnostics.Append(diags...)
@@ -519,6 +855,27 @@ func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	// build_environment is write-only, so it is only available in the config.
+	var config Deployment
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var buildEnvironment map[string]types.String
+	diags = config.BuildEnvironment.ElementsAs(ctx, &buildEnvironment, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var meta map[string]types.String
+	diags = plan.Meta.ElementsAs(ctx, &meta, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	target := ""
 	if plan.Production.ValueBool() {
 		target = "production"
@@ -528,12 +885,18 @@ func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		files[i].File = normaliseFilename(files[i].File, plan.PathPrefix)
 	}
 	cdr := client.CreateDeploymentRequest{
-		Files:           files,
-		Environment:     filterNullFromMap(environment),
-		ProjectID:       plan.ProjectID.ValueString(),
-		ProjectSettings: plan.ProjectSettings.toRequest(),
-		Target:          target,
-		Ref:             plan.Ref.ValueString(),
+		Files:            files,
+		Environment:      filterNullFromMap(environment),
+		Meta:             filterNullFromMap(meta),
+		BuildEnvironment: filterNullFromMap(buildEnvironment),
+		ProjectID:        plan.ProjectID.ValueString(),
+		ProjectSettings:  plan.ProjectSettings.toRequest(),
+		Target:           target,
+		Ref:              plan.Ref.ValueString(),
+	}
+	if plan.SkipAutoAliases.ValueBool() {
+		autoAssign := false
+		cdr.AutoAssignCustomDomains = &autoAssign
 	}
 
 	_, err = r.client.GetProject(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString())
@@ -549,8 +912,13 @@ func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 	var mfErr client.MissingFilesError
 	if errors.As(err, &mfErr) {
 		// Then we need to upload the files, and create the deployment again.
-		for _, sha := range mfErr.Missing {
+		progress := client.NewProgress("uploading deployment files")
+		var uploadErrs []string
+		for i, sha := range mfErr.Missing {
 			f := filesBySha[sha]
+			progress.Step(ctx, fmt.Sprintf("uploading file %d of %d", i+1, len(mfErr.Missing)), map[string]any{
+				"file": f.File,
+			})
 
 			// Get file info to check if it's a symlink
 			fileInfo, err := os.Lstat(f.File)
@@ -566,11 +934,10 @@ func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 				return
 			}
 
-			var content []byte
-
+			var content string
 			if fileInfo.Mode()&os.ModeSymlink != 0 {
-				// It's a symlink - read the target path
-				linkTarget, err := os.Readlink(f.File)
+				// It's a symlink - the content is the target path
+				content, err = os.Readlink(f.File)
 				if err != nil {
 					resp.Diagnostics.AddError(
 						"Error reading symlink",
@@ -582,27 +949,9 @@ func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 					)
 					return
 				}
-				// For symlinks, the content is the target path as string
-				err = r.client.CreateFile(ctx, client.CreateFileRequest{
-					Filename: normaliseFilename(f.File, plan.PathPrefix),
-					SHA:      f.Sha,
-					Content:  linkTarget, // Just use the target path as content
-					TeamID:   plan.TeamID.ValueString(),
-				})
-				if err != nil {
-					resp.Diagnostics.AddError(
-						"Error uploading symlink file",
-						fmt.Sprintf(
-							"Could not upload symlink %s, unexpected error: %s",
-							f.File,
-							err,
-						),
-					)
-					return
-				}
 			} else {
 				// Regular file - read its content
-				content, err = os.ReadFile(f.File)
+				raw, err := os.ReadFile(f.File)
 				if err != nil {
 					resp.Diagnostics.AddError(
 						"Error reading file",
@@ -614,28 +963,36 @@ func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 					)
 					return
 				}
+				content = string(raw)
 			}
 
+			// Failed uploads are collected rather than returned straight away, so that every file that could not
+			// be uploaded is reported at once, and the files that were uploaded do not need uploading again.
 			err = r.client.CreateFile(ctx, client.CreateFileRequest{
 				Filename: normaliseFilename(f.File, plan.PathPrefix),
 				SHA:      f.Sha,
-				Content:  string(content),
+				Content:  content,
 				TeamID:   plan.TeamID.ValueString(),
 				// If we need to preserve the file mode, add it here
 				// Mode:     uint32(fileInfo.Mode()),
 			})
 			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error uploading deployment file",
-					fmt.Sprintf(
-						"Could not upload deployment file %s, unexpected error: %s",
-						f.File,
-						err,
-					),
-				)
-				return
+				uploadErrs = append(uploadErrs, err.Error())
 			}
 		}
+		progress.Done(ctx)
+		if len(uploadErrs) > 0 {
+			resp.Diagnostics.AddError(
+				"Error uploading deployment files",
+				fmt.Sprintf(
+					"Could not upload %d of %d deployment files:\n  - %s",
+					len(uploadErrs),
+					len(mfErr.Missing),
+					strings.Join(uploadErrs, "\n  - "),
+				),
+			)
+			return
+		}
 
 		out, err = r.client.CreateDeployment(ctx, cdr, plan.TeamID.ValueString())
 		if err != nil {
@@ -654,6 +1011,18 @@ func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	result := convertResponseToDeployment(out, plan)
+	result.UploadSize = types.Int64Value(uploadSize(files))
+	err = readFunctionMetrics(ctx, r.client, &result, out.ReadyState)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Error reading deployment build output",
+			fmt.Sprintf(
+				"The deployment %s was created, but its build output could not be read, so its function metrics are not available: %s",
+				result.URL.ValueString(),
+				err,
+			),
+		)
+	}
 	tflog.Info(ctx, "created deployment", map[string]any{
 		"team_id":    result.TeamID.ValueString(),
 		"project_id": result.ID.ValueString(),
@@ -664,6 +1033,21 @@ func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	if !result.ArchivePath.IsNull() {
+		err = writeDeploymentArchive(ctx, r.client, result, result.ArchivePath.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Error archiving deployment",
+				fmt.Sprintf(
+					"The deployment %s was created, but could not be archived to %s: %s",
+					result.URL.ValueString(),
+					result.ArchivePath.ValueString(),
+					err,
+				),
+			)
+		}
+	}
 }
 
 // Read will read a file from the filesytem and provide terraform with information about it.
@@ -694,6 +1078,20 @@ func (r *deploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	result := convertResponseToDeployment(out, state)
+	// Deployments that were not ready when they were created only have their function metrics read once they are.
+	if result.FunctionCount.IsNull() {
+		err = readFunctionMetrics(ctx, r.client, &result, out.ReadyState)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Error reading deployment build output",
+				fmt.Sprintf(
+					"The build output of deployment %s could not be read, so its function metrics are not available: %s",
+					result.URL.ValueString(),
+					err,
+				),
+			)
+		}
+	}
 	tflog.Info(ctx, "read deployment", map[string]any{
 		"team_id":    result.TeamID.ValueString(),
 		"project_id": result.ID.ValueString(),
@@ -707,8 +1105,8 @@ func (r *deploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 // Update updates the deployment state.
-// Note that only the `delete_on_destroy` field is updatable, and this does not affect Vercel. So it is just a case
-// of setting terraform state.
+// Note that only the `delete_on_destroy`, `archive_path` and `deployment_windows` fields are updatable, and these do not affect Vercel.
+// So it is just a case of setting terraform state, and writing a new archive if the path has changed.
 func (r *deploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan Deployment
 	diags := req.Plan.Get(ctx, &plan)
@@ -728,13 +1126,32 @@ func (r *deploymentResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	// Copy over the planned field only
+	archiveChanged := !plan.ArchivePath.IsNull() && !plan.ArchivePath.Equal(state.ArchivePath)
+
+	// Copy over the planned fields only
 	state.DeleteOnDestroy = plan.DeleteOnDestroy
+	state.ArchivePath = plan.ArchivePath
+	state.DeploymentWindows = plan.DeploymentWindows
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	if archiveChanged {
+		err := writeDeploymentArchive(ctx, r.client, state, state.ArchivePath.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Error archiving deployment",
+				fmt.Sprintf(
+					"Could not archive deployment %s to %s: %s",
+					state.URL.ValueString(),
+					state.ArchivePath.ValueString(),
+					err,
+				),
+			)
+		}
+	}
 }
 
 // Delete conditionally deletes a Deployment.
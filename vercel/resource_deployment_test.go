@@ -2,11 +2,15 @@ package vercel_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"os"
+	"path/filepath"
+	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -87,6 +91,10 @@ func TestAcc_Deployment(t *testing.T) {
 					testTeamID,
 					testAccDeploymentExists(testClient(t), "vercel_deployment.test", ""),
 					resource.TestCheckResourceAttr("vercel_deployment.test", "production", "true"),
+					resource.TestCheckResourceAttrSet("vercel_deployment.test", "upload_size"),
+					resource.TestCheckResourceAttrSet("vercel_deployment.test", "function_count"),
+					resource.TestCheckResourceAttrSet("vercel_deployment.test", "edge_function_count"),
+					resource.TestCheckResourceAttrSet("vercel_deployment.test", "largest_function_size"),
 				),
 			},
 			{
@@ -100,6 +108,38 @@ func TestAcc_Deployment(t *testing.T) {
 	})
 }
 
+func TestAcc_DeploymentNotWaitingForReady(t *testing.T) {
+	projectSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             noopDestroyCheck,
+		Steps: []resource.TestStep{
+			{
+				// The build output is not complete until the deployment is ready, so the function metrics are not
+				// known yet.
+				Config: fmt.Sprintf(`
+provider "vercel" {
+  team = "%[1]s"
+
+  features {
+    deployments {
+      wait_for_ready = false
+    }
+  }
+}
+%[2]s`, testTeam(t), testAccDeploymentConfig(projectSuffix, "")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeploymentExists(testClient(t), "vercel_deployment.test", ""),
+					resource.TestCheckResourceAttrSet("vercel_deployment.test", "upload_size"),
+					resource.TestCheckNoResourceAttr("vercel_deployment.test", "function_count"),
+					resource.TestCheckNoResourceAttr("vercel_deployment.test", "edge_function_count"),
+					resource.TestCheckNoResourceAttr("vercel_deployment.test", "largest_function_size"),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_DeploymentWithEnvironment(t *testing.T) {
 	projectSuffix := acctest.RandString(16)
 	resource.Test(t, resource.TestCase{
@@ -123,6 +163,186 @@ func TestAcc_DeploymentWithEnvironment(t *testing.T) {
 	})
 }
 
+func TestAcc_DeploymentSkipAutomaticAliases(t *testing.T) {
+	projectSuffix := acctest.RandString(16)
+	productionDomain := fmt.Sprintf("test-acc-deployment-%s.vercel.app", projectSuffix)
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:             noopDestroyCheck,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(`
+resource "vercel_deployment" "test" {
+  project_id             = "prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  ref                    = "main"
+  skip_automatic_aliases = true
+}`),
+				ExpectError: regexp.MustCompile("can only be set on a production deployment"),
+			},
+			{
+				Config: cfg(testAccDeploymentConfig(projectSuffix, "skip_automatic_aliases = true")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeploymentExists(testClient(t), "vercel_deployment.test", ""),
+					resource.TestCheckResourceAttr("vercel_deployment.test", "production", "true"),
+					resource.TestCheckResourceAttr("vercel_deployment.test", "skip_automatic_aliases", "true"),
+					func(s *terraform.State) error {
+						rs := s.RootModule().Resources["vercel_deployment.test"]
+						for k, v := range rs.Primary.Attributes {
+							if strings.HasPrefix(k, "domains.") && v == productionDomain {
+								return fmt.Errorf("expected production domain %s not to be assigned to the deployment", productionDomain)
+							}
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_DeploymentWithDeploymentWindows(t *testing.T) {
+	projectSuffix := acctest.RandString(16)
+	// A day of the week that it is not today, so a window on that day is closed.
+	otherDay := (int(time.Now().UTC().Weekday()) + 3) % 7
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:             noopDestroyCheck,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// `*/1` matches every day of the month, so it does not widen the window to every day.
+				Config: cfg(testAccDeploymentConfig(projectSuffix, fmt.Sprintf(`
+  deployment_windows = [{
+    schedule = "* * */1 * %d"
+    duration = "1m"
+  }]`, otherDay))),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("outside all of the configured `deployment_windows`"),
+			},
+			{
+				Config: cfg(testAccDeploymentConfig(projectSuffix, `
+  deployment_windows = [{
+    schedule = "0 0 31 2 *"
+    duration = "1h"
+  }]`)),
+				ExpectError: regexp.MustCompile("outside all of the configured `deployment_windows`"),
+			},
+			{
+				Config: cfg(testAccDeploymentConfig(projectSuffix, `
+  deployment_windows = [
+    {
+      schedule = "0 0 31 2 *"
+      duration = "1h"
+    },
+    {
+      schedule  = "* * * * *"
+      duration  = "1m"
+      time_zone = "Europe/London"
+    },
+  ]`)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeploymentExists(testClient(t), "vercel_deployment.test", ""),
+					resource.TestCheckResourceAttr("vercel_deployment.test", "deployment_windows.#", "2"),
+					resource.TestCheckResourceAttr("vercel_deployment.test", "deployment_windows.1.time_zone", "Europe/London"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_DeploymentWindowsValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(`
+resource "vercel_deployment" "test" {
+  project_id = "prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  ref        = "main"
+  deployment_windows = [{
+    schedule = "0 9 * *"
+    duration = "8h"
+  }]
+}`),
+				ExpectError: regexp.MustCompile("five field cron expression"),
+			},
+			{
+				Config: cfg(`
+resource "vercel_deployment" "test" {
+  project_id = "prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  ref        = "main"
+  deployment_windows = [{
+    schedule  = "0 9 * * 1-5"
+    duration  = "8h"
+    time_zone = "Mars/Olympus_Mons"
+  }]
+}`),
+				ExpectError: regexp.MustCompile("IANA time zone name"),
+			},
+		},
+	})
+}
+
+func TestAcc_DeploymentWithBuildEnvironment(t *testing.T) {
+	projectSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:             noopDestroyCheck,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccDeploymentConfig(projectSuffix, `build_environment = {
+                    NPM_TOKEN = "secret"
+                }
+                build_environment_version = 1`)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeploymentExists(testClient(t), "vercel_deployment.test", ""),
+					testAccEnvironmentSet(testClient(t), "vercel_deployment.test", "", "NPM_TOKEN"),
+					resource.TestCheckNoResourceAttr("vercel_deployment.test", "build_environment.%"),
+					resource.TestCheckResourceAttr("vercel_deployment.test", "build_environment_version", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_DeploymentWithMeta(t *testing.T) {
+	projectSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:             noopDestroyCheck,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccDeploymentConfig(projectSuffix, `meta = {
+                    terraform_workspace = "default"
+                    ticket              = "ABC-123"
+                }`) + `
+data "vercel_deployments" "test" {
+  project_id = vercel_deployment.test.project_id
+  meta = {
+    ticket = "ABC-123"
+  }
+}
+
+data "vercel_deployments" "none" {
+  project_id = vercel_deployment.test.project_id
+  meta = {
+    ticket = "does-not-exist"
+  }
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeploymentExists(testClient(t), "vercel_deployment.test", ""),
+					resource.TestCheckResourceAttr("vercel_deployment.test", "meta.terraform_workspace", "default"),
+					resource.TestCheckResourceAttr("vercel_deployment.test", "meta.ticket", "ABC-123"),
+					resource.TestCheckResourceAttr("data.vercel_deployments.test", "deployments.#", "1"),
+					resource.TestCheckResourceAttrPair("data.vercel_deployments.test", "deployments.0.id", "vercel_deployment.test", "id"),
+					resource.TestCheckResourceAttr("data.vercel_deployments.test", "deployments.0.meta.ticket", "ABC-123"),
+					resource.TestCheckResourceAttr("data.vercel_deployments.none", "deployments.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_DeploymentWithProjectSettings(t *testing.T) {
 	projectSuffix := acctest.RandString(16)
 	resource.Test(t, resource.TestCase{
@@ -178,6 +398,51 @@ func TestAcc_DeploymentWithPathPrefix(t *testing.T) {
 	})
 }
 
+func TestAcc_DeploymentWithArchivePath(t *testing.T) {
+	projectSuffix := acctest.RandString(16)
+	archivePath := filepath.Join(t.TempDir(), "archive", "deployment.json")
+	testArchiveWritten := func(n string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			rs, ok := s.RootModule().Resources[n]
+			if !ok {
+				return fmt.Errorf("not found: %s", n)
+			}
+			content, err := os.ReadFile(archivePath)
+			if err != nil {
+				return fmt.Errorf("expected deployment archive to be written: %w", err)
+			}
+			var archive struct {
+				ID     string `json:"id"`
+				Output []any  `json:"output"`
+			}
+			if err := json.Unmarshal(content, &archive); err != nil {
+				return fmt.Errorf("could not parse deployment archive: %w", err)
+			}
+			if archive.ID != rs.Primary.ID {
+				return fmt.Errorf("expected archive for deployment %s, but got %s", rs.Primary.ID, archive.ID)
+			}
+			if len(archive.Output) == 0 {
+				return fmt.Errorf("expected archive to contain the build output manifest")
+			}
+			return nil
+		}
+	}
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:             noopDestroyCheck,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccDeploymentConfig(projectSuffix, fmt.Sprintf("archive_path = %q", archivePath))),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeploymentExists(testClient(t), "vercel_deployment.test", ""),
+					resource.TestCheckResourceAttr("vercel_deployment.test", "archive_path", archivePath),
+					testArchiveWritten("vercel_deployment.test"),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_DeploymentWithDeleteOnDestroy(t *testing.T) {
 	projectSuffix := acctest.RandString(16)
 	extraConfig := "delete_on_destroy = true"
@@ -239,6 +504,11 @@ func TestAcc_DeploymentWithGitSource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccDeploymentExists(testClient(t), "vercel_deployment.bitbucket", testTeam(t)),
 					testAccDeploymentExists(testClient(t), "vercel_deployment.github", testTeam(t)),
+					resource.TestCheckResourceAttr("vercel_deployment.github", "git_metadata.provider", "github"),
+					resource.TestCheckResourceAttr("vercel_deployment.github", "git_metadata.ref", "main"),
+					resource.TestCheckResourceAttrSet("vercel_deployment.github", "git_metadata.sha"),
+					resource.TestCheckResourceAttr("vercel_deployment.bitbucket", "git_metadata.provider", "bitbucket"),
+					resource.TestCheckResourceAttrSet("vercel_deployment.bitbucket", "git_metadata.sha"),
 				),
 			},
 		},
@@ -0,0 +1,295 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &dnsAuthoritativeZoneResource{}
+	_ resource.ResourceWithConfigure  = &dnsAuthoritativeZoneResource{}
+	_ resource.ResourceWithModifyPlan = &dnsAuthoritativeZoneResource{}
+)
+
+func newDNSAuthoritativeZoneResource() resource.Resource {
+	return &dnsAuthoritativeZoneResource{}
+}
+
+type dnsAuthoritativeZoneResource struct {
+	client *client.Client
+}
+
+func (r *dnsAuthoritativeZoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_authoritative_zone"
+}
+
+func (r *dnsAuthoritativeZoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema returns the schema information for a DNS authoritative zone resource.
+func (r *dnsAuthoritativeZoneResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Makes Terraform the single source of truth for every DNS record of a domain.
+
+Any record in the domain that is not listed in ` + "`managed_record_ids`" + `, such as one added in the Vercel dashboard, is detected as drift and deleted. Records that Vercel creates and manages itself, such as the default records of a domain using Vercel's nameservers and domain verification records, are left in place unless ` + "`include_system_records`" + ` is enabled.
+
+Terraform plans each resource separately, so this resource cannot find the records managed by other resources by itself. The IDs of every ` + "`vercel_dns_record`" + ` and ` + "`vercel_dns_record_set`" + ` in the domain must be passed to ` + "`managed_record_ids`" + `. Referencing them also makes sure that new records are created before the zone is checked.
+
+~> Any record whose ID is missing from ` + "`managed_record_ids`" + ` is deleted, including records managed by other Terraform configurations. Destroying this resource does not delete any records.
+`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The team ID that the domain belongs to. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"domain": schema.StringAttribute{
+				Description:   "The domain name, or zone, to manage every DNS record of.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:      true,
+			},
+			"managed_record_ids": schema.SetAttribute{
+				Description: "The IDs of every DNS record in the domain that is managed by Terraform. All other records are deleted.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"include_system_records": schema.BoolAttribute{
+				Description: "When `true`, records that Vercel creates and manages itself, such as domain verification records, are deleted too. Vercel may recreate these records after they are deleted. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"unmanaged_records": schema.MapAttribute{
+				Description: "A map of the ID of each DNS record in the domain that is not managed by Terraform to a description of it. This is always empty after an apply, and any records found by a refresh are deleted by the next apply.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// DNSAuthoritativeZone reflects the state terraform stores internally for a DNS authoritative zone.
+type DNSAuthoritativeZone struct {
+	TeamID               types.String `tfsdk:"team_id"`
+	Domain               types.String `tfsdk:"domain"`
+	ManagedRecordIDs     types.Set    `tfsdk:"managed_record_ids"`
+	IncludeSystemRecords types.Bool   `tfsdk:"include_system_records"`
+	UnmanagedRecords     types.Map    `tfsdk:"unmanaged_records"`
+}
+
+// unmanagedZoneRecords returns the records in the domain that are not in managedIDs, by record ID. Records managed
+// by Vercel itself are skipped unless includeSystemRecords is set.
+func unmanagedZoneRecords(records []client.DNSRecord, managedIDs []string, includeSystemRecords bool) map[string]client.DNSRecord {
+	managed := map[string]struct{}{}
+	for _, id := range managedIDs {
+		managed[id] = struct{}{}
+	}
+	unmanaged := map[string]client.DNSRecord{}
+	for _, record := range records {
+		if _, ok := managed[record.ID]; ok {
+			continue
+		}
+		if isSystemDNSRecord(record) && !includeSystemRecords {
+			continue
+		}
+		unmanaged[record.ID] = record
+	}
+	return unmanaged
+}
+
+// describeDNSRecord returns a short description of a record, to show in unmanaged_records.
+func describeDNSRecord(record client.DNSRecord) string {
+	name := record.Name
+	if name == "" {
+		name = "@"
+	}
+	return fmt.Sprintf("%s %s %s", name, record.RecordType, record.Value)
+}
+
+// ModifyPlan plans for unmanaged_records to be empty, so that any unmanaged records found by a refresh show as a
+// change, and are deleted by the apply.
+func (r *dnsAuthoritativeZoneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("unmanaged_records"), types.MapValueMust(types.StringType, map[string]attr.Value{}))...)
+}
+
+// enforce deletes every unmanaged record in the domain.
+func (r *dnsAuthoritativeZoneResource) enforce(ctx context.Context, plan DNSAuthoritativeZone) (DNSAuthoritativeZone, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var managedIDs []string
+	diags.Append(plan.ManagedRecordIDs.ElementsAs(ctx, &managedIDs, false)...)
+	if diags.HasError() {
+		return plan, diags
+	}
+
+	teamID := r.client.TeamID(plan.TeamID.ValueString())
+	records, err := r.client.ListDNSRecords(ctx, plan.Domain.ValueString(), teamID)
+	if err != nil {
+		diags.AddError(
+			"Error listing DNS Records",
+			fmt.Sprintf("Could not list the DNS Records of %s, unexpected error: %s", plan.Domain.ValueString(), err),
+		)
+		return plan, diags
+	}
+
+	unmanaged := unmanagedZoneRecords(records, managedIDs, plan.IncludeSystemRecords.ValueBool())
+	ids := sortedKeys(unmanaged)
+	errs := runConcurrently(len(ids), maxConcurrentRequests, func(i int) error {
+		err := r.client.DeleteDNSRecord(ctx, plan.Domain.ValueString(), ids[i], teamID)
+		if client.NotFound(err) {
+			return nil
+		}
+		return err
+	})
+	remaining := map[string]string{}
+	for i, err := range errs {
+		if err != nil {
+			remaining[ids[i]] = describeDNSRecord(unmanaged[ids[i]])
+			diags.AddError(
+				"Error deleting DNS Record",
+				fmt.Sprintf("Could not delete unmanaged DNS Record %s (%s), unexpected error: %s", ids[i], describeDNSRecord(unmanaged[ids[i]]), err),
+			)
+		}
+	}
+	tflog.Info(ctx, "deleted unmanaged DNS records", map[string]any{
+		"team_id": teamID,
+		"domain":  plan.Domain.ValueString(),
+		"deleted": len(ids) - len(remaining),
+	})
+
+	unmanagedRecords, d := types.MapValueFrom(ctx, types.StringType, remaining)
+	diags.Append(d...)
+	return DNSAuthoritativeZone{
+		TeamID:               toTeamID(teamID),
+		Domain:               plan.Domain,
+		ManagedRecordIDs:     plan.ManagedRecordIDs,
+		IncludeSystemRecords: plan.IncludeSystemRecords,
+		UnmanagedRecords:     unmanagedRecords,
+	}, diags
+}
+
+// Create deletes every unmanaged record in the domain.
+func (r *dnsAuthoritativeZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DNSAuthoritativeZone
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.enforce(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read lists the records in the domain, and records any that are not managed by Terraform as drift.
+func (r *dnsAuthoritativeZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DNSAuthoritativeZone
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var managedIDs []string
+	diags = state.ManagedRecordIDs.ElementsAs(ctx, &managedIDs, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID := r.client.TeamID(state.TeamID.ValueString())
+	records, err := r.client.ListDNSRecords(ctx, state.Domain.ValueString(), teamID)
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNS Authoritative Zone",
+			fmt.Sprintf("Could not list the DNS Records of %s, unexpected error: %s", state.Domain.ValueString(), err),
+		)
+		return
+	}
+
+	unmanaged := map[string]string{}
+	for id, record := range unmanagedZoneRecords(records, managedIDs, state.IncludeSystemRecords.ValueBool()) {
+		unmanaged[id] = describeDNSRecord(record)
+	}
+	state.UnmanagedRecords, diags = types.MapValueFrom(ctx, types.StringType, unmanaged)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "read DNS authoritative zone", map[string]any{
+		"team_id":   teamID,
+		"domain":    state.Domain.ValueString(),
+		"unmanaged": len(unmanaged),
+	})
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update deletes every unmanaged record in the domain.
+func (r *dnsAuthoritativeZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DNSAuthoritativeZone
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.enforce(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete only removes the zone from the terraform state. No records are deleted.
+func (r *dnsAuthoritativeZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DNSAuthoritativeZone
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "removed DNS authoritative zone from state", map[string]any{
+		"team_id": state.TeamID.ValueString(),
+		"domain":  state.Domain.ValueString(),
+	})
+}
@@ -0,0 +1,92 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+func TestAcc_DNSAuthoritativeZone(t *testing.T) {
+	t.Skip("Skipping until i have a domain in a suitable location to test with")
+	nameSuffix := acctest.RandString(16)
+	unmanagedName := fmt.Sprintf("test-acc-%s-unmanaged", nameSuffix)
+	createUnmanagedRecord := func() {
+		_, err := testClient(t).CreateDNSRecord(context.TODO(), testTeam(t), client.CreateDNSRecordRequest{
+			Domain: testDomain(t),
+			Name:   unmanagedName,
+			Type:   "A",
+			TTL:    60,
+			Value:  "127.0.0.9",
+		})
+		if err != nil {
+			t.Fatalf("could not create DNS record: %s", err)
+		}
+	}
+	testUnmanagedRecordDeleted := func(*terraform.State) error {
+		records, err := testClient(t).ListDNSRecords(context.TODO(), testDomain(t), testTeam(t))
+		if err != nil {
+			return err
+		}
+		for _, r := range records {
+			if r.Name == unmanagedName {
+				return fmt.Errorf("expected unmanaged record %s to be deleted", r.ID)
+			}
+		}
+		return nil
+	}
+	config := cfg(fmt.Sprintf(`
+resource "vercel_dns_record" "test" {
+  domain = "%[1]s"
+  name   = "test-acc-%[2]s-managed"
+  type   = "A"
+  ttl    = 60
+  value  = "127.0.0.1"
+}
+
+resource "vercel_dns_authoritative_zone" "test" {
+  domain             = "%[1]s"
+  managed_record_ids = [vercel_dns_record.test.id]
+}
+`, testDomain(t), nameSuffix))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             noopDestroyCheck,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: createUnmanagedRecord,
+				Config:    config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_dns_authoritative_zone.test", "unmanaged_records.%", "0"),
+					resource.TestCheckResourceAttr("vercel_dns_authoritative_zone.test", "include_system_records", "false"),
+					testUnmanagedRecordDeleted,
+				),
+			},
+			{
+				// A record added outside of Terraform is detected as drift and deleted.
+				PreConfig: createUnmanagedRecord,
+				Config:    config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("vercel_dns_authoritative_zone.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testUnmanagedRecordDeleted,
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
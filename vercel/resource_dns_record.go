@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -64,6 +66,8 @@ DNS records are instructions that live in authoritative DNS servers and provide
 
 ~> The ` + "`value` field" + ` must be specified on all DNS record types except ` + "`SRV`" + `. When using ` + "`SRV`" + ` DNS records, the ` + "`srv`" + ` field must be specified.
 
+Changes to the name, value, TTL, priority and comment of a record are made in place, so the record keeps resolving throughout the change. Only changing the ` + "`domain`" + ` or ` + "`type`" + ` replaces the record.
+
 For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/concepts/projects/custom-domains#dns-records)
         `,
 		Attributes: map[string]schema.Attribute{
@@ -95,13 +99,14 @@ For more detailed information, please see the [Vercel documentation](https://ver
 			},
 			"value": schema.StringAttribute{
 				// required if any record type apart from SRV.
-				Description: "The value of the DNS record. The format depends on the 'type' property.\nFor an 'A' record, this should be a valid IPv4 address.\nFor an 'AAAA' record, this should be an IPv6 address.\nFor 'ALIAS' records, this should be a hostname.\nFor 'CAA' records, this should specify specify which Certificate Authorities (CAs) are allowed to issue certificates for the domain.\nFor 'CNAME' records, this should be a different domain name.\nFor 'MX' records, this should specify the mail server responsible for accepting messages on behalf of the domain name.\nFor 'TXT' records, this can contain arbitrary text.",
+				Description: "The value of the DNS record. The format depends on the 'type' property.\nFor an 'A' record, this should be a valid IPv4 address.\nFor an 'AAAA' record, this should be an IPv6 address.\nFor 'ALIAS' records, this should be a hostname.\nFor 'CAA' records, this should specify specify which Certificate Authorities (CAs) are allowed to issue certificates for the domain.\nFor 'CNAME' records, this should be a different domain name.\nFor 'MX' records, this should specify the mail server responsible for accepting messages on behalf of the domain name.\nFor 'TXT' records, this can contain arbitrary text.\nValues are compared semantically, so differences in hostname casing or trailing dots, and in how TXT values are quoted, do not produce a diff.",
 				Optional:    true,
 			},
 			"ttl": schema.Int64Attribute{
-				Description: "The TTL value in seconds. Must be a number between 60 and 2147483647. If unspecified, it will default to 60 seconds.",
-				Optional:    true,
-				Computed:    true,
+				Description:   "The TTL value in seconds. Must be a number between 60 and 2147483647. If unspecified, it will default to 60 seconds.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 				Validators: []validator.Int64{
 					int64validator.AtLeast(60),
 					int64validator.AtMost(2147483647),
@@ -116,7 +121,7 @@ For more detailed information, please see the [Vercel documentation](https://ver
 				},
 			},
 			"comment": schema.StringAttribute{
-				Description: "A comment explaining what the DNS record is for.",
+				Description: "A comment explaining what the DNS record is for, such as the team that owns it. The comment is shown alongside the record in the Vercel dashboard.",
 				Optional:    true,
 				Computed:    true,
 				Default:     stringdefault.StaticString(""),
@@ -124,6 +129,12 @@ For more detailed information, please see the [Vercel documentation](https://ver
 					stringvalidator.LengthBetween(0, 500),
 				},
 			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "When enabled, the DNS record cannot be deleted by Terraform. To delete the record, first set this to `false` and apply the change. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
 			"srv": schema.SingleNestedAttribute{
 				Description: "Settings for an SRV record.",
 				Optional:    true, // required for SRV records.
@@ -172,16 +183,17 @@ type SRV struct {
 
 // DNSRecord reflects the state terraform stores internally for a DNS Record.
 type DNSRecord struct {
-	ID         types.String `tfsdk:"id"`
-	Domain     types.String `tfsdk:"domain"`
-	MXPriority types.Int64  `tfsdk:"mx_priority"`
-	Name       types.String `tfsdk:"name"`
-	SRV        *SRV         `tfsdk:"srv"`
-	TTL        types.Int64  `tfsdk:"ttl"`
-	TeamID     types.String `tfsdk:"team_id"`
-	Type       types.String `tfsdk:"type"`
-	Value      types.String `tfsdk:"value"`
-	Comment    types.String `tfsdk:"comment"`
+	ID                 types.String `tfsdk:"id"`
+	Domain             types.String `tfsdk:"domain"`
+	MXPriority         types.Int64  `tfsdk:"mx_priority"`
+	Name               types.String `tfsdk:"name"`
+	SRV                *SRV         `tfsdk:"srv"`
+	TTL                types.Int64  `tfsdk:"ttl"`
+	TeamID             types.String `tfsdk:"team_id"`
+	Type               types.String `tfsdk:"type"`
+	Value              types.String `tfsdk:"value"`
+	Comment            types.String `tfsdk:"comment"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
 }
 
 func (d DNSRecord) toCreateDNSRecordRequest() client.CreateDNSRecordRequest {
@@ -227,7 +239,10 @@ func (d DNSRecord) toUpdateRequest() client.UpdateDNSRecordRequest {
 	}
 }
 
-func convertResponseToDNSRecord(r client.DNSRecord, value types.String, srv *SRV) (record DNSRecord, err error) {
+// convertResponseToDNSRecord converts the API response into the terraform model. Where the API returns a name or
+// value that is equivalent to the prior value, but formatted differently (e.g. with a trailing dot or different
+// casing), the prior value is kept to avoid a spurious diff.
+func convertResponseToDNSRecord(r client.DNSRecord, prior DNSRecord) (record DNSRecord, err error) {
 	record = DNSRecord{
 		Domain:     types.StringValue(r.Domain),
 		ID:         types.StringValue(r.ID),
@@ -239,6 +254,10 @@ func convertResponseToDNSRecord(r client.DNSRecord, value types.String, srv *SRV
 		Comment:    types.StringValue(r.Comment),
 	}
 
+	if !prior.Name.IsNull() && normalizeDNSName(prior.Name.ValueString()) == normalizeDNSName(r.Name) {
+		record.Name = prior.Name
+	}
+
 	if r.RecordType == "SRV" {
 		// The returned 'Value' field is comprised of the various parts of the SRV block.
 		// So instead, we want to parse the SRV block back out.
@@ -270,8 +289,8 @@ func convertResponseToDNSRecord(r client.DNSRecord, value types.String, srv *SRV
 		}
 		// SRV records have no value
 		record.Value = types.StringNull()
-		if srv != nil && fmt.Sprintf("%s.", srv.Target.ValueString()) == record.SRV.Target.ValueString() {
-			record.SRV.Target = srv.Target
+		if prior.SRV != nil && normalizeDNSName(prior.SRV.Target.ValueString()) == normalizeDNSName(target) {
+			record.SRV.Target = prior.SRV.Target
 		}
 		return record, nil
 	}
@@ -288,15 +307,15 @@ func convertResponseToDNSRecord(r client.DNSRecord, value types.String, srv *SRV
 
 		record.MXPriority = types.Int64Value(int64(priority))
 		record.Value = types.StringValue(split[1])
-		if split[1] == fmt.Sprintf("%s.", value.ValueString()) {
-			record.Value = value
+		if !prior.Value.IsNull() && dnsValuesEquivalent(r.RecordType, prior.Value.ValueString(), split[1]) {
+			record.Value = prior.Value
 		}
 		return record, nil
 	}
 
 	record.Value = types.StringValue(r.Value)
-	if r.Value == fmt.Sprintf("%s.", value.ValueString()) {
-		record.Value = value
+	if !prior.Value.IsNull() && dnsValuesEquivalent(r.RecordType, prior.Value.ValueString(), r.Value) {
+		record.Value = prior.Value
 	}
 	return record, nil
 }
@@ -372,7 +391,7 @@ func (r *dnsRecordResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	result, err := convertResponseToDNSRecord(out, plan.Value, plan.SRV)
+	result, err := convertResponseToDNSRecord(out, plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error parsing DNS Record response",
@@ -380,6 +399,7 @@ func (r *dnsRecordResource) Create(ctx context.Context, req resource.CreateReque
 		)
 		return
 	}
+	result.DeletionProtection = plan.DeletionProtection
 	tflog.Info(ctx, "created DNS Record", map[string]any{
 		"team_id":   result.TeamID,
 		"record_id": result.ID,
@@ -420,7 +440,7 @@ func (r *dnsRecordResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	result, err := convertResponseToDNSRecord(out, state.Value, state.SRV)
+	result, err := convertResponseToDNSRecord(out, state)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error parsing DNS Record response",
@@ -428,6 +448,7 @@ func (r *dnsRecordResource) Read(ctx context.Context, req resource.ReadRequest,
 		)
 		return
 	}
+	result.DeletionProtection = state.DeletionProtection
 	tflog.Info(ctx, "read DNS record", map[string]any{
 		"team_id":   result.TeamID,
 		"record_id": result.ID,
@@ -476,7 +497,7 @@ func (r *dnsRecordResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	result, err := convertResponseToDNSRecord(out, plan.Value, plan.SRV)
+	result, err := convertResponseToDNSRecord(out, plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error parsing DNS Record response",
@@ -484,6 +505,7 @@ func (r *dnsRecordResource) Update(ctx context.Context, req resource.UpdateReque
 		)
 		return
 	}
+	result.DeletionProtection = plan.DeletionProtection
 	tflog.Info(ctx, "updated DNS record", map[string]any{
 		"team_id":   result.TeamID,
 		"record_id": result.ID,
@@ -506,6 +528,18 @@ func (r *dnsRecordResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Error deleting DNS Record",
+			fmt.Sprintf(
+				"Could not delete DNS Record %s for domain %s as it has `deletion_protection` enabled. To delete the record, set `deletion_protection = false`, apply the change, and then try again.",
+				state.ID.ValueString(),
+				state.Domain.ValueString(),
+			),
+		)
+		return
+	}
+
 	err := r.client.DeleteDNSRecord(ctx, state.Domain.ValueString(), state.ID.ValueString(), state.TeamID.ValueString())
 	if client.NotFound(err) {
 		// The DNS Record is already gone - do nothing.
@@ -554,7 +588,7 @@ func (r *dnsRecordResource) ImportState(ctx context.Context, req resource.Import
 		return
 	}
 
-	result, err := convertResponseToDNSRecord(out, types.String{}, nil)
+	result, err := convertResponseToDNSRecord(out, DNSRecord{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error processing DNS Record response",
@@ -565,6 +599,16 @@ func (r *dnsRecordResource) ImportState(ctx context.Context, req resource.Import
 			),
 		)
 	}
+	result.DeletionProtection = types.BoolValue(false)
+	if isSystemDNSRecord(out) {
+		resp.Diagnostics.AddWarning(
+			"Importing a Vercel managed DNS Record",
+			fmt.Sprintf(
+				"The DNS Record %s is managed by Vercel, which recreates it if it is deleted. Consider setting `deletion_protection = true` so that Terraform does not try to delete it.",
+				recordID,
+			),
+		)
+	}
 
 	diags := resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
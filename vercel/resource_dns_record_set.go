@@ -0,0 +1,680 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &dnsRecordSetResource{}
+	_ resource.ResourceWithConfigure  = &dnsRecordSetResource{}
+	_ resource.ResourceWithModifyPlan = &dnsRecordSetResource{}
+)
+
+func newDNSRecordSetResource() resource.Resource {
+	return &dnsRecordSetResource{}
+}
+
+type dnsRecordSetResource struct {
+	client *client.Client
+}
+
+func (r *dnsRecordSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_record_set"
+}
+
+func (r *dnsRecordSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema returns the schema information for a DNS record set resource.
+func (r *dnsRecordSetResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a DNS Record Set resource, which manages every value of a DNS record with the same name and type.
+
+Some record types can have many values for the same name, such as several ` + "`A`" + ` records to spread traffic across a pool of IP addresses. Modelling these as separate ` + "`vercel_dns_record`" + ` resources works, but every value must be given its own resource. This resource manages them together as a set: values are added and removed individually, while the other values keep resolving, and TTL or comment changes are applied to every record in place.
+
+` + "`CNAME`" + ` records cannot be used, as a name with a ` + "`CNAME`" + ` record can have no other records.
+
+~> Records managed by this resource should not also be managed by a ` + "`vercel_dns_record`" + ` resource.
+
+When ` + "`authoritative`" + ` is enabled, the set becomes the single source of truth for its name and type: any other records with the same name and type, such as ones added in the Vercel dashboard, are detected as drift and deleted, and existing records with a configured value are taken over rather than created again. Records that Vercel creates and manages itself, such as the default records of a domain using Vercel's nameservers and domain verification records, are left in place unless ` + "`include_system_records`" + ` is also enabled. To remove records of every name and type that are not managed by Terraform, use the ` + "`vercel_dns_authoritative_zone`" + ` resource.
+`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The team ID that the domain and DNS records belong to. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"domain": schema.StringAttribute{
+				Description:   "The domain name, or zone, that the DNS records should be created beneath.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:      true,
+			},
+			"name": schema.StringAttribute{
+				Description:   "The subdomain name of the records. This should be an empty string if the records are for the root domain.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:      true,
+			},
+			"type": schema.StringAttribute{
+				Description:   "The type of the DNS records. Available types: `A`, `AAAA`, `CAA`, `NS`, `TXT`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:      true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("A", "AAAA", "CAA", "NS", "TXT"),
+				},
+			},
+			"values": schema.SetAttribute{
+				Description: "The values of the DNS records. A record is created for each value. The format of each value is the same as the `value` of a `vercel_dns_record` of the same type.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"ttl": schema.Int64Attribute{
+				Description:   "The TTL value in seconds for every record. Must be a number between 60 and 2147483647. If unspecified, it will default to 60 seconds.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(2147483647),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Description: "A comment explaining what the DNS records are for. The comment is shown alongside every record in the Vercel dashboard.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(0, 500),
+				},
+			},
+			"authoritative": schema.BoolAttribute{
+				Description: "When `true`, records with the same name and type that are not in `values` are deleted, so that only the configured values exist. Records managed by Vercel itself are left in place, unless `include_system_records` is `true`. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"include_system_records": schema.BoolAttribute{
+				Description: "When `true`, `authoritative` also deletes records that Vercel creates and manages itself, such as domain verification records. Vercel may recreate these records after they are deleted. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"record_ids": schema.MapAttribute{
+				Description: "A map of each value to the ID of its DNS record.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// DNSRecordSet reflects the state terraform stores internally for a DNS record set.
+type DNSRecordSet struct {
+	TeamID               types.String `tfsdk:"team_id"`
+	Domain               types.String `tfsdk:"domain"`
+	Name                 types.String `tfsdk:"name"`
+	Type                 types.String `tfsdk:"type"`
+	Values               types.Set    `tfsdk:"values"`
+	TTL                  types.Int64  `tfsdk:"ttl"`
+	Comment              types.String `tfsdk:"comment"`
+	Authoritative        types.Bool   `tfsdk:"authoritative"`
+	IncludeSystemRecords types.Bool   `tfsdk:"include_system_records"`
+	RecordIDs            types.Map    `tfsdk:"record_ids"`
+}
+
+// dnsRecordSetState tracks the records that are known to exist while changes are applied, so that state can be
+// saved even if only some of the changes succeed.
+type dnsRecordSetState struct {
+	mu  sync.Mutex
+	ids map[string]string
+	ttl int64
+}
+
+func (s *dnsRecordSetState) set(value, id string, ttl int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ids[value] = id
+	s.ttl = ttl
+}
+
+func (s *dnsRecordSetState) remove(value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.ids, value)
+}
+
+func (s *dnsRecordSetState) toModel(ctx context.Context, prior DNSRecordSet, teamID string) (DNSRecordSet, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	values, d := types.SetValueFrom(ctx, types.StringType, sortedKeys(s.ids))
+	diags.Append(d...)
+	ids, d := types.MapValueFrom(ctx, types.StringType, s.ids)
+	diags.Append(d...)
+	// Older states do not have authoritative or include_system_records set, so default them here rather than
+	// planning a change.
+	authoritative := types.BoolValue(prior.Authoritative.ValueBool())
+	includeSystemRecords := types.BoolValue(prior.IncludeSystemRecords.ValueBool())
+	return DNSRecordSet{
+		TeamID:               toTeamID(teamID),
+		Domain:               prior.Domain,
+		Name:                 prior.Name,
+		Type:                 prior.Type,
+		Values:               values,
+		TTL:                  types.Int64Value(s.ttl),
+		Comment:              prior.Comment,
+		Authoritative:        authoritative,
+		IncludeSystemRecords: includeSystemRecords,
+		RecordIDs:            ids,
+	}, diags
+}
+
+func (d DNSRecordSet) state(ctx context.Context) (*dnsRecordSetState, diag.Diagnostics) {
+	s := &dnsRecordSetState{
+		ids: map[string]string{},
+		ttl: d.TTL.ValueInt64(),
+	}
+	var diags diag.Diagnostics
+	if !d.RecordIDs.IsNull() && !d.RecordIDs.IsUnknown() {
+		diags.Append(d.RecordIDs.ElementsAs(ctx, &s.ids, false)...)
+	}
+	return s, diags
+}
+
+func (d DNSRecordSet) values(ctx context.Context) ([]string, diag.Diagnostics) {
+	var values []string
+	diags := d.Values.ElementsAs(ctx, &values, false)
+	return values, diags
+}
+
+// ModifyPlan adds a warning counting the records that are planned to be added, changed and removed, as the diff of
+// a large set of values is hard to read.
+func (r *dnsRecordSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+	var plan, state DNSRecordSet
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Values.IsUnknown() {
+		return
+	}
+
+	values, diags := plan.values(ctx)
+	resp.Diagnostics.Append(diags...)
+	current, diags := state.values(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settingsChanged := !plan.TTL.Equal(state.TTL) || !plan.Comment.Equal(state.Comment)
+	var added, changed, removed int
+	for _, v := range values {
+		switch {
+		case !contains(current, v):
+			added++
+		case settingsChanged:
+			changed++
+		}
+	}
+	for _, v := range current {
+		if !contains(values, v) {
+			removed++
+		}
+	}
+	if added+changed+removed == 0 {
+		return
+	}
+	resp.Diagnostics.AddWarning(
+		"DNS Record Set changes",
+		fmt.Sprintf(
+			"The %s records named %q on %s have %d added, %d changed and %d removed, out of %d configured.",
+			plan.Type.ValueString(),
+			plan.Name.ValueString(),
+			plan.Domain.ValueString(),
+			added,
+			changed,
+			removed,
+			len(values),
+		),
+	)
+}
+
+// createRecords creates a record for each value in parallel, recording the successful ones in s.
+func (r *dnsRecordSetResource) createRecords(ctx context.Context, plan DNSRecordSet, teamID string, values []string, s *dnsRecordSetState, diags *diag.Diagnostics) {
+	errs := runConcurrently(len(values), maxConcurrentRequests, func(i int) error {
+		out, err := r.client.CreateDNSRecord(ctx, teamID, client.CreateDNSRecordRequest{
+			Domain:  plan.Domain.ValueString(),
+			Name:    plan.Name.ValueString(),
+			TTL:     plan.TTL.ValueInt64(),
+			Type:    plan.Type.ValueString(),
+			Value:   values[i],
+			Comment: plan.Comment.ValueString(),
+		})
+		if err != nil {
+			return err
+		}
+		s.set(values[i], out.ID, out.TTL)
+		return nil
+	})
+	for i, err := range errs {
+		if err != nil {
+			diags.AddError(
+				"Error creating DNS Record",
+				fmt.Sprintf("Could not create %s record %s with value %s, unexpected error: %s", plan.Type.ValueString(), plan.Name.ValueString(), values[i], err),
+			)
+		}
+	}
+}
+
+// deleteRecords deletes each record in parallel, removing the successful ones from s.
+func (r *dnsRecordSetResource) deleteRecords(ctx context.Context, domain, teamID string, ids map[string]string, s *dnsRecordSetState, diags *diag.Diagnostics) {
+	// Take a copy of the IDs first, as s may be the map that is being deleted from.
+	values := sortedKeys(ids)
+	recordIDs := make([]string, len(values))
+	for i, v := range values {
+		recordIDs[i] = ids[v]
+	}
+	errs := runConcurrently(len(values), maxConcurrentRequests, func(i int) error {
+		err := r.client.DeleteDNSRecord(ctx, domain, recordIDs[i], teamID)
+		if err != nil && !client.NotFound(err) {
+			return err
+		}
+		s.remove(values[i])
+		return nil
+	})
+	for i, err := range errs {
+		if err != nil {
+			diags.AddError(
+				"Error deleting DNS Record",
+				fmt.Sprintf("Could not delete DNS Record %s with value %s, unexpected error: %s", recordIDs[i], values[i], err),
+			)
+		}
+	}
+}
+
+// updateRecords updates the TTL and comment of each record in parallel, recording the successful ones in s.
+func (r *dnsRecordSetResource) updateRecords(ctx context.Context, plan DNSRecordSet, teamID string, ids map[string]string, s *dnsRecordSetState, diags *diag.Diagnostics) {
+	values := sortedKeys(ids)
+	errs := runConcurrently(len(values), maxConcurrentRequests, func(i int) error {
+		out, err := r.client.UpdateDNSRecord(ctx, teamID, ids[values[i]], client.UpdateDNSRecordRequest{
+			TTL:     plan.TTL.ValueInt64Pointer(),
+			Comment: plan.Comment.ValueString(),
+		})
+		if err != nil {
+			return err
+		}
+		s.set(values[i], out.ID, out.TTL)
+		return nil
+	})
+	for i, err := range errs {
+		if err != nil {
+			diags.AddError(
+				"Error updating DNS Record",
+				fmt.Sprintf("Could not update DNS Record %s with value %s, unexpected error: %s", ids[values[i]], values[i], err),
+			)
+		}
+	}
+}
+
+// unmanagedRecords lists the records with the same name and type as the set that are not tracked in ids. Records
+// managed by Vercel itself are skipped unless include_system_records is set, as they are recreated whenever they
+// are deleted.
+func (r *dnsRecordSetResource) unmanagedRecords(ctx context.Context, d DNSRecordSet, teamID string, ids map[string]string) ([]client.DNSRecord, error) {
+	records, err := r.client.ListDNSRecords(ctx, d.Domain.ValueString(), teamID)
+	if err != nil {
+		return nil, err
+	}
+	managed := map[string]struct{}{}
+	for _, id := range ids {
+		managed[id] = struct{}{}
+	}
+	var unmanaged []client.DNSRecord
+	for _, record := range records {
+		if record.Name != d.Name.ValueString() || record.RecordType != d.Type.ValueString() {
+			continue
+		}
+		if isSystemDNSRecord(record) && !d.IncludeSystemRecords.ValueBool() {
+			continue
+		}
+		if _, ok := managed[record.ID]; ok {
+			continue
+		}
+		unmanaged = append(unmanaged, record)
+	}
+	return unmanaged, nil
+}
+
+// unmanagedRecordKey returns the key to track an unmanaged record by in ids. This is its value, unless a record
+// with the same value is already tracked, in which case the record ID is added to tell them apart.
+func unmanagedRecordKey(ids map[string]string, record client.DNSRecord) string {
+	if _, ok := ids[record.Value]; ok {
+		return fmt.Sprintf("%s (%s)", record.Value, record.ID)
+	}
+	return record.Value
+}
+
+// partitionUnmanagedRecords works out what to do with the unmanaged records of an authoritative set. Records with a
+// value in values are taken over, and the rest are deleted. The values without a record to take over still need
+// to be created.
+func partitionUnmanagedRecords(recordType string, values []string, unmanaged []client.DNSRecord) (toCreate []string, toAdopt, toDelete map[string]string) {
+	toAdopt = map[string]string{}
+	toDelete = map[string]string{}
+	adopted := map[string]struct{}{}
+	for _, v := range values {
+		found := false
+		for _, record := range unmanaged {
+			if _, ok := adopted[record.ID]; ok {
+				continue
+			}
+			if dnsValuesEquivalent(recordType, v, record.Value) {
+				toAdopt[v] = record.ID
+				adopted[record.ID] = struct{}{}
+				found = true
+				break
+			}
+		}
+		if !found {
+			toCreate = append(toCreate, v)
+		}
+	}
+	for _, record := range unmanaged {
+		if _, ok := adopted[record.ID]; !ok {
+			toDelete[unmanagedRecordKey(toDelete, record)] = record.ID
+		}
+	}
+	return toCreate, toAdopt, toDelete
+}
+
+// Create will create a DNS record for every value within Vercel.
+func (r *dnsRecordSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DNSRecordSet
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values, diags := plan.values(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID := r.client.TeamID(plan.TeamID.ValueString())
+	s := &dnsRecordSetState{ids: map[string]string{}, ttl: plan.TTL.ValueInt64()}
+	toCreate := values
+	toAdopt := map[string]string{}
+	toDelete := map[string]string{}
+	if plan.Authoritative.ValueBool() {
+		existing, err := r.unmanagedRecords(ctx, plan, teamID, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating DNS Record Set",
+				fmt.Sprintf("Could not list the existing DNS Records of %s, unexpected error: %s", plan.Domain.ValueString(), err),
+			)
+			return
+		}
+		toCreate, toAdopt, toDelete = partitionUnmanagedRecords(plan.Type.ValueString(), values, existing)
+	}
+
+	r.createRecords(ctx, plan, teamID, toCreate, s, &resp.Diagnostics)
+	r.updateRecords(ctx, plan, teamID, toAdopt, s, &resp.Diagnostics)
+	if len(toDelete) > 0 && !resp.Diagnostics.HasError() {
+		// The deleted records are not part of the set, so they are tracked separately.
+		deleted := &dnsRecordSetState{ids: map[string]string{}}
+		r.deleteRecords(ctx, plan.Domain.ValueString(), teamID, toDelete, deleted, &resp.Diagnostics)
+	}
+
+	result, diags := s.toModel(ctx, plan, teamID)
+	resp.Diagnostics.Append(diags...)
+	tflog.Info(ctx, "created DNS record set", map[string]any{
+		"team_id": teamID,
+		"domain":  plan.Domain.ValueString(),
+		"records": len(s.ids),
+		"adopted": len(toAdopt),
+		"deleted": len(toDelete),
+	})
+
+	// Save whatever was created, even on a partial failure, so that it is not orphaned.
+	if len(s.ids) == 0 && resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read will read each DNS record from the Vercel API, and update terraform with this information.
+func (r *dnsRecordSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DNSRecordSet
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := state.state(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID := r.client.TeamID(state.TeamID.ValueString())
+	values := sortedKeys(current.ids)
+	recordIDs := make([]string, len(values))
+	for i, v := range values {
+		recordIDs[i] = current.ids[v]
+	}
+	errs := runConcurrently(len(values), maxConcurrentRequests, func(i int) error {
+		out, err := r.client.GetDNSRecord(ctx, recordIDs[i], teamID)
+		if client.NotFound(err) {
+			current.remove(values[i])
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !dnsValuesEquivalent(state.Type.ValueString(), values[i], out.Value) {
+			// The value was changed outside of Terraform.
+			current.remove(values[i])
+			current.set(out.Value, out.ID, out.TTL)
+			return nil
+		}
+		current.set(values[i], out.ID, out.TTL)
+		return nil
+	})
+	for i, err := range errs {
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading DNS Record",
+				fmt.Sprintf("Could not read DNS Record %s with value %s, unexpected error: %s", recordIDs[i], values[i], err),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(current.ids) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Any other records with the same name and type are drift, and are deleted by the next apply.
+	if state.Authoritative.ValueBool() {
+		unmanaged, err := r.unmanagedRecords(ctx, state, teamID, current.ids)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading DNS Record Set",
+				fmt.Sprintf("Could not list the DNS Records of %s, unexpected error: %s", state.Domain.ValueString(), err),
+			)
+			return
+		}
+		for _, record := range unmanaged {
+			current.set(unmanagedRecordKey(current.ids, record), record.ID, current.ttl)
+		}
+	}
+
+	result, diags := current.toModel(ctx, state, teamID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "read DNS record set", map[string]any{
+		"team_id": teamID,
+		"domain":  state.Domain.ValueString(),
+		"records": len(current.ids),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update creates records for added values and deletes the records of removed values. The TTL and comment of the
+// records that are kept are updated in place.
+func (r *dnsRecordSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DNSRecordSet
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state DNSRecordSet
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values, diags := plan.values(ctx)
+	resp.Diagnostics.Append(diags...)
+	current, d := state.state(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID := r.client.TeamID(plan.TeamID.ValueString())
+	planned := map[string]struct{}{}
+	var toCreate []string
+	for _, v := range values {
+		planned[v] = struct{}{}
+		if _, ok := current.ids[v]; !ok {
+			toCreate = append(toCreate, v)
+		}
+	}
+	toDelete := map[string]string{}
+	toUpdate := map[string]string{}
+	for v, id := range current.ids {
+		if _, ok := planned[v]; !ok {
+			toDelete[v] = id
+		} else if !plan.TTL.Equal(state.TTL) || !plan.Comment.Equal(state.Comment) {
+			toUpdate[v] = id
+		}
+	}
+
+	// Records added outside of Terraform are normally found by Read, but also look for them here in case
+	// authoritative has just been enabled, or the state was not refreshed.
+	if plan.Authoritative.ValueBool() {
+		unmanaged, err := r.unmanagedRecords(ctx, plan, teamID, current.ids)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating DNS Record Set",
+				fmt.Sprintf("Could not list the DNS Records of %s, unexpected error: %s", plan.Domain.ValueString(), err),
+			)
+			return
+		}
+		var toAdopt, unmanagedToDelete map[string]string
+		toCreate, toAdopt, unmanagedToDelete = partitionUnmanagedRecords(plan.Type.ValueString(), toCreate, unmanaged)
+		for v, id := range toAdopt {
+			toUpdate[v] = id
+		}
+		for v, id := range unmanagedToDelete {
+			toDelete[unmanagedRecordKey(toDelete, client.DNSRecord{Value: v, ID: id})] = id
+		}
+	}
+
+	// Add the new values before removing the old ones, so that the name always has a value to resolve to.
+	r.createRecords(ctx, plan, teamID, toCreate, current, &resp.Diagnostics)
+
+	r.updateRecords(ctx, plan, teamID, toUpdate, current, &resp.Diagnostics)
+
+	if !resp.Diagnostics.HasError() {
+		r.deleteRecords(ctx, plan.Domain.ValueString(), teamID, toDelete, current, &resp.Diagnostics)
+	}
+
+	result, diags := current.toModel(ctx, plan, teamID)
+	resp.Diagnostics.Append(diags...)
+	tflog.Info(ctx, "updated DNS record set", map[string]any{
+		"team_id": teamID,
+		"domain":  plan.Domain.ValueString(),
+		"created": len(toCreate),
+		"updated": len(toUpdate),
+		"deleted": len(toDelete),
+	})
+
+	// Save the records that exist, even on a partial failure.
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes every record in the set.
+func (r *dnsRecordSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DNSRecordSet
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := state.state(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID := r.client.TeamID(state.TeamID.ValueString())
+	r.deleteRecords(ctx, state.Domain.ValueString(), teamID, current.ids, current, &resp.Diagnostics)
+	tflog.Info(ctx, "deleted DNS record set", map[string]any{
+		"team_id": teamID,
+		"domain":  state.Domain.ValueString(),
+	})
+}
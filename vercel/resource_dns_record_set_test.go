@@ -0,0 +1,222 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+func TestAcc_DNSRecordSet(t *testing.T) {
+	t.Skip("Skipping until i have a domain in a suitable location to test with")
+	nameSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             noopDestroyCheck,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccDNSRecordSetConfig(testDomain(t), nameSuffix, `["127.0.0.1", "127.0.0.2"]`, 120)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_dns_record_set.test", "values.#", "2"),
+					resource.TestCheckTypeSetElemAttr("vercel_dns_record_set.test", "values.*", "127.0.0.1"),
+					resource.TestCheckTypeSetElemAttr("vercel_dns_record_set.test", "values.*", "127.0.0.2"),
+					resource.TestCheckResourceAttr("vercel_dns_record_set.test", "record_ids.%", "2"),
+					resource.TestCheckResourceAttr("vercel_dns_record_set.test", "ttl", "120"),
+				),
+			},
+			{
+				Config: cfg(testAccDNSRecordSetConfig(testDomain(t), nameSuffix, `["127.0.0.2", "127.0.0.3"]`, 60)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("vercel_dns_record_set.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_dns_record_set.test", "values.#", "2"),
+					resource.TestCheckTypeSetElemAttr("vercel_dns_record_set.test", "values.*", "127.0.0.2"),
+					resource.TestCheckTypeSetElemAttr("vercel_dns_record_set.test", "values.*", "127.0.0.3"),
+					resource.TestCheckResourceAttrSet("vercel_dns_record_set.test", "record_ids.127.0.0.2"),
+					resource.TestCheckResourceAttr("vercel_dns_record_set.test", "ttl", "60"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_DNSRecordSetAuthoritative(t *testing.T) {
+	t.Skip("Skipping until i have a domain in a suitable location to test with")
+	nameSuffix := acctest.RandString(16)
+	name := fmt.Sprintf("test-acc-%s-set", nameSuffix)
+	createRecord := func(value string) {
+		_, err := testClient(t).CreateDNSRecord(context.TODO(), testTeam(t), client.CreateDNSRecordRequest{
+			Domain: testDomain(t),
+			Name:   name,
+			Type:   "A",
+			TTL:    60,
+			Value:  value,
+		})
+		if err != nil {
+			t.Fatalf("could not create DNS record: %s", err)
+		}
+	}
+	testRecordValues := func(expected ...string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			records, err := testClient(t).ListDNSRecords(context.TODO(), testDomain(t), testTeam(t))
+			if err != nil {
+				return err
+			}
+			var values []string
+			for _, r := range records {
+				if r.Name == name && r.RecordType == "A" {
+					values = append(values, r.Value)
+				}
+			}
+			sort.Strings(values)
+			if fmt.Sprint(values) != fmt.Sprint(expected) {
+				return fmt.Errorf("expected records %v, got %v", expected, values)
+			}
+			return nil
+		}
+	}
+	config := cfg(fmt.Sprintf(`
+resource "vercel_dns_record_set" "test" {
+  domain        = "%s"
+  name          = "%s"
+  type          = "A"
+  values        = ["127.0.0.1", "127.0.0.2"]
+  authoritative = true
+}
+`, testDomain(t), name))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             noopDestroyCheck,
+		Steps: []resource.TestStep{
+			{
+				// An existing record with a configured value is taken over, and the other one is deleted.
+				PreConfig: func() {
+					createRecord("127.0.0.1")
+					createRecord("127.0.0.9")
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_dns_record_set.test", "values.#", "2"),
+					resource.TestCheckResourceAttr("vercel_dns_record_set.test", "record_ids.%", "2"),
+					resource.TestCheckResourceAttr("vercel_dns_record_set.test", "authoritative", "true"),
+					testRecordValues("127.0.0.1", "127.0.0.2"),
+				),
+			},
+			{
+				// A record added outside of Terraform is detected as drift and deleted.
+				PreConfig: func() {
+					createRecord("127.0.0.8")
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("vercel_dns_record_set.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testRecordValues("127.0.0.1", "127.0.0.2"),
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAcc_DNSRecordSetAuthoritativeSystemRecords(t *testing.T) {
+	t.Skip("Skipping until i have a domain in a suitable location to test with")
+	nameSuffix := acctest.RandString(16)
+	verification := "vc-domain-verify=test-acc." + nameSuffix
+	testVerificationRecord := func(exists bool) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			records, err := testClient(t).ListDNSRecords(context.TODO(), testDomain(t), testTeam(t))
+			if err != nil {
+				return err
+			}
+			found := false
+			for _, r := range records {
+				if r.Name == "_vercel" && r.Value == verification {
+					found = true
+				}
+			}
+			if found != exists {
+				return fmt.Errorf("expected verification record to exist: %t, but it exists: %t", exists, found)
+			}
+			return nil
+		}
+	}
+	config := func(includeSystemRecords bool) string {
+		return cfg(fmt.Sprintf(`
+resource "vercel_dns_record_set" "test" {
+  domain                 = "%s"
+  name                   = "_vercel"
+  type                   = "TXT"
+  values                 = ["test-acc-%s"]
+  authoritative          = true
+  include_system_records = %t
+}
+`, testDomain(t), nameSuffix, includeSystemRecords))
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             noopDestroyCheck,
+		Steps: []resource.TestStep{
+			{
+				// Domain verification records are left in place by default.
+				PreConfig: func() {
+					_, err := testClient(t).CreateDNSRecord(context.TODO(), testTeam(t), client.CreateDNSRecordRequest{
+						Domain: testDomain(t),
+						Name:   "_vercel",
+						Type:   "TXT",
+						TTL:    60,
+						Value:  verification,
+					})
+					if err != nil {
+						t.Fatalf("could not create DNS record: %s", err)
+					}
+				},
+				Config: config(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_dns_record_set.test", "record_ids.%", "1"),
+					testVerificationRecord(true),
+				),
+			},
+			{
+				Config: config(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_dns_record_set.test", "record_ids.%", "1"),
+					resource.TestCheckResourceAttr("vercel_dns_record_set.test", "include_system_records", "true"),
+					testVerificationRecord(false),
+				),
+			},
+		},
+	})
+}
+
+func testAccDNSRecordSetConfig(testDomain, nameSuffix, values string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vercel_dns_record_set" "test" {
+  domain  = "%[1]s"
+  name    = "test-acc-%[2]s-set"
+  type    = "A"
+  ttl     = %[4]d
+  values  = %[3]s
+  comment = "record set"
+}
+`, testDomain, nameSuffix, values, ttl)
+}
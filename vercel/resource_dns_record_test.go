@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/vercel/terraform-provider-vercel/v3/client"
 )
@@ -143,10 +144,19 @@ func TestAcc_DNSRecord(t *testing.T) {
 					resource.TestCheckResourceAttr("vercel_dns_record.ns", "ttl", "120"),
 					resource.TestCheckResourceAttr("vercel_dns_record.ns", "value", "example.com."),
 					resource.TestCheckResourceAttr("vercel_dns_record.ns", "comment", "ns"),
+					resource.TestCheckResourceAttr("vercel_dns_record.cname_mixed_case", "value", "Example.COM"),
+					resource.TestCheckResourceAttr("vercel_dns_record.txt_quoted", "value", "\"terraform\" \" testing\""),
 				),
 			},
 			{
 				Config: cfg(testAccDNSRecordConfigUpdated(testDomain(t), nameSuffix)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					// Value and TTL changes must not recreate the record, which would briefly stop it resolving.
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("vercel_dns_record.a", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("vercel_dns_record.aaaa", plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccDNSRecordExists(testClient(t), "vercel_dns_record.a_without_ttl", testTeam(t)),
 					resource.TestCheckResourceAttr("vercel_dns_record.a_without_ttl", "domain", testDomain(t)),
@@ -299,6 +309,20 @@ resource "vercel_dns_record" "txt" {
   value = "terraform testing"
   comment = "txt"
 }
+resource "vercel_dns_record" "cname_mixed_case" {
+  domain = "%[1]s"
+  name  = "test-acc-%[2]s-cname-mixed-case"
+  type  = "CNAME"
+  value = "Example.COM"
+  comment = "cname with a value that the API normalizes"
+}
+resource "vercel_dns_record" "txt_quoted" {
+  domain = "%[1]s"
+  name = "test-acc-%[2]s-txt-quoted"
+  type = "TXT"
+  value = "\"terraform\" \" testing\""
+  comment = "txt in zone file format"
+}
 resource "vercel_dns_record" "ns" {
   domain = "%[1]s"
   name = "test-acc-%[2]s-ns"
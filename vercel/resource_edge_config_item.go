@@ -2,12 +2,18 @@ package vercel
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"regexp"
+	"sync"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/vercel/terraform-provider-vercel/v3/client"
@@ -15,8 +21,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &edgeConfigItemResource{}
-	_ resource.ResourceWithConfigure = &edgeConfigItemResource{}
+	_ resource.Resource               = &edgeConfigItemResource{}
+	_ resource.ResourceWithConfigure  = &edgeConfigItemResource{}
+	_ resource.ResourceWithModifyPlan = &edgeConfigItemResource{}
 )
 
 func newEdgeConfigItemResource() resource.Resource {
@@ -72,14 +79,24 @@ An Edge Config Item is a value within an Edge Config.
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
 			},
 			"key": schema.StringAttribute{
-				Description:   "The name of the key you want to add to or update within your Edge Config.",
+				Description:   "The name of the key you want to add to or update within your Edge Config. Keys may be up to 256 characters long, and may only contain alphanumeric characters, underscores and hyphens.",
 				Required:      true,
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[\w-]+$`),
+						"Edge Config Item keys may only contain alphanumeric characters, underscores and hyphens",
+					),
+				},
 			},
 			"value": schema.StringAttribute{
-				Description:   "The value you want to assign to the key.",
+				Description:   "The value you want to assign to the key. The total size of all items within an Edge Config is limited to 8KB on the Hobby plan, 64KB on the Pro plan and 512KB on the Enterprise plan. This is checked when planning, including any other items planned for the same Edge Config.",
 				Required:      true,
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					validateEdgeConfigItemValue(),
+				},
 			},
 		},
 	}
@@ -101,6 +118,128 @@ func responseToEdgeConfigItem(out client.EdgeConfigItem) EdgeConfigItem {
 	}
 }
 
+// plannedEdgeConfigItems holds the size of every Edge Config Item planned by this provider process, by Edge Config
+// ID and then key, so that the size check can include the other items in the same plan. Terraform plans each
+// resource separately, so this is the only place they can be seen together. A size of zero means the item is being
+// removed. Removed items are only accounted for once their own plan has been made.
+var plannedEdgeConfigItems = struct {
+	sync.Mutex
+	sizes map[string]map[string]int
+}{sizes: map[string]map[string]int{}}
+
+// planEdgeConfigItem records the size of a planned Edge Config Item, and returns the sizes of all of the planned
+// items in the same Edge Config.
+func planEdgeConfigItem(edgeConfigID, key string, size int) map[string]int {
+	plannedEdgeConfigItems.Lock()
+	defer plannedEdgeConfigItems.Unlock()
+	if plannedEdgeConfigItems.sizes[edgeConfigID] == nil {
+		plannedEdgeConfigItems.sizes[edgeConfigID] = map[string]int{}
+	}
+	plannedEdgeConfigItems.sizes[edgeConfigID][key] = size
+	planned := make(map[string]int, len(plannedEdgeConfigItems.sizes[edgeConfigID]))
+	for k, v := range plannedEdgeConfigItems.sizes[edgeConfigID] {
+		planned[k] = v
+	}
+	return planned
+}
+
+// edgeConfigSizeLimit returns the maximum size of an Edge Config for the plan the team is on. If the plan cannot be
+// determined, the largest limit is used, so that a valid configuration is never rejected.
+func edgeConfigSizeLimit(ctx context.Context, c *client.Client, teamID string) int {
+	teamID = c.TeamID(teamID)
+	plan := "hobby"
+	if teamID != "" {
+		team, err := c.GetTeam(ctx, teamID)
+		if err != nil {
+			tflog.Warn(ctx, "unable to read team plan for edge config size limit", map[string]any{
+				"team_id": teamID,
+				"error":   err.Error(),
+			})
+			return edgeConfigMaxSizeBytes
+		}
+		plan = teamPlan(team)
+	}
+	limits, ok := publishedPlanLimits[plan]
+	if !ok {
+		return edgeConfigMaxSizeBytes
+	}
+	return limits.edgeConfigMaxSize
+}
+
+// ModifyPlan checks that the planned items will not take the Edge Config over the maximum size for the team's plan.
+// This surfaces the problem at plan time, rather than as a generic API error part way through an apply.
+func (r *edgeConfigItemResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	var state *EdgeConfigItem
+	if !req.State.Raw.IsNull() {
+		diags := req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if req.Plan.Raw.IsNull() {
+		if state != nil {
+			planEdgeConfigItem(state.EdgeConfigID.ValueString(), state.Key.ValueString(), 0)
+		}
+		return
+	}
+	var plan EdgeConfigItem
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.EdgeConfigID.IsUnknown() || plan.Key.IsUnknown() || plan.Value.IsUnknown() {
+		return
+	}
+	if state != nil && (state.EdgeConfigID != plan.EdgeConfigID || state.Key != plan.Key) {
+		planEdgeConfigItem(state.EdgeConfigID.ValueString(), state.Key.ValueString(), 0)
+	}
+
+	value, _ := json.Marshal(plan.Value.ValueString())
+	planned := planEdgeConfigItem(plan.EdgeConfigID.ValueString(), plan.Key.ValueString(), edgeConfigItemSize(plan.Key.ValueString(), value))
+
+	items, err := r.client.ListEdgeConfigItems(ctx, plan.EdgeConfigID.ValueString(), plan.TeamID.ValueString())
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		tflog.Warn(ctx, "unable to check edge config size", map[string]any{
+			"edge_config_id": plan.EdgeConfigID.ValueString(),
+			"error":          err.Error(),
+		})
+		return
+	}
+
+	size := 2
+	for _, item := range items {
+		if _, ok := planned[item.Key]; ok {
+			continue
+		}
+		size += edgeConfigItemSize(item.Key, item.Value)
+	}
+	for _, s := range planned {
+		size += s
+	}
+	limit := edgeConfigSizeLimit(ctx, r.client, plan.TeamID.ValueString())
+	if size > limit {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("value"),
+			"Edge Config size limit exceeded",
+			fmt.Sprintf(
+				"Setting %s would make Edge Config %s %d bytes in size, including the other items planned for it, which exceeds the maximum Edge Config size of %d bytes for the team's plan.",
+				plan.Key.ValueString(),
+				plan.EdgeConfigID.ValueString(),
+				size,
+				limit,
+			),
+		)
+	}
+}
+
 // Create will create an edgeConfigToken within Vercel.
 // This is called automatically by the provider when a new resource should be created.
 func (r *edgeConfigItemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
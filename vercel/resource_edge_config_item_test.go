@@ -3,6 +3,8 @@ package vercel_test
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -104,3 +106,71 @@ resource "vercel_edge_config" "test_item" {
 }
 `, name)
 }
+
+func TestAcc_EdgeConfigItemResourceValidation(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      cfg(testAccResourceEdgeConfigItemWithKeyAndValue(name, "not a valid key", "baz")),
+				ExpectError: regexp.MustCompile("may only contain alphanumeric characters"),
+			},
+			{
+				Config:      cfg(testAccResourceEdgeConfigItemWithKeyAndValue(name, "foobar", strings.Repeat("a", 600*1024))),
+				ExpectError: regexp.MustCompile("exceeds the maximum Edge Config size"),
+			},
+		},
+	})
+}
+
+func TestAcc_EdgeConfigItemResourceCombinedSize(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccResourceEdgeConfigItemDeleted(name)),
+			},
+			{
+				// Each item is within the limit for every plan, but together they are not.
+				Config:      cfg(testAccResourceEdgeConfigItemsWithSize(name, 300*1024)),
+				ExpectError: regexp.MustCompile("Edge Config size limit exceeded"),
+			},
+		},
+	})
+}
+
+func testAccResourceEdgeConfigItemsWithSize(name string, size int) string {
+	return fmt.Sprintf(`
+resource "vercel_edge_config" "test_item" {
+    name         = "%[1]s"
+}
+
+resource "vercel_edge_config_item" "first" {
+    edge_config_id = vercel_edge_config.test_item.id
+    key = "first"
+    value = "%[2]s"
+}
+
+resource "vercel_edge_config_item" "second" {
+    edge_config_id = vercel_edge_config.test_item.id
+    key = "second"
+    value = "%[2]s"
+}
+`, name, strings.Repeat("a", size))
+}
+
+func testAccResourceEdgeConfigItemWithKeyAndValue(name, key, value string) string {
+	return fmt.Sprintf(`
+resource "vercel_edge_config" "test_item" {
+    name         = "%[1]s"
+}
+
+resource "vercel_edge_config_item" "test" {
+    edge_config_id = vercel_edge_config.test_item.id
+    key = "%[2]s"
+    value = "%[3]s"
+}
+`, name, key, value)
+}
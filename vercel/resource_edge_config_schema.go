@@ -65,7 +65,8 @@ An Edge Config Schema provides an existing Edge Config with a JSON schema. Use s
 			},
 			"definition": schema.StringAttribute{
 				Required:    true,
-				Description: "A JSON schema that will be used to validate data in the Edge Config.",
+				CustomType:  jsonStringType{},
+				Description: "A JSON schema that will be used to validate data in the Edge Config. Differences in whitespace or key order are ignored.",
 				Validators:  []validator.String{validateJSON()},
 			},
 			"team_id": schema.StringAttribute{
@@ -79,9 +80,9 @@ An Edge Config Schema provides an existing Edge Config with a JSON schema. Use s
 }
 
 type EdgeConfigSchema struct {
-	ID         types.String `tfsdk:"id"`
-	Definition types.String `tfsdk:"definition"`
-	TeamID     types.String `tfsdk:"team_id"`
+	ID         types.String    `tfsdk:"id"`
+	Definition jsonStringValue `tfsdk:"definition"`
+	TeamID     types.String    `tfsdk:"team_id"`
 }
 
 func (e EdgeConfigSchema) JSONDefinition() (i any, err error) {
@@ -89,7 +90,7 @@ func (e EdgeConfigSchema) JSONDefinition() (i any, err error) {
 	return i, err
 }
 
-func responseToEdgeConfigSchema(out client.EdgeConfigSchema, def types.String) EdgeConfigSchema {
+func responseToEdgeConfigSchema(out client.EdgeConfigSchema, def jsonStringValue) EdgeConfigSchema {
 	return EdgeConfigSchema{
 		ID:         types.StringValue(out.ID),
 		Definition: def,
@@ -169,7 +170,19 @@ func (r *edgeConfigSchemaResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	result := responseToEdgeConfigSchema(out, state.Definition)
+	// The definition is compared semantically, so formatting differences from the configured value don't
+	// produce a diff, but changes made outside of Terraform are still detected.
+	def, err := json.Marshal(out.Definition)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Edge Config Schema",
+			fmt.Sprintf("Could not marshal Edge Config Schema %s %s, unexpected error: %s",
+				state.TeamID.ValueString(), state.ID.ValueString(), err,
+			),
+		)
+		return
+	}
+	result := responseToEdgeConfigSchema(out, newJSONStringValue(string(def)))
 	tflog.Info(ctx, "read edge config schema", map[string]any{
 		"team_id":        result.TeamID.ValueString(),
 		"edge_config_id": result.ID.ValueString(),
@@ -293,7 +306,7 @@ func (r *edgeConfigSchemaResource) ImportState(ctx context.Context, req resource
 		)
 		return
 	}
-	result := responseToEdgeConfigSchema(out, types.StringValue(string(def)))
+	result := responseToEdgeConfigSchema(out, newJSONStringValue(string(def)))
 	tflog.Info(ctx, "import edge config schema", map[string]any{
 		"team_id":        result.TeamID.ValueString(),
 		"edge_config_id": result.ID.ValueString(),
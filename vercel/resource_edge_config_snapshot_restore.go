@@ -0,0 +1,236 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+var (
+	_ resource.Resource              = &edgeConfigSnapshotRestoreResource{}
+	_ resource.ResourceWithConfigure = &edgeConfigSnapshotRestoreResource{}
+)
+
+func newEdgeConfigSnapshotRestoreResource() resource.Resource {
+	return &edgeConfigSnapshotRestoreResource{}
+}
+
+type edgeConfigSnapshotRestoreResource struct {
+	client *client.Client
+}
+
+func (r *edgeConfigSnapshotRestoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_edge_config_snapshot_restore"
+}
+
+func (r *edgeConfigSnapshotRestoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *edgeConfigSnapshotRestoreResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Rolls an Edge Config back to a snapshot taken with the ` + "`vercel_edge_config_snapshot`" + ` data source.
+
+When this resource is created, the items within the Edge Config are replaced with the items in the snapshot in a single change. Items that are not in the snapshot are removed. Changing ` + "`items`" + ` restores the Edge Config again.
+
+The restore runs once. Destroying this resource does not undo it, and later changes to the Edge Config are not reverted.
+
+~> The data source is read again on every plan, so its ` + "`items`" + ` always reflect the current state of the Edge Config. Keep the snapshot somewhere that does not change, such as a ` + "`terraform_data`" + ` resource that ignores changes to its input, and restore from there. Items that are also managed by ` + "`vercel_edge_config_item`" + ` resources will show as changed on the next plan if the snapshot has a different value for them.
+`,
+		Attributes: map[string]schema.Attribute{
+			"edge_config_id": schema.StringAttribute{
+				Description:   "The ID of the Edge Config to restore.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the team the Edge Config exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"items": schema.MapAttribute{
+				Description:   "A map of every key the Edge Config should contain to its JSON encoded value, as returned by the `items` of the `vercel_edge_config_snapshot` data source.",
+				Required:      true,
+				ElementType:   types.StringType,
+				PlanModifiers: []planmodifier.Map{mapplanmodifier.RequiresReplace()},
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(validateJSON()),
+				},
+			},
+			"digest": schema.StringAttribute{
+				Description:   "The digest of the Edge Config once it was restored.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+type EdgeConfigSnapshotRestore struct {
+	EdgeConfigID types.String `tfsdk:"edge_config_id"`
+	TeamID       types.String `tfsdk:"team_id"`
+	Items        types.Map    `tfsdk:"items"`
+	Digest       types.String `tfsdk:"digest"`
+}
+
+// edgeConfigRestoreOperations returns the changes needed to turn the existing items within an Edge Config into the
+// items in a snapshot. Items that already have the snapshot value are left alone.
+func edgeConfigRestoreOperations(existing []client.RawEdgeConfigItem, snapshot map[string]string) []client.RawEdgeConfigOperation {
+	current := map[string]string{}
+	for _, item := range existing {
+		current[item.Key] = string(item.Value)
+	}
+
+	var operations []client.RawEdgeConfigOperation
+	for _, key := range sortedKeys(snapshot) {
+		if v, ok := current[key]; ok && sameJSON(v, snapshot[key]) {
+			continue
+		}
+		operations = append(operations, client.RawEdgeConfigOperation{
+			Operation: "upsert",
+			Key:       key,
+			Value:     json.RawMessage(snapshot[key]),
+		})
+	}
+	for _, key := range sortedKeys(current) {
+		if _, ok := snapshot[key]; !ok {
+			operations = append(operations, client.RawEdgeConfigOperation{
+				Operation: "delete",
+				Key:       key,
+			})
+		}
+	}
+	return operations
+}
+
+// sameJSON returns whether two JSON documents decode to the same value.
+func sameJSON(a, b string) bool {
+	var x, y any
+	if json.Unmarshal([]byte(a), &x) != nil || json.Unmarshal([]byte(b), &y) != nil {
+		return false
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+// Create restores the Edge Config to the snapshot.
+func (r *edgeConfigSnapshotRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan EdgeConfigSnapshotRestore
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var snapshot map[string]string
+	diags = plan.Items.ElementsAs(ctx, &snapshot, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := r.client.ListEdgeConfigItems(ctx, plan.EdgeConfigID.ValueString(), plan.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error restoring Edge Config",
+			"Could not list Edge Config Items, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	operations := edgeConfigRestoreOperations(existing, snapshot)
+	if len(operations) > 0 {
+		err = r.client.UpdateEdgeConfigItems(ctx, plan.EdgeConfigID.ValueString(), plan.TeamID.ValueString(), operations)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error restoring Edge Config",
+				"Could not update Edge Config Items, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	ec, err := r.client.GetEdgeConfig(ctx, plan.EdgeConfigID.ValueString(), plan.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error restoring Edge Config",
+			"Could not read Edge Config after restoring it, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	result := EdgeConfigSnapshotRestore{
+		EdgeConfigID: types.StringValue(ec.ID),
+		TeamID:       toTeamID(ec.TeamID),
+		Items:        plan.Items,
+		Digest:       types.StringValue(ec.Digest),
+	}
+	tflog.Info(ctx, "restored edge config snapshot", map[string]any{
+		"edge_config_id": result.EdgeConfigID.ValueString(),
+		"team_id":        result.TeamID.ValueString(),
+		"operations":     len(operations),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read does not refresh anything, as the restore only happens once. Later changes to the Edge Config are not
+// treated as drift.
+func (r *edgeConfigSnapshotRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state EdgeConfigSnapshotRestore
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *edgeConfigSnapshotRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Edge Config restore should always be recreated", "Something incorrectly caused an Update, this should always be recreated instead of updated.")
+}
+
+// Delete only removes the restore from the terraform state. The restore is not undone.
+func (r *edgeConfigSnapshotRestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state EdgeConfigSnapshotRestore
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "removed edge config snapshot restore from state", map[string]any{
+		"edge_config_id": state.EdgeConfigID.ValueString(),
+		"team_id":        state.TeamID.ValueString(),
+	})
+}
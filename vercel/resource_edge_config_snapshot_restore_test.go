@@ -0,0 +1,58 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_EdgeConfigSnapshotRestore(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccEdgeConfigSnapshotRestoreConfig(name, `{
+    foo = jsonencode("bar")
+    baz = jsonencode({ enabled = true })
+  }`)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("vercel_edge_config_snapshot_restore.test", "digest"),
+					resource.TestCheckResourceAttr("data.vercel_edge_config_snapshot.test", "items.%", "2"),
+					resource.TestCheckResourceAttr("data.vercel_edge_config_snapshot.test", "items.foo", `"bar"`),
+					resource.TestCheckResourceAttr("data.vercel_edge_config_snapshot.test", "items.baz", `{"enabled":true}`),
+				),
+			},
+			{
+				Config: cfg(testAccEdgeConfigSnapshotRestoreConfig(name, `{
+    foo = jsonencode("restored")
+  }`)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_edge_config_snapshot.test", "items.%", "1"),
+					resource.TestCheckResourceAttr("data.vercel_edge_config_snapshot.test", "items.foo", `"restored"`),
+					resource.TestCheckNoResourceAttr("data.vercel_edge_config_snapshot.test", "items.baz"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEdgeConfigSnapshotRestoreConfig(name, items string) string {
+	return fmt.Sprintf(`
+resource "vercel_edge_config" "test" {
+  name = "%[1]s"
+}
+
+resource "vercel_edge_config_snapshot_restore" "test" {
+  edge_config_id = vercel_edge_config.test.id
+  items          = %[2]s
+}
+
+data "vercel_edge_config_snapshot" "test" {
+  id         = vercel_edge_config.test.id
+  depends_on = [vercel_edge_config_snapshot_restore.test]
+}
+`, name, items)
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/vercel/terraform-provider-vercel/v3/client"
@@ -56,7 +58,9 @@ func (r *firewallBypassResource) Schema(_ context.Context, req resource.SchemaRe
 		Description: `
 Provides a Firewall Bypass Rule
 
-Firewall Bypass Rules configure sets of domains and ip address to prevent bypass Vercel's system mitigations for.  The hosts used in a bypass rule must be a production domain assigned to the associated project.  Requests that bypass system mitigations will incur usage.`,
+Firewall Bypass Rules configure sets of domains and ip address to prevent bypass Vercel's system mitigations for.  The hosts used in a bypass rule must be a production domain assigned to the associated project.  Requests that bypass system mitigations will incur usage.
+
+Bypass rules can be made temporary by setting a ` + "`ttl`" + `. Once a temporary rule expires, Vercel removes it. The rule is kept in state and is not created again, and every plan warns that it has expired until it is removed from the configuration. To create it again, change its ` + "`ttl`" + ` or run ` + "`terraform apply -replace`" + `.`,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description:   "The identifier for the firewall bypass rule.",
@@ -84,6 +88,17 @@ Firewall Bypass Rules configure sets of domains and ip address to prevent bypass
 				Description:   "The source IP address to configure the bypass rule for.",
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
+			"ttl": schema.StringAttribute{
+				Optional:      true,
+				Description:   "How long the bypass rule should last, as a duration such as `1h` or `24h`. Once elapsed, the rule is removed by Vercel. If not set, the rule never expires.",
+				Validators:    []validator.String{validateDuration()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The time the bypass rule expires, in RFC 3339 format. Null if the rule does not expire.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
 		},
 	}
 }
@@ -94,21 +109,47 @@ type FirewallBypassRule struct {
 	TeamID    types.String `tfsdk:"team_id"`
 	Domain    types.String `tfsdk:"domain"`
 	SourceIp  types.String `tfsdk:"source_ip"`
+	TTL       types.String `tfsdk:"ttl"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+func (r FirewallBypassRule) ttlMilliseconds() int64 {
+	if r.TTL.IsNull() || r.TTL.IsUnknown() {
+		return 0
+	}
+	// The value has already been validated by validateDuration.
+	d, _ := time.ParseDuration(r.TTL.ValueString())
+	return d.Milliseconds()
 }
 
-func responseToBypassRule(out client.FirewallBypass) FirewallBypassRule {
+// expired reports whether the TTL of a temporary rule has elapsed.
+func (r FirewallBypassRule) expired(now time.Time) bool {
+	if r.ExpiresAt.IsNull() || r.ExpiresAt.IsUnknown() {
+		return false
+	}
+	expiresAt, err := time.Parse(time.RFC3339, r.ExpiresAt.ValueString())
+	return err == nil && !expiresAt.After(now)
+}
+
+func responseToBypassRule(out client.FirewallBypass, ttl types.String) FirewallBypassRule {
 	split := strings.Split(out.Id, "#")
 	domain := out.Domain
 	if out.IsProjectRule {
 		domain = "*"
 	}
-	return FirewallBypassRule{
+	rule := FirewallBypassRule{
 		ID:        types.StringValue(out.Id),
 		TeamID:    types.StringValue(out.OwnerId),
 		ProjectID: types.StringValue(split[0]),
 		Domain:    types.StringValue(domain),
 		SourceIp:  types.StringValue(out.Ip),
+		TTL:       ttl,
+		ExpiresAt: types.StringNull(),
 	}
+	if out.ExpiresAt != nil {
+		rule.ExpiresAt = timestampValue(*out.ExpiresAt)
+	}
+	return rule
 }
 
 func (r *firewallBypassResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -126,6 +167,7 @@ func (r *firewallBypassResource) Create(ctx context.Context, req resource.Create
 		client.FirewallBypassRule{
 			Domain:   plan.Domain.ValueString(),
 			SourceIp: plan.SourceIp.ValueString(),
+			TTL:      plan.ttlMilliseconds(),
 		},
 	)
 	if err != nil {
@@ -136,7 +178,7 @@ func (r *firewallBypassResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	result := responseToBypassRule(out)
+	result := responseToBypassRule(out, plan.TTL)
 	tflog.Info(ctx, "created firewall bypass rule", map[string]any{
 		"team_id":    plan.TeamID.ValueString(),
 		"project_id": result.ProjectID.ValueString(),
@@ -166,6 +208,22 @@ func (r *firewallBypassResource) Read(ctx context.Context, req resource.ReadRequ
 			SourceIp: state.SourceIp.ValueString(),
 		},
 	)
+	if !state.TTL.IsNull() && (state.expired(time.Now()) || (err == nil && out.Expired(time.Now()))) {
+		// Temporary rules are removed by Vercel once their TTL elapses. The rule is kept in state, rather than
+		// being created again with a new TTL on every apply, and the expiry is reported instead.
+		resp.Diagnostics.AddWarning(
+			"Firewall Bypass Rule expired",
+			fmt.Sprintf(
+				"The Firewall Bypass Rule for %s on %s expired at %s, and has been removed by Vercel. It will not be created again. Remove it from the configuration, or change its `ttl` or run `terraform apply -replace` to create it again.",
+				state.SourceIp.ValueString(),
+				state.Domain.ValueString(),
+				state.ExpiresAt.ValueString(),
+			),
+		)
+		diags = resp.State.Set(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
 	if client.NotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
@@ -182,7 +240,7 @@ func (r *firewallBypassResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	result := responseToBypassRule(out)
+	result := responseToBypassRule(out, state.TTL)
 	tflog.Info(ctx, "read firewall bypass rule", map[string]any{
 		"team_id":    result.TeamID.ValueString(),
 		"project_id": result.ProjectID.ValueString(),
@@ -296,7 +354,7 @@ func (r *firewallBypassResource) ImportState(ctx context.Context, req resource.I
 		return
 	}
 
-	result := responseToBypassRule(out)
+	result := responseToBypassRule(out, types.StringNull())
 	tflog.Info(ctx, "import firewall bypass", map[string]any{
 		"team_id":    result.TeamID.ValueString(),
 		"project_id": result.ProjectID.ValueString(),
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -22,6 +24,7 @@ func TestAcc_FirewallBypassResource(t *testing.T) {
 					resource.TestCheckResourceAttr("vercel_firewall_bypass.bypass_some", "domain", "*"),
 					resource.TestCheckResourceAttr("vercel_firewall_bypass.bypass_one", "source_ip", "1.2.3.4"),
 					resource.TestCheckResourceAttr("vercel_firewall_bypass.bypass_some", "source_ip", "2.3.4.0/24"),
+					resource.TestCheckNoResourceAttr("vercel_firewall_bypass.bypass_one", "expires_at"),
 					resource.TestCheckResourceAttrWith("vercel_firewall_bypass.bypass_one", "id", func(id string) error {
 						if !strings.HasSuffix(id, "#test-acc-domain-"+name+".vercel.app#1.2.3.4") {
 							return fmt.Errorf("expected id does not match got %s - expected %s", id, "test-acc-domain-"+name+".vercel.app#1.2.3.4")
@@ -62,6 +65,8 @@ func TestAcc_FirewallBypassResource(t *testing.T) {
 				Config: cfg(testAccFirewallBypassConfigResourceUpdated(name, testGithubRepo(t))),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("vercel_firewall_bypass.bypass_one", "source_ip", "0.0.0.0/0"),
+					resource.TestCheckResourceAttr("vercel_firewall_bypass.bypass_one", "ttl", "1h"),
+					resource.TestCheckResourceAttrSet("vercel_firewall_bypass.bypass_one", "expires_at"),
 					resource.TestCheckResourceAttrWith("vercel_firewall_bypass.bypass_one", "id", func(id string) error {
 						if !strings.HasSuffix(id, "#test-acc-domain-"+name+".vercel.app#0.0.0.0/0") {
 							return fmt.Errorf("expected id does not match got %s - expected %s", id, "test-acc-domain-"+name+".vercel.app#0.0.0.0/0")
@@ -108,6 +113,54 @@ resource "vercel_firewall_bypass" "bypass_some" {
 `, name, githubRepo)
 }
 
+func TestAcc_FirewallBypassResourceExpired(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccFirewallBypassConfigResourceTemporary(name)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_firewall_bypass.temporary", "ttl", "1m"),
+					resource.TestCheckResourceAttrSet("vercel_firewall_bypass.temporary", "expires_at"),
+				),
+			},
+			{
+				// Once the rule has expired it is kept in state, and is not created again.
+				PreConfig: func() { time.Sleep(90 * time.Second) },
+				Config:    cfg(testAccFirewallBypassConfigResourceTemporary(name)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("vercel_firewall_bypass.temporary", plancheck.ResourceActionNoop),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccFirewallBypassConfigResourceTemporary(name string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "bypass_project" {
+  name = "test-acc-%[1]s-temporary"
+}
+
+resource "vercel_project_domain" "test" {
+  domain = "test-acc-domain-%[1]s.vercel.app"
+  project_id = vercel_project.bypass_project.id
+}
+
+resource "vercel_firewall_bypass" "temporary" {
+  project_id = vercel_project.bypass_project.id
+  domain    = vercel_project_domain.test.domain
+  source_ip = "1.2.3.4"
+  ttl       = "1m"
+
+  depends_on = [vercel_project_domain.test]
+}
+`, name)
+}
+
 func testAccFirewallBypassConfigResourceUpdated(name, githubRepo string) string {
 	return fmt.Sprintf(`
 resource "vercel_project" "bypass_project" {
@@ -127,6 +180,7 @@ resource "vercel_firewall_bypass" "bypass_one" {
   project_id = vercel_project.bypass_project.id
   domain    = vercel_project_domain.test.domain
   source_ip = "0.0.0.0/0"
+  ttl       = "1h"
 
   depends_on = [vercel_project_domain.test]
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
@@ -27,6 +28,7 @@ var (
 	_ resource.Resource                = &logDrainResource{}
 	_ resource.ResourceWithConfigure   = &logDrainResource{}
 	_ resource.ResourceWithImportState = &logDrainResource{}
+	_ resource.ResourceWithModifyPlan  = &logDrainResource{}
 )
 
 func newLogDrainResource() resource.Resource {
@@ -135,6 +137,21 @@ Teams on Pro and Enterprise plans can subscribe to log drains that are generic a
 					stringvalidator.LengthAtLeast(32),
 				},
 			},
+			"secret_wo": schema.StringAttribute{
+				Description: "A write-only alternative to `secret`, which is never stored in the Terraform state or plan. Requires the `secrets.write_only` provider feature. As Terraform cannot tell when it changes, change `secret_wo_version` to apply a new value.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(32),
+					stringvalidator.ConflictsWith(path.MatchRoot("secret")),
+				},
+			},
+			"secret_wo_version": schema.StringAttribute{
+				Description:   "An arbitrary version for `secret_wo`, such as a number or the date it was rotated. Changing it re-creates the Log Drain with the current value of `secret_wo`.",
+				Optional:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
 			"sources": schema.SetAttribute{
 				Description:   "A set of sources that the log drain should send logs for. Valid values are `static`, `edge`, `external`, `build` and `lambda`.",
 				Required:      true,
@@ -163,6 +180,8 @@ type LogDrain struct {
 	ProjectIDs     types.Set     `tfsdk:"project_ids"`
 	SamplingRate   types.Float64 `tfsdk:"sampling_rate"`
 	Secret         types.String  `tfsdk:"secret"`
+	SecretWO       types.String  `tfsdk:"secret_wo"`
+	SecretVersion  types.String  `tfsdk:"secret_wo_version"`
 	Sources        types.Set     `tfsdk:"sources"`
 	Endpoint       types.String  `tfsdk:"endpoint"`
 }
@@ -206,6 +225,15 @@ func responseToLogDrain(ctx context.Context, out client.LogDrain, secret types.S
 	}, nil
 }
 
+var logDrainSecretWOPath = path.Root("secret_wo")
+
+func (r *logDrainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(checkWriteOnlyValue(ctx, r.client, req, logDrainSecretWOPath, path.Root("secret_wo_version"))...)
+}
+
 func (r *logDrainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan LogDrain
 	diags := req.Plan.Get(ctx, &plan)
@@ -242,6 +270,17 @@ func (r *logDrainResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	var secretWO types.String
+	diags = req.Config.GetAttribute(ctx, logDrainSecretWOPath, &secretWO)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	secret := plan.Secret.ValueString()
+	if !secretWO.IsNull() {
+		secret = secretWO.ValueString()
+	}
+
 	out, err := r.client.CreateLogDrain(ctx, client.CreateLogDrainRequest{
 		TeamID:         plan.TeamID.ValueString(),
 		DeliveryFormat: plan.DeliveryFormat.ValueString(),
@@ -249,7 +288,7 @@ func (r *logDrainResource) Create(ctx context.Context, req resource.CreateReques
 		Headers:        headers,
 		ProjectIDs:     projectIDs,
 		SamplingRate:   plan.SamplingRate.ValueFloat64(),
-		Secret:         plan.Secret.ValueString(),
+		Secret:         secret,
 		Sources:        sources,
 		Endpoint:       plan.Endpoint.ValueString(),
 	})
@@ -266,6 +305,16 @@ func (r *logDrainResource) Create(ctx context.Context, req resource.CreateReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	result.SecretVersion = plan.SecretVersion
+	if !secretWO.IsNull() {
+		// The secret was written from secret_wo, so it must not be stored.
+		result.Secret = types.StringNull()
+	}
+	diags = recordWriteOnlyValue(ctx, resp.Private, logDrainSecretWOPath, secretWO)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	tflog.Info(ctx, "created Log Drain", map[string]any{
 		"team_id":      plan.TeamID.ValueString(),
 		"log_drain_id": result.ID.ValueString(),
@@ -308,6 +357,10 @@ func (r *logDrainResource) Read(ctx context.Context, req resource.ReadRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	result.SecretVersion = state.SecretVersion
+	if hasWriteOnlyValue(ctx, resp.Private, logDrainSecretWOPath) {
+		result.Secret = types.StringNull()
+	}
 	tflog.Info(ctx, "read log drain", map[string]any{
 		"team_id":      result.TeamID.ValueString(),
 		"log_drain_id": result.ID.ValueString(),
@@ -3,6 +3,7 @@ package vercel_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -93,6 +94,68 @@ func TestAcc_LogDrainResource(t *testing.T) {
 	})
 }
 
+func TestAcc_LogDrainResourceWriteOnlySecret(t *testing.T) {
+	config := func(features, version string) string {
+		return fmt.Sprintf(`
+provider "vercel" {
+  team = "%[1]s"
+
+  features {
+    secrets {
+      write_only = %[2]s
+    }
+  }
+}
+
+data "vercel_endpoint_verification" "test" {
+}
+
+resource "vercel_log_drain" "test" {
+  delivery_format   = "json"
+  environments      = ["production"]
+  sources           = ["static"]
+  secret_wo         = "a_very_long_and_very_well_specified_secret"
+  secret_wo_version = "%[3]s"
+  endpoint          = "https://verify-test-rouge.vercel.app/api?${data.vercel_endpoint_verification.test.verification_code}"
+}
+`, testTeam(t), features, version)
+	}
+
+	var id string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckLogDrainDeleted(testClient(t), "vercel_log_drain.test", testTeam(t)),
+		Steps: []resource.TestStep{
+			{
+				Config:      config("false", "1"),
+				ExpectError: regexp.MustCompile(`Write-only secrets are not enabled`),
+			},
+			{
+				Config: config("true", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckLogDrainExists(testClient(t), testTeam(t), "vercel_log_drain.test"),
+					resource.TestCheckNoResourceAttr("vercel_log_drain.test", "secret"),
+					resource.TestCheckNoResourceAttr("vercel_log_drain.test", "secret_wo"),
+					resource.TestCheckResourceAttrWith("vercel_log_drain.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+			{
+				// Changing the version re-creates the Log Drain.
+				Config: config("true", "2"),
+				Check: resource.TestCheckResourceAttrWith("vercel_log_drain.test", "id", func(value string) error {
+					if value == id {
+						return fmt.Errorf("expected the log drain to be re-created")
+					}
+					return nil
+				}),
+			},
+		},
+	})
+}
+
 func testAccResourceLogDrain(name string) string {
 	return fmt.Sprintf(`
 data "vercel_endpoint_verification" "test" {
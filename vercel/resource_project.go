@@ -2,11 +2,15 @@ package vercel
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -24,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -108,7 +113,7 @@ For more detailed information, please see the [Vercel documentation](https://ver
 			},
 			"ignore_command": schema.StringAttribute{
 				Optional:    true,
-				Description: "When a commit is pushed to the Git repository that is connected with your Project, its SHA will determine if a new Build has to be issued. If the SHA was deployed before, no new Build will be issued. You can customize this behavior with a command that exits with code 1 (new Build needed) or code 0.",
+				Description: "When a commit is pushed to the Git repository that is connected with your Project, its SHA will determine if a new Build has to be issued. If the SHA was deployed before, no new Build will be issued. You can customize this behavior with a command that exits with code 1 (new Build needed) or code 0. The branch being built is available to the command as `$VERCEL_GIT_COMMIT_REF`, so this can be used to only build Preview Deployments for branches matching a pattern.",
 			},
 			"serverless_function_region": schema.StringAttribute{
 				Optional:      true,
@@ -189,7 +194,7 @@ For more detailed information, please see the [Vercel documentation](https://ver
 				},
 			},
 			"vercel_authentication": schema.SingleNestedAttribute{
-				Description:   "Ensures visitors to your Preview Deployments are logged into Vercel and have a minimum of Viewer access on your team.",
+				Description:   "Ensures visitors to your Preview Deployments are logged into Vercel and have a minimum of Viewer access on your team. Vercel Authentication cannot be disabled for individual paths. To allow monitoring services to reach protected deployments, use `protection_bypass_for_automation`, or `options_allowlist` for CORS preflight requests.",
 				Optional:      true,
 				Computed:      true,
 				PlanModifiers: []planmodifier.Object{objectplanmodifier.UseStateForUnknown()},
@@ -217,13 +222,30 @@ For more detailed information, please see the [Vercel documentation](https://ver
 				Optional:    true,
 				Attributes: map[string]schema.Attribute{
 					"password": schema.StringAttribute{
-						Description: "The password that visitors must enter to gain access to your Preview Deployments. Drift detection is not possible for this field.",
-						Required:    true,
+						Description: "The password that visitors must enter to gain access to your Preview Deployments. Drift detection is not possible for this field. Exactly one of `password` or `password_wo` must be set.",
+						Optional:    true,
+						Sensitive:   true,
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 72),
+							stringvalidator.ExactlyOneOf(
+								path.MatchRelative().AtParent().AtName("password"),
+								path.MatchRelative().AtParent().AtName("password_wo"),
+							),
+						},
+					},
+					"password_wo": schema.StringAttribute{
+						Description: "A write-only alternative to `password`, which is never stored in the Terraform state or plan. Requires the `secrets.write_only` provider feature. As Terraform cannot tell when it changes, change `password_wo_version` to apply a new value.",
+						Optional:    true,
 						Sensitive:   true,
+						WriteOnly:   true,
 						Validators: []validator.String{
 							stringvalidator.LengthBetween(1, 72),
 						},
 					},
+					"password_wo_version": schema.StringAttribute{
+						Description: "An arbitrary version for `password_wo`, such as a number or the date it was rotated. Changing it sends the current value of `password_wo` to Vercel.",
+						Optional:    true,
+					},
 					"deployment_type": schema.StringAttribute{
 						Required:      true,
 						Description:   "The deployment environment to protect. Must be one of `standard_protection`, `all_deployments`, or `only_preview_deployments`.",
@@ -336,6 +358,44 @@ For more detailed information, please see the [Vercel documentation](https://ver
 					},
 				},
 			},
+			"rolling_release": schema.SingleNestedAttribute{
+				Description: "Gradually roll out Production Deployments, by sending an increasing percentage of traffic to them in stages. Once the last stage completes, the deployment receives all traffic.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"advancement_type": schema.StringAttribute{
+						Description: "How the rollout advances to the next stage. Must be one of `automatic`, where each stage advances after its `duration`, or `manual-approval`, where each stage must be approved.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(rollingReleaseAutomatic, rollingReleaseManualApproval),
+						},
+					},
+					"stages": schema.ListNestedAttribute{
+						Description: "The stages of the rollout, in order. The final stage, sending all traffic to the new deployment, is added automatically.",
+						Required:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"target_percentage": schema.Int64Attribute{
+									Description: "The percentage of traffic to send to the new deployment during this stage. Must be higher than the previous stage.",
+									Required:    true,
+									Validators: []validator.Int64{
+										int64validator.Between(1, 99),
+									},
+								},
+								"duration": schema.Int64Attribute{
+									Description: "The number of minutes before the stage advances. Required if `advancement_type` is `automatic`, and cannot be set otherwise.",
+									Optional:    true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
+							},
+						},
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+			},
 			"id": schema.StringAttribute{
 				Computed:      true,
 				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
@@ -397,6 +457,16 @@ For more detailed information, please see the [Vercel documentation](https://ver
 					},
 				},
 			},
+			"git_provider_options": schema.SingleNestedAttribute{
+				Description: "Configuration for how Vercel interacts with the connected Git provider.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"create_deployments": schema.BoolAttribute{
+						Description: "Whether Vercel should create Deployments on the Git provider. These surface as deployment status updates on pull requests and commits.",
+						Required:    true,
+					},
+				},
+			},
 			"preview_comments": schema.BoolAttribute{
 				Description:        "Enables the Vercel Toolbar on your preview deployments.",
 				DeprecationMessage: "Use `enable_preview_feedback` instead. This attribute will be removed in a future version.",
@@ -458,12 +528,32 @@ For more detailed information, please see the [Vercel documentation](https://ver
 				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
 				Description:   "If enabled, builds for the Production environment will be prioritized over Preview environments.",
 			},
+			"cancel_outdated_builds": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+				Description:   "When enabled, a new Deployment cancels any builds for the same branch that are still queued or in progress, so that busy repositories do not spend build minutes on outdated commits. When disabled, every commit is built in sequence.",
+			},
 			"directory_listing": schema.BoolAttribute{
 				Optional:      true,
 				Computed:      true,
 				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
 				Description:   "If no index file is present within a directory, the directory contents will be displayed.",
 			},
+			"related_projects": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of other Projects in the same team that are related to this Project, such as other applications in the same monorepo. Related Projects share Preview Deployment comments and can be referenced by microfrontends. If this is not set, any Related Projects configured outside of Terraform are left unchanged. Set it to an empty set to remove them.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"protected_sourcemaps": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+				Description:   "Requires visitors to be authenticated with Vercel, and have access to the project, in order to download the source maps of its Deployments. Disabling this allows anyone to reconstruct your original source code from the built output, so it should only be disabled if the source code is already public.",
+			},
 			"skew_protection": schema.StringAttribute{
 				Optional:    true,
 				Description: "Ensures that outdated clients always fetch the correct version for a given deployment. This value defines how long Vercel keeps Skew Protection active.",
@@ -472,7 +562,7 @@ For more detailed information, please see the [Vercel documentation](https://ver
 				},
 			},
 			"resource_config": schema.SingleNestedAttribute{
-				Description:   "Resource Configuration for the project.",
+				Description:   "Resource Configuration for the project. These settings apply to every environment, as Vercel does not support overriding them for preview or custom environments. The default function tier is set with `function_default_cpu_type`. Tier-based function concurrency is not available in the Vercel API, so it cannot be configured.",
 				Optional:      true,
 				Computed:      true,
 				PlanModifiers: []planmodifier.Object{objectplanmodifier.UseStateForUnknown()},
@@ -503,6 +593,24 @@ For more detailed information, please see the [Vercel documentation](https://ver
 						Computed:      true,
 						PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
 					},
+					"function_default_regions": schema.SetAttribute{
+						Description: "The default regions for Serverless Functions. Functions are deployed to all of these regions. This supersedes `serverless_function_region`, which only allows a single region, so the two cannot both be set.",
+						Optional:    true,
+						Computed:    true,
+						ElementType: types.StringType,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ValueStringsAre(validateServerlessFunctionRegion()),
+							setvalidator.ConflictsWith(path.MatchRoot("serverless_function_region")),
+						},
+						PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+					},
+					"function_zero_config_failover": schema.BoolAttribute{
+						Description:   "Automatically fail over Serverless Functions to the next closest region if a region becomes unavailable. Available on Enterprise plans.",
+						Optional:      true,
+						Computed:      true,
+						PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+					},
 				},
 			},
 			"on_demand_concurrent_builds": schema.BoolAttribute{
@@ -519,6 +627,34 @@ For more detailed information, please see the [Vercel documentation](https://ver
 					stringvalidator.OneOf("enhanced", "turbo"),
 				},
 			},
+			"latest_production_deployment": schema.SingleNestedAttribute{
+				Description: "The deployment currently serving production traffic for the project. This is refreshed each time the project is read, so it reflects what is live rather than what Terraform last deployed.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "The ID of the deployment.",
+						Computed:    true,
+					},
+					"url": schema.StringAttribute{
+						Description: "The unique URL of the deployment.",
+						Computed:    true,
+					},
+					"created_at": schema.StringAttribute{
+						Description: "The time the deployment was created, in RFC 3339 format.",
+						Computed:    true,
+					},
+					"commit_sha": schema.StringAttribute{
+						Description: "The SHA of the Git commit the deployment was built from, if it was created from a Git provider.",
+						Computed:    true,
+					},
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "When enabled, the project cannot be deleted by Terraform. To delete the project, first set this to `false` and apply the change. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -553,10 +689,12 @@ type Project struct {
 	TrustedIps                          *TrustedIps                     `tfsdk:"trusted_ips"`
 	OIDCTokenConfig                     *OIDCTokenConfig                `tfsdk:"oidc_token_config"`
 	OptionsAllowlist                    *OptionsAllowlist               `tfsdk:"options_allowlist"`
+	RollingRelease                      *RollingRelease                 `tfsdk:"rolling_release"`
 	ProtectionBypassForAutomation       types.Bool                      `tfsdk:"protection_bypass_for_automation"`
 	ProtectionBypassForAutomationSecret types.String                    `tfsdk:"protection_bypass_for_automation_secret"`
 	AutoExposeSystemEnvVars             types.Bool                      `tfsdk:"automatically_expose_system_environment_variables"`
 	GitComments                         types.Object                    `tfsdk:"git_comments"`
+	GitProviderOptions                  types.Object                    `tfsdk:"git_provider_options"`
 	PreviewComments                     types.Bool                      `tfsdk:"preview_comments"`
 	EnablePreviewFeedback               types.Bool                      `tfsdk:"enable_preview_feedback"`
 	EnableProductionFeedback            types.Bool                      `tfsdk:"enable_production_feedback"`
@@ -566,12 +704,17 @@ type Project struct {
 	CustomerSuccessCodeVisibility       types.Bool                      `tfsdk:"customer_success_code_visibility"`
 	GitForkProtection                   types.Bool                      `tfsdk:"git_fork_protection"`
 	PrioritiseProductionBuilds          types.Bool                      `tfsdk:"prioritise_production_builds"`
+	CancelOutdatedBuilds                types.Bool                      `tfsdk:"cancel_outdated_builds"`
 	DirectoryListing                    types.Bool                      `tfsdk:"directory_listing"`
+	ProtectedSourcemaps                 types.Bool                      `tfsdk:"protected_sourcemaps"`
+	RelatedProjects                     types.Set                       `tfsdk:"related_projects"`
 	EnableAffectedProjectsDeployments   types.Bool                      `tfsdk:"enable_affected_projects_deployments"`
 	SkewProtection                      types.String                    `tfsdk:"skew_protection"`
 	ResourceConfig                      types.Object                    `tfsdk:"resource_config"`
 	OnDemandConcurrentBuilds            types.Bool                      `tfsdk:"on_demand_concurrent_builds"`
 	BuildMachineType                    types.String                    `tfsdk:"build_machine_type"`
+	DeletionProtection                  types.Bool                      `tfsdk:"deletion_protection"`
+	LatestProductionDeployment          types.Object                    `tfsdk:"latest_production_deployment"`
 }
 
 type GitComments struct {
@@ -589,6 +732,23 @@ func (g *GitComments) toUpdateProjectRequest() *client.GitComments {
 	}
 }
 
+type GitProviderOptions struct {
+	CreateDeployments types.Bool `tfsdk:"create_deployments"`
+}
+
+func (g *GitProviderOptions) toUpdateProjectRequest() *client.GitProviderOptions {
+	if g == nil {
+		return nil
+	}
+	createDeployments := "disabled"
+	if g.CreateDeployments.ValueBool() {
+		createDeployments = "enabled"
+	}
+	return &client.GitProviderOptions{
+		CreateDeployments: createDeployments,
+	}
+}
+
 func (p Project) RequiresUpdateAfterCreation() bool {
 	return p.PasswordProtection != nil ||
 		p.VercelAuthentication != nil ||
@@ -597,13 +757,17 @@ func (p Project) RequiresUpdateAfterCreation() bool {
 		p.OptionsAllowlist != nil ||
 		!p.AutoExposeSystemEnvVars.IsNull() ||
 		p.GitComments.IsNull() ||
+		!p.GitProviderOptions.IsNull() ||
 		(!p.AutoAssignCustomDomains.IsNull() && !p.AutoAssignCustomDomains.ValueBool()) ||
 		!p.GitLFS.IsNull() ||
 		!p.FunctionFailover.IsNull() ||
 		!p.CustomerSuccessCodeVisibility.IsNull() ||
 		(!p.GitForkProtection.IsNull() && !p.GitForkProtection.ValueBool()) ||
 		!p.PrioritiseProductionBuilds.IsNull() ||
+		!p.CancelOutdatedBuilds.IsNull() ||
 		!p.DirectoryListing.IsNull() ||
+		!p.ProtectedSourcemaps.IsNull() ||
+		!p.RelatedProjects.IsNull() ||
 		!p.SkewProtection.IsNull() ||
 		!p.NodeVersion.IsNull()
 
@@ -617,6 +781,8 @@ var nullProject = Project{
 	OutputDirectory: types.StringNull(),
 	PublicSource:    types.BoolNull(),
 	Environment:     types.SetNull(EnvVariableElemType),
+	/* Deletion protection is a Terraform-only setting, so it is never present in the API response */
+	DeletionProtection: types.BoolValue(false),
 }
 
 func (p *Project) environment(ctx context.Context) ([]EnvironmentItem, error) {
@@ -686,6 +852,15 @@ func oneBoolPointer(a, b types.Bool) *bool {
 	return nil
 }
 
+// knownBoolPointer returns nil for null and unknown values, so that settings the user has not configured
+// are left untouched.
+func knownBoolPointer(v types.Bool) *bool {
+	if v.IsUnknown() {
+		return nil
+	}
+	return v.ValueBoolPointer()
+}
+
 func (p *Project) toUpdateProjectRequest(ctx context.Context, oldName string) (req client.UpdateProjectRequest, diags diag.Diagnostics) {
 	var name *string = nil
 	if oldName != p.Name.ValueString() {
@@ -700,10 +875,27 @@ func (p *Project) toUpdateProjectRequest(ctx context.Context, oldName string) (r
 	if diags.HasError() {
 		return req, diags
 	}
+	var gpo *GitProviderOptions
+	diags = p.GitProviderOptions.As(ctx, &gpo, basetypes.ObjectAsOptions{
+		UnhandledNullAsEmpty:    true,
+		UnhandledUnknownAsEmpty: true,
+	})
+	if diags.HasError() {
+		return req, diags
+	}
 	resourceConfig, diags := p.resourceConfig(ctx)
 	if diags.HasError() {
 		return req, diags
 	}
+	var relatedProjects *[]string
+	if !p.RelatedProjects.IsNull() && !p.RelatedProjects.IsUnknown() {
+		ids := []string{}
+		diags = p.RelatedProjects.ElementsAs(ctx, &ids, false)
+		if diags.HasError() {
+			return req, diags
+		}
+		relatedProjects = &ids
+	}
 	return client.UpdateProjectRequest{
 		BuildCommand:                         p.BuildCommand.ValueStringPointer(),
 		CommandForIgnoringBuildStep:          p.IgnoreCommand.ValueStringPointer(),
@@ -731,8 +923,12 @@ func (p *Project) toUpdateProjectRequest(ctx context.Context, oldName string) (r
 		GitForkProtection:                    p.GitForkProtection.ValueBool(),
 		ProductionDeploymentsFastLane:        p.PrioritiseProductionBuilds.ValueBool(),
 		DirectoryListing:                     p.DirectoryListing.ValueBool(),
+		ProtectedSourcemaps:                  knownBoolPointer(p.ProtectedSourcemaps),
+		AutoJobCancelation:                   knownBoolPointer(p.CancelOutdatedBuilds),
+		RelatedProjects:                      relatedProjects,
 		SkewProtectionMaxAge:                 toSkewProtectionAge(p.SkewProtection),
 		GitComments:                          gc.toUpdateProjectRequest(),
+		GitProviderOptions:                   gpo.toUpdateProjectRequest(),
 		ResourceConfig:                       resourceConfig.toClientResourceConfig(p.OnDemandConcurrentBuilds, p.BuildMachineType),
 		NodeVersion:                          p.NodeVersion.ValueString(),
 	}, nil
@@ -820,10 +1016,33 @@ func (p *PasswordProtectionWithPassword) toUpdateProjectRequest() *client.Passwo
 		return nil
 	}
 
+	password := p.Password.ValueString()
+	if !p.PasswordWO.IsNull() {
+		password = p.PasswordWO.ValueString()
+	}
 	return &client.PasswordProtectionWithPassword{
 		DeploymentType: toApiDeploymentProtectionType(p.DeploymentType),
-		Password:       p.Password.ValueString(),
+		Password:       password,
+	}
+}
+
+var projectPasswordWOPath = path.Root("password_protection").AtName("password_wo")
+
+// withPasswordWO copies the write-only password from the configuration into the plan, where it is always null, so
+// that it can be sent to Vercel.
+func (p *Project) withPasswordWO(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	if p.PasswordProtection == nil {
+		return nil
 	}
+	return config.GetAttribute(ctx, projectPasswordWOPath, &p.PasswordProtection.PasswordWO)
+}
+
+// passwordWO returns the write-only password, if one is set.
+func (p *Project) passwordWO() types.String {
+	if p.PasswordProtection == nil {
+		return types.StringNull()
+	}
+	return p.PasswordProtection.PasswordWO
 }
 
 func toApiTrustedIpProtectionMode(dt types.String) string {
@@ -900,16 +1119,20 @@ func (o *OIDCTokenConfig) toUpdateProjectRequest() *client.OIDCTokenConfig {
 
 var resourceConfigAttrType = types.ObjectType{
 	AttrTypes: map[string]attr.Type{
-		"function_default_cpu_type": types.StringType,
-		"function_default_timeout":  types.Int64Type,
-		"fluid":                     types.BoolType,
+		"function_default_cpu_type":     types.StringType,
+		"function_default_timeout":      types.Int64Type,
+		"fluid":                         types.BoolType,
+		"function_default_regions":      types.SetType{ElemType: types.StringType},
+		"function_zero_config_failover": types.BoolType,
 	},
 }
 
 type ResourceConfig struct {
-	FunctionDefaultCPUType types.String `tfsdk:"function_default_cpu_type"`
-	FunctionDefaultTimeout types.Int64  `tfsdk:"function_default_timeout"`
-	Fluid                  types.Bool   `tfsdk:"fluid"`
+	FunctionDefaultCPUType     types.String `tfsdk:"function_default_cpu_type"`
+	FunctionDefaultTimeout     types.Int64  `tfsdk:"function_default_timeout"`
+	Fluid                      types.Bool   `tfsdk:"fluid"`
+	FunctionDefaultRegions     types.Set    `tfsdk:"function_default_regions"`
+	FunctionZeroConfigFailover types.Bool   `tfsdk:"function_zero_config_failover"`
 }
 
 func (p *Project) resourceConfig(ctx context.Context) (rc *ResourceConfig, diags diag.Diagnostics) {
@@ -935,6 +1158,14 @@ func (r *ResourceConfig) toClientResourceConfig(onDemandConcurrentBuilds types.B
 	if !r.Fluid.IsUnknown() {
 		resourceConfig.Fluid = r.Fluid.ValueBoolPointer()
 	}
+	if !r.FunctionDefaultRegions.IsUnknown() && !r.FunctionDefaultRegions.IsNull() {
+		for _, region := range r.FunctionDefaultRegions.Elements() {
+			resourceConfig.FunctionDefaultRegions = append(resourceConfig.FunctionDefaultRegions, region.(types.String).ValueString())
+		}
+	}
+	if !r.FunctionZeroConfigFailover.IsUnknown() {
+		resourceConfig.FunctionZeroConfigFailover = r.FunctionZeroConfigFailover.ValueBoolPointer()
+	}
 	if !onDemandConcurrentBuilds.IsUnknown() {
 		resourceConfig.ElasticConcurrencyEnabled = onDemandConcurrentBuilds.ValueBoolPointer()
 	}
@@ -1014,6 +1245,40 @@ var gitCommentsAttrTypes = map[string]attr.Type{
 	"on_pull_request": types.BoolType,
 }
 
+var gitProviderOptionsAttrTypes = map[string]attr.Type{
+	"create_deployments": types.BoolType,
+}
+
+func gitProviderOptionsFromResponse(response *client.GitProviderOptions) types.Object {
+	return types.ObjectValueMust(gitProviderOptionsAttrTypes, map[string]attr.Value{
+		"create_deployments": types.BoolValue(response.CreateDeployments != "disabled"),
+	})
+}
+
+var latestProductionDeploymentAttrTypes = map[string]attr.Type{
+	"id":         types.StringType,
+	"url":        types.StringType,
+	"created_at": types.StringType,
+	"commit_sha": types.StringType,
+}
+
+func latestProductionDeploymentFromResponse(response *client.ProjectTargets) types.Object {
+	if response == nil || response.Production == nil {
+		return types.ObjectNull(latestProductionDeploymentAttrTypes)
+	}
+	d := response.Production
+	commitSHA := types.StringNull()
+	if sha := d.CommitSHA(); sha != "" {
+		commitSHA = types.StringValue(sha)
+	}
+	return types.ObjectValueMust(latestProductionDeploymentAttrTypes, map[string]attr.Value{
+		"id":         types.StringValue(d.ID),
+		"url":        types.StringValue(d.URL),
+		"created_at": types.StringValue(time.UnixMilli(d.CreatedAt).UTC().Format(time.RFC3339)),
+		"commit_sha": commitSHA,
+	})
+}
+
 func isSameStringSet(a []string, b []string) bool {
 	if len(a) != len(b) {
 		return false
@@ -1097,9 +1362,15 @@ func convertResponseToProject(ctx context.Context, response client.ProjectRespon
 		if plan.PasswordProtection != nil {
 			pass = plan.PasswordProtection.Password
 		}
+		version := types.StringNull()
+		if plan.PasswordProtection != nil {
+			version = plan.PasswordProtection.PasswordVersion
+		}
 		pp = &PasswordProtectionWithPassword{
-			Password:       pass,
-			DeploymentType: fromApiDeploymentProtectionType(response.PasswordProtection.DeploymentType),
+			Password:        pass,
+			PasswordWO:      types.StringNull(),
+			PasswordVersion: version,
+			DeploymentType:  fromApiDeploymentProtectionType(response.PasswordProtection.DeploymentType),
 		}
 	}
 
@@ -1144,9 +1415,11 @@ func convertResponseToProject(ctx context.Context, response client.ProjectRespon
 	resourceConfig := types.ObjectNull(resourceConfigAttrType.AttrTypes)
 	if response.ResourceConfig != nil {
 		resourceConfig = types.ObjectValueMust(resourceConfigAttrType.AttrTypes, map[string]attr.Value{
-			"function_default_cpu_type": types.StringPointerValue(response.ResourceConfig.FunctionDefaultMemoryType),
-			"function_default_timeout":  types.Int64PointerValue(response.ResourceConfig.FunctionDefaultTimeout),
-			"fluid":                     types.BoolValue(response.ResourceConfig.Fluid),
+			"function_default_cpu_type":     types.StringPointerValue(response.ResourceConfig.FunctionDefaultMemoryType),
+			"function_default_timeout":      types.Int64PointerValue(response.ResourceConfig.FunctionDefaultTimeout),
+			"fluid":                         types.BoolValue(response.ResourceConfig.Fluid),
+			"function_default_regions":      toStringSet(response.ResourceConfig.FunctionDefaultRegions),
+			"function_zero_config_failover": types.BoolPointerValue(response.ResourceConfig.FunctionZeroConfigFailover),
 		})
 	}
 
@@ -1179,6 +1452,11 @@ func convertResponseToProject(ctx context.Context, response client.ProjectRespon
 		protectionBypassSecret = types.StringValue(plan.ProtectionBypassForAutomationSecret.ValueString())
 	}
 
+	relatedProjects := types.SetNull(types.StringType)
+	if !plan.RelatedProjects.IsNull() {
+		relatedProjects = toStringSet(response.RelatedProjects)
+	}
+
 	gitComments := types.ObjectNull(gitCommentsAttrTypes)
 	if response.GitComments != nil && !plan.GitComments.IsNull() {
 		var diags diag.Diagnostics
@@ -1191,6 +1469,11 @@ func convertResponseToProject(ctx context.Context, response client.ProjectRespon
 		}
 	}
 
+	gitProviderOptions := types.ObjectNull(gitProviderOptionsAttrTypes)
+	if response.GitProviderOptions != nil && !plan.GitProviderOptions.IsNull() {
+		gitProviderOptions = gitProviderOptionsFromResponse(response.GitProviderOptions)
+	}
+
 	return Project{
 		BuildCommand:                        uncoerceString(fields.BuildCommand, types.StringPointerValue(response.BuildCommand)),
 		DevCommand:                          uncoerceString(fields.DevCommand, types.StringPointerValue(response.DevCommand)),
@@ -1210,6 +1493,7 @@ func convertResponseToProject(ctx context.Context, response client.ProjectRespon
 		TrustedIps:                          tip,
 		OIDCTokenConfig:                     oidcTokenConfig,
 		OptionsAllowlist:                    oal,
+		RollingRelease:                      rollingReleaseFromResponse(response.RollingRelease),
 		ProtectionBypassForAutomation:       protectionBypass,
 		ProtectionBypassForAutomationSecret: protectionBypassSecret,
 		AutoExposeSystemEnvVars:             types.BoolPointerValue(response.AutoExposeSystemEnvVars),
@@ -1223,13 +1507,19 @@ func convertResponseToProject(ctx context.Context, response client.ProjectRespon
 		CustomerSuccessCodeVisibility:       types.BoolValue(response.CustomerSupportCodeVisibility),
 		GitForkProtection:                   types.BoolValue(response.GitForkProtection),
 		PrioritiseProductionBuilds:          types.BoolValue(response.ProductionDeploymentsFastLane),
+		CancelOutdatedBuilds:                types.BoolPointerValue(response.AutoJobCancelation),
 		DirectoryListing:                    types.BoolValue(response.DirectoryListing),
+		ProtectedSourcemaps:                 types.BoolPointerValue(response.ProtectedSourcemaps),
+		RelatedProjects:                     relatedProjects,
 		SkewProtection:                      fromSkewProtectionMaxAge(response.SkewProtectionMaxAge),
 		GitComments:                         gitComments,
+		GitProviderOptions:                  gitProviderOptions,
 		ResourceConfig:                      resourceConfig,
 		NodeVersion:                         types.StringValue(response.NodeVersion),
 		OnDemandConcurrentBuilds:            types.BoolValue(response.ResourceConfig.ElasticConcurrencyEnabled),
 		BuildMachineType:                    types.StringValue(response.ResourceConfig.BuildMachineType),
+		DeletionProtection:                  plan.DeletionProtection,
+		LatestProductionDeployment:          latestProductionDeploymentFromResponse(response.Targets),
 	}, nil
 }
 
@@ -1244,6 +1534,39 @@ func (r *projectResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 		return
 	}
 
+	resp.Diagnostics.Append(checkWriteOnlyValue(ctx, r.client, req, projectPasswordWOPath, path.Root("password_protection").AtName("password_wo_version"))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *Project
+	if !req.State.Raw.IsNull() {
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	resp.Diagnostics.Append(config.RollingRelease.validate()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ProtectedSourcemaps.Equal(types.BoolValue(false)) && (state == nil || !state.ProtectedSourcemaps.Equal(config.ProtectedSourcemaps)) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("protected_sourcemaps"),
+			"Source maps will be publicly accessible",
+			"Disabling `protected_sourcemaps` allows anyone to download the source maps of this project's Deployments, which can be used to reconstruct the original source code.",
+		)
+	}
+	if config.PublicSource.Equal(types.BoolValue(true)) && (state == nil || !state.PublicSource.Equal(config.PublicSource)) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("public_source"),
+			"Source code and logs will be publicly accessible",
+			"Enabling `public_source` allows anyone to view the source code, build logs and deployment status of this project's Deployments via the `/_src` and `/_logs` paths.",
+		)
+	}
+
 	environment, err := config.environment(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -1299,6 +1622,48 @@ func (r *projectResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 	}
 }
 
+// validateRelatedProjects checks that every related project exists within the same team as the project being
+// configured, as the API does not report which of the related projects is invalid.
+func validateRelatedProjects(ctx context.Context, c *client.Client, projectID, teamID string, relatedProjects types.Set) (diags diag.Diagnostics) {
+	if relatedProjects.IsNull() || relatedProjects.IsUnknown() {
+		return nil
+	}
+	var ids []string
+	diags = relatedProjects.ElementsAs(ctx, &ids, false)
+	if diags.HasError() {
+		return diags
+	}
+	for _, id := range ids {
+		if id == projectID {
+			diags.AddAttributeError(
+				path.Root("related_projects"),
+				"Invalid related project",
+				"A project cannot be related to itself.",
+			)
+			continue
+		}
+		// Projects are looked up within the team, so projects belonging to other teams are not found.
+		_, err := c.GetProject(ctx, id, teamID)
+		var apiErr client.APIError
+		if client.NotFound(err) || (errors.As(err, &apiErr) && apiErr.StatusCode == 403) {
+			diags.AddAttributeError(
+				path.Root("related_projects"),
+				"Invalid related project",
+				fmt.Sprintf("The project %q does not exist in team %s. Related projects must belong to the same team.", id, c.TeamID(teamID)),
+			)
+			continue
+		}
+		if err != nil {
+			diags.AddError(
+				"Error validating related projects",
+				fmt.Sprintf("Could not read related project %s, unexpected error: %s", id, err),
+			)
+			return diags
+		}
+	}
+	return diags
+}
+
 // Create will create a project within Vercel by calling the Vercel API.
 // This is called automatically by the provider when a new resource should be created.
 func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -1309,6 +1674,23 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	diags = plan.withPasswordWO(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = recordWriteOnlyValue(ctx, resp.Private, projectPasswordWOPath, plan.passwordWO())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = validateRelatedProjects(ctx, r.client, "", plan.TeamID.ValueString(), plan.RelatedProjects)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	request, diags := plan.toCreateProjectRequest(ctx)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
@@ -1380,6 +1762,35 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		}
 	}
 
+	if plan.RollingRelease != nil {
+		rollingRelease, err := r.client.UpdateRollingRelease(ctx, client.UpdateRollingReleaseRequest{
+			ProjectID:      result.ID.ValueString(),
+			TeamID:         result.TeamID.ValueString(),
+			RollingRelease: plan.RollingRelease.toClientRollingRelease(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error configuring rolling release",
+				"Failed to create project, an error occurred configuring the rolling release: "+err.Error(),
+			)
+			return
+		}
+		out.RollingRelease = &rollingRelease
+		result, err = convertResponseToProject(ctx, out, plan)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error converting project response to model",
+				"Could not create project, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		diags = resp.State.Set(ctx, result)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Fields that have to be updated after the project is initially created.
 	if plan.RequiresUpdateAfterCreation() {
 		req, diags := plan.toUpdateProjectRequest(ctx, plan.Name.ValueString())
@@ -1503,6 +1914,11 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	if out.PendingDeletion() {
+		addProjectPendingDeletionWarning(&resp.Diagnostics, "project", state.ID.ValueString())
+		return
+	}
+
 	result, err := convertResponseToProject(ctx, out, state)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -1579,6 +1995,12 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	diags = plan.withPasswordWO(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state Project
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -1586,6 +2008,14 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	if !plan.RelatedProjects.Equal(state.RelatedProjects) {
+		diags = validateRelatedProjects(ctx, r.client, state.ID.ValueString(), plan.TeamID.ValueString(), plan.RelatedProjects)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if state.ProtectionBypassForAutomation != plan.ProtectionBypassForAutomation {
 		secret := state.ProtectionBypassForAutomationSecret.ValueString()
 		if plan.ProtectionBypassForAutomationSecret.ValueString() != "" {
@@ -1611,6 +2041,34 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 	}
 
+	if !plan.RollingRelease.equal(state.RollingRelease) {
+		var err error
+		if plan.RollingRelease != nil {
+			_, err = r.client.UpdateRollingRelease(ctx, client.UpdateRollingReleaseRequest{
+				ProjectID:      plan.ID.ValueString(),
+				TeamID:         plan.TeamID.ValueString(),
+				RollingRelease: plan.RollingRelease.toClientRollingRelease(),
+			})
+		} else {
+			err = r.client.DeleteRollingRelease(ctx, plan.ID.ValueString(), plan.TeamID.ValueString())
+			if client.NotFound(err) {
+				err = nil
+			}
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating project",
+				fmt.Sprintf(
+					"Could not update project %s %s, unexpected error configuring the rolling release: %s",
+					state.TeamID.ValueString(),
+					state.ID.ValueString(),
+					err,
+				),
+			)
+			return
+		}
+	}
+
 	updateRequest, diags := plan.toUpdateProjectRequest(ctx, state.Name.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -1768,6 +2226,11 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		)
 		return
 	}
+	diags = recordWriteOnlyValue(ctx, resp.Private, projectPasswordWOPath, plan.passwordWO())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	tflog.Info(ctx, "updated project", map[string]any{
 		"team_id":    result.TeamID.ValueString(),
 		"project_id": result.ID.ValueString(),
@@ -1790,6 +2253,18 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Error deleting project",
+			fmt.Sprintf(
+				"Could not delete project %s %s as it has `deletion_protection` enabled. To delete the project, set `deletion_protection = false`, apply the change, and then try again.",
+				state.TeamID.ValueString(),
+				state.ID.ValueString(),
+			),
+		)
+		return
+	}
+
 	err := r.client.DeleteProject(ctx, state.ID.ValueString(), state.TeamID.ValueString())
 	if client.NotFound(err) {
 		return
@@ -1850,6 +2325,27 @@ func (r *projectResource) ImportState(ctx context.Context, req resource.ImportSt
 		"project_id": result.ID.ValueString(),
 	})
 
+	// Let the user know which settings are not managed by Terraform, so they are not surprised that changing
+	// them in the Vercel UI is not reflected in a plan. This is best effort, and never fails the import.
+	var projectSchema resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &projectSchema)
+	fields, err := r.client.GetProjectFields(ctx, projectID, teamID)
+	if err != nil {
+		tflog.Warn(ctx, "unable to check project for unmanaged fields", map[string]any{
+			"project_id": projectID,
+			"error":      err.Error(),
+		})
+	} else if unmanaged := unmanagedProjectFields(projectSchema.Schema.Attributes, fields); len(unmanaged) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Project has settings that are not managed",
+			fmt.Sprintf(
+				"Project %s was imported, but has the following settings that the vercel_project resource does not support. They are not in state, and will not be changed or have drift detected by Terraform:\n  - %s",
+				result.Name.ValueString(),
+				strings.Join(unmanaged, "\n  - "),
+			),
+		)
+	}
+
 	diags := resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
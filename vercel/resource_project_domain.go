@@ -2,10 +2,15 @@ package vercel
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"net"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,8 +23,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &projectDomainResource{}
-	_ resource.ResourceWithConfigure = &projectDomainResource{}
+	_ resource.Resource               = &projectDomainResource{}
+	_ resource.ResourceWithConfigure  = &projectDomainResource{}
+	_ resource.ResourceWithModifyPlan = &projectDomainResource{}
 )
 
 func newProjectDomainResource() resource.Resource {
@@ -60,7 +66,9 @@ Provides a Project Domain resource.
 
 A Project Domain is used to associate a domain name with a ` + "`vercel_project`." + `
 
-By default, Project Domains will be automatically applied to any ` + "`production` deployments.",
+By default, Project Domains will be automatically applied to any ` + "`production` deployments. This can be disabled with the `auto_assign_custom_domains` field on the `vercel_project` resource." + `
+
+Project Domains with a ` + "`git_branch` are automatically assigned to the latest deployment of that branch instead.",
 		Attributes: map[string]schema.Attribute{
 			"project_id": schema.StringAttribute{
 				Description:   "The project ID to add the deployment to.",
@@ -113,6 +121,25 @@ By default, Project Domains will be automatically applied to any ` + "`productio
 					),
 				},
 			},
+			"wait_for_certificate": schema.BoolAttribute{
+				Description: "When true, adding the domain waits until a valid TLS certificate is being served for it, and fails with hints about the DNS records that are blocking the certificate if it is not issued within `certificate_timeout`. Without this, the domain may be added while visitors see a certificate error.",
+				Optional:    true,
+			},
+			"certificate_timeout": schema.StringAttribute{
+				Description: "How long to wait for the certificate when `wait_for_certificate` is true, as a duration such as `10m`. Defaults to `10m`.",
+				Optional:    true,
+				Validators: []validator.String{
+					validateDuration(),
+				},
+			},
+			"check_caa": schema.BoolAttribute{
+				Description: "When true, the public DNS of the domain is checked for CAA records that would stop Vercel from issuing a certificate for it before the domain is added. The plan fails with the offending records if any are found.",
+				Optional:    true,
+			},
+			"verified": schema.BoolAttribute{
+				Description: "Whether Vercel has verified ownership of the domain. A certificate cannot be issued for an unverified domain.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -127,10 +154,20 @@ type ProjectDomain struct {
 	Redirect            types.String `tfsdk:"redirect"`
 	RedirectStatusCode  types.Int64  `tfsdk:"redirect_status_code"`
 	TeamID              types.String `tfsdk:"team_id"`
+	WaitForCertificate  types.Bool   `tfsdk:"wait_for_certificate"`
+	CertificateTimeout  types.String `tfsdk:"certificate_timeout"`
+	CheckCAA            types.Bool   `tfsdk:"check_caa"`
+	Verified            types.Bool   `tfsdk:"verified"`
 }
 
-func convertResponseToProjectDomain(response client.ProjectDomainResponse) ProjectDomain {
+// convertResponseToProjectDomain converts a project domain from the API. The settings that only affect how the
+// provider behaves are taken from the plan or state, as the API has no knowledge of them.
+func convertResponseToProjectDomain(response client.ProjectDomainResponse, plan ProjectDomain) ProjectDomain {
 	return ProjectDomain{
+		WaitForCertificate:  plan.WaitForCertificate,
+		CertificateTimeout:  plan.CertificateTimeout,
+		CheckCAA:            plan.CheckCAA,
+		Verified:            types.BoolValue(response.Verified),
 		Domain:              types.StringValue(response.Name),
 		GitBranch:           types.StringPointerValue(response.GitBranch),
 		CustomEnvironmentID: types.StringPointerValue(response.CustomEnvironmentID),
@@ -161,6 +198,74 @@ func (p *ProjectDomain) toUpdateRequest() client.UpdateProjectDomainRequest {
 	}
 }
 
+// ModifyPlan checks that the project belongs to the configured team, and checks the CAA records of a domain that is
+// about to be added, if `check_caa` is set. CAA records that do not allow Vercel's certificate authorities are a
+// common reason for a domain to be added without a certificate ever being issued, so this surfaces the problem
+// before anything is changed.
+func (r *projectDomainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+	var plan ProjectDomain
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.ProjectID.IsUnknown() && referencesChanged(ctx, req, "project_id", "team_id") {
+		resp.Diagnostics.Append(checkProjectTeam(ctx, r.client, plan.ProjectID.ValueString(), plan.TeamID.ValueString())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !plan.CheckCAA.ValueBool() || plan.Domain.IsUnknown() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var state ProjectDomain
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// Only check domains that are being added, rather than on every plan.
+		if state.Domain.ValueString() == plan.Domain.ValueString() {
+			return
+		}
+	}
+
+	domain := plan.Domain.ValueString()
+	records, err := r.client.LookupCAA(ctx, domain)
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("check_caa"),
+			"Unable to check CAA records",
+			fmt.Sprintf("Could not check the CAA records of %s, so they have not been checked: %s", domain, err),
+		)
+		return
+	}
+	blocking := client.BlockingCAARecords(domain, records)
+	if len(blocking) == 0 {
+		return
+	}
+	var offending []string
+	for _, record := range blocking {
+		offending = append(offending, record.String())
+	}
+	resp.Diagnostics.AddAttributeError(
+		path.Root("domain"),
+		"CAA records block certificate issuance",
+		fmt.Sprintf(
+			"The domain %s has CAA records that do not allow Vercel's certificate authorities (%s) to issue a certificate for it:\n  %s\n\nAdd a CAA record allowing %s, for example `0 issue \"letsencrypt.org\"`, or remove the records above.",
+			domain,
+			strings.Join(client.CertificateAuthorities, ", "),
+			strings.Join(offending, "\n  "),
+			client.CertificateAuthorities[0],
+		),
+	)
+}
+
 // Create will create a project domain within Vercel.
 // This is called automatically by the provider when a new resource should be created.
 func (r *projectDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -207,7 +312,7 @@ func (r *projectDomainResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	result := convertResponseToProjectDomain(out)
+	result := convertResponseToProjectDomain(out, plan)
 	tflog.Info(ctx, "added domain to project", map[string]any{
 		"project_id": result.ProjectID.ValueString(),
 		"domain":     result.Domain.ValueString(),
@@ -219,6 +324,12 @@ func (r *projectDomainResource) Create(ctx context.Context, req resource.CreateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// The domain is saved to state first, so that a certificate that is never issued taints the resource rather
+	// than leaving the domain attached but unmanaged.
+	if plan.WaitForCertificate.ValueBool() {
+		resp.Diagnostics.Append(r.waitForCertificate(ctx, result)...)
+	}
 }
 
 // Read will read a project domain from the vercel API and provide terraform with information about it.
@@ -232,6 +343,10 @@ func (r *projectDomainResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	out, err := r.client.GetProjectDomain(ctx, state.ProjectID.ValueString(), state.Domain.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) && projectPendingDeletion(ctx, r.client, state.ProjectID.ValueString(), state.TeamID.ValueString()) {
+		addProjectPendingDeletionWarning(&resp.Diagnostics, "domain "+state.Domain.ValueString(), state.ProjectID.ValueString())
+		return
+	}
 	if client.NotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
@@ -248,7 +363,7 @@ func (r *projectDomainResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	result := convertResponseToProjectDomain(out)
+	result := convertResponseToProjectDomain(out, state)
 	tflog.Info(ctx, "read project domain", map[string]any{
 		"project_id": result.ProjectID.ValueString(),
 		"domain":     result.Domain.ValueString(),
@@ -290,7 +405,7 @@ func (r *projectDomainResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	result := convertResponseToProjectDomain(out)
+	result := convertResponseToProjectDomain(out, plan)
 	tflog.Info(ctx, "update project domain", map[string]any{
 		"project_id": result.ProjectID.ValueString(),
 		"domain":     result.Domain.ValueString(),
@@ -302,6 +417,10 @@ func (r *projectDomainResource) Update(ctx context.Context, req resource.UpdateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	if plan.WaitForCertificate.ValueBool() {
+		resp.Diagnostics.Append(r.waitForCertificate(ctx, result)...)
+	}
 }
 
 // Delete will remove a project domain via the Vercel API.
@@ -363,7 +482,11 @@ func (r *projectDomainResource) ImportState(ctx context.Context, req resource.Im
 		return
 	}
 
-	result := convertResponseToProjectDomain(out)
+	result := convertResponseToProjectDomain(out, ProjectDomain{
+		WaitForCertificate: types.BoolNull(),
+		CertificateTimeout: types.StringNull(),
+		CheckCAA:           types.BoolNull(),
+	})
 	tflog.Info(ctx, "imported project domain", map[string]any{
 		"project_id": result.ProjectID.ValueString(),
 		"domain":     result.Domain.ValueString(),
@@ -376,3 +499,111 @@ func (r *projectDomainResource) ImportState(ctx context.Context, req resource.Im
 		return
 	}
 }
+
+// defaultCertificateTimeout is how long to wait for a certificate if `certificate_timeout` is not set.
+const defaultCertificateTimeout = 10 * time.Minute
+
+// checkCertificate connects to the domain and checks that it serves a certificate that is trusted and valid for it.
+func checkCertificate(ctx context.Context, domain string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	dialer := tls.Dialer{
+		Config: &tls.Config{
+			ServerName: domain,
+		},
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(domain, "443"))
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// waitForCertificate polls a project domain until it serves a valid certificate. If it does not within the timeout,
+// the error explains what is most likely stopping the certificate from being issued.
+func (r *projectDomainResource) waitForCertificate(ctx context.Context, pd ProjectDomain) diag.Diagnostics {
+	var diags diag.Diagnostics
+	timeout := defaultCertificateTimeout
+	if !pd.CertificateTimeout.IsNull() {
+		// The duration has already been validated.
+		timeout, _ = time.ParseDuration(pd.CertificateTimeout.ValueString())
+	}
+	domain := pd.Domain.ValueString()
+	deadline := time.Now().Add(timeout)
+
+	progress := client.NewProgress("waiting for certificate for " + domain)
+	var err error
+	for {
+		err = checkCertificate(ctx, domain)
+		if err == nil {
+			progress.Done(ctx)
+			return diags
+		}
+		if time.Now().Add(15 * time.Second).After(deadline) {
+			break
+		}
+		progress.Step(ctx, "certificate not ready", map[string]any{
+			"domain": domain,
+			"error":  err.Error(),
+		})
+		select {
+		case <-ctx.Done():
+			diags.AddError(
+				"Error waiting for certificate",
+				fmt.Sprintf("Stopped waiting for a certificate for %s: %s", domain, ctx.Err()),
+			)
+			return diags
+		case <-time.After(15 * time.Second):
+		}
+	}
+
+	hints := r.certificateHints(ctx, pd)
+	diags.AddError(
+		"Error waiting for certificate",
+		fmt.Sprintf(
+			"The domain %s was added to project %s, but is not serving a valid certificate after %s: %s\n\n%s",
+			domain,
+			pd.ProjectID.ValueString(),
+			timeout,
+			err,
+			"The most likely causes are:\n  - "+strings.Join(hints, "\n  - "),
+		),
+	)
+	return diags
+}
+
+// certificateHints describes the problems that may be stopping a certificate from being issued for a project domain.
+func (r *projectDomainResource) certificateHints(ctx context.Context, pd ProjectDomain) []string {
+	var hints []string
+	domain := pd.Domain.ValueString()
+
+	out, err := r.client.GetProjectDomain(ctx, pd.ProjectID.ValueString(), domain, pd.TeamID.ValueString())
+	if err == nil && !out.Verified {
+		hint := "The domain has not been verified. Add the following DNS records to prove ownership of it:"
+		for _, v := range out.Verification {
+			hint += fmt.Sprintf("\n      %s %s %s", v.Type, v.Domain, v.Value)
+		}
+		hints = append(hints, hint)
+	}
+
+	config, err := r.client.GetDomainConfig(ctx, domain, pd.TeamID.ValueString())
+	if err == nil && config.Misconfigured {
+		hints = append(hints, "The DNS records of the domain do not point to Vercel. Add an A record of 76.76.21.21 for an apex domain, or a CNAME record of cname.vercel-dns.com for a subdomain, or delegate the domain to Vercel's nameservers.")
+	}
+
+	records, err := r.client.LookupCAA(ctx, domain)
+	if err != nil {
+		hints = append(hints, "The domain, or its parent domain, may have CAA records that do not allow letsencrypt.org to issue certificates. Either add a CAA record of `0 issue \"letsencrypt.org\"`, or remove the CAA records.")
+	} else if blocking := client.BlockingCAARecords(domain, records); len(blocking) > 0 {
+		hint := "The following CAA records do not allow letsencrypt.org to issue certificates. Either add a CAA record of `0 issue \"letsencrypt.org\"`, or remove them:"
+		for _, record := range blocking {
+			hint += "\n      " + record.String()
+		}
+		hints = append(hints, hint)
+	}
+
+	if len(hints) == 0 {
+		hints = append(hints, "The certificate is still being issued. Try increasing `certificate_timeout`.")
+	}
+	return hints
+}
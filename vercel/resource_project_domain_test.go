@@ -89,6 +89,27 @@ func TestAcc_ProjectDomainCustomEnvironment(t *testing.T) {
 	})
 }
 
+func TestAcc_ProjectDomainWaitForCertificate(t *testing.T) {
+	projectSuffix := acctest.RandString(16)
+	domain := acctest.RandString(30) + ".vercel.app"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             noopDestroyCheck,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccProjectDomainConfigWaitForCertificate(projectSuffix, domain)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectDomainExists(testClient(t), "vercel_project.test", testTeam(t), domain),
+					resource.TestCheckResourceAttr("vercel_project_domain.test", "wait_for_certificate", "true"),
+					resource.TestCheckResourceAttr("vercel_project_domain.test", "check_caa", "true"),
+					resource.TestCheckResourceAttr("vercel_project_domain.test", "verified", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccProjectDomainExists(testClient *client.Client, n, teamID, domain string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -254,3 +275,19 @@ resource "vercel_project_domain" "test" {
 }
 `, randomSuffix, githubRepo)
 }
+
+func testAccProjectDomainConfigWaitForCertificate(projectSuffix, domain string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-domain-cert-%s"
+}
+
+resource "vercel_project_domain" "test" {
+  domain               = "%s"
+  project_id           = vercel_project.test.id
+  check_caa            = true
+  wait_for_certificate = true
+  certificate_timeout  = "5m"
+}
+`, projectSuffix, domain)
+}
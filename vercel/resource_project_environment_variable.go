@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
@@ -28,6 +30,7 @@ var (
 	_ resource.ResourceWithConfigure   = &projectEnvironmentVariableResource{}
 	_ resource.ResourceWithImportState = &projectEnvironmentVariableResource{}
 	_ resource.ResourceWithModifyPlan  = &projectEnvironmentVariableResource{}
+	_ resource.ResourceWithMoveState   = &projectEnvironmentVariableResource{}
 )
 
 func newProjectEnvironmentVariableResource() resource.Resource {
@@ -72,6 +75,8 @@ For more detailed information, please see the [Vercel documentation](https://ver
 
 ~> Terraform currently provides this Project Environment Variable resource (a single Environment Variable), a Project Environment Variables resource (multiple Environment Variables), and a Project resource with Environment Variables defined in-line via the ` + "`environment` field" + `.
 At this time you cannot use a Vercel Project resource with in-line ` + "`environment` in conjunction with any `vercel_project_environment_variables` or `vercel_project_environment_variable`" + ` resources. Doing so will cause a conflict of settings and will overwrite Environment Variables.
+
+A ` + "`vercel_project_environment_variables`" + ` resource with a single Environment Variable can be moved to this resource with a ` + "`moved`" + ` block, without the Environment Variable being deleted and created again.
 `,
 		Attributes: map[string]schema.Attribute{
 			"target": schema.SetAttribute{
@@ -80,10 +85,13 @@ At this time you cannot use a Vercel Project resource with in-line ` + "`environ
 				PlanModifiers: []planmodifier.Set{
 					setplanmodifier.UseStateForUnknown(),
 				},
-				Description: "The environments that the Environment Variable should be present on. Valid targets are either `production`, `preview`, or `development`. At least one of `target` or `custom_environment_ids` must be set.",
+				Description: "The environments that the Environment Variable should be present on. Valid targets are `production`, `preview`, `development`, or the slug of a Custom Environment on the project, such as `staging`. Custom Environment slugs are resolved to `custom_environment_ids` when planning, and cannot be combined with `custom_environment_ids`. At least one of `target` or `custom_environment_ids` must be set.",
 				ElementType: types.StringType,
 				Validators: []validator.Set{
-					setvalidator.ValueStringsAre(stringvalidator.OneOf("production", "preview", "development")),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(
+						customEnvironmentSlugRe,
+						"must be `production`, `preview`, `development`, or the slug of a Custom Environment",
+					)),
 					setvalidator.AtLeastOneOf(
 						path.MatchRoot("custom_environment_ids"),
 						path.MatchRoot("target"),
@@ -94,7 +102,7 @@ At this time you cannot use a Vercel Project resource with in-line ` + "`environ
 				Optional:      true,
 				Computed:      true,
 				ElementType:   types.StringType,
-				Description:   "The IDs of Custom Environments that the Environment Variable should be present on. At least one of `target` or `custom_environment_ids` must be set.",
+				Description:   "The IDs of Custom Environments that the Environment Variable should be present on. Computed from `target` if it contains Custom Environment slugs. At least one of `target` or `custom_environment_ids` must be set.",
 				PlanModifiers: []planmodifier.Set{setplanmodifier.RequiresReplace(), setplanmodifier.UseStateForUnknown()},
 				Validators: []validator.Set{
 					setvalidator.SizeAtLeast(1),
@@ -107,11 +115,14 @@ At this time you cannot use a Vercel Project resource with in-line ` + "`environ
 			"key": schema.StringAttribute{
 				Required:      true,
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
-				Description:   "The name of the Environment Variable.",
+				Description:   "The name of the Environment Variable. Names can only contain letters, digits and underscores, and names that Vercel reserves, such as `TZ`, cannot be used.",
+				Validators: []validator.String{
+					validateEnvVariableKey(),
+				},
 			},
 			"value": schema.StringAttribute{
 				Required:    true,
-				Description: "The value of the Environment Variable.",
+				Description: "The value of the Environment Variable. As the value of a sensitive Environment Variable cannot be read, a change made to it outside of Terraform is detected from the time it was last updated, and the configured value is applied again.",
 				Sensitive:   true,
 				WriteOnly: true,
 			},
@@ -119,6 +130,10 @@ At this time you cannot use a Vercel Project resource with in-line ` + "`environ
 				Optional:    true,
 				Description: "The git branch of the Environment Variable.",
 			},
+			"effective_git_branch": schema.StringAttribute{
+				Computed:    true,
+				Description: "The git branch the Environment Variable is scoped to, exactly as returned by Vercel. Differences in case from `git_branch`, or an empty branch, are not reported as drift. Null if the variable is not scoped to a branch.",
+			},
 			"project_id": schema.StringAttribute{
 				Required:      true,
 				Description:   "The ID of the Vercel project.",
@@ -150,6 +165,18 @@ At this time you cannot use a Vercel Project resource with in-line ` + "`environ
 					stringvalidator.LengthBetween(0, 1000),
 				},
 			},
+			"retain_on_delete": schema.BoolAttribute{
+				Description: "When `true`, destroying this resource only removes it from Terraform state, and the Environment Variable is left in place on the Vercel project. If the resource is replaced, such as when `value` changes, the retained Environment Variable is updated in place rather than created again. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"exclude_development_target": schema.BoolAttribute{
+				Description: "When `true`, the `development` target is not managed by Terraform. `target` may not include `development`, and any `development` target added outside of Terraform, such as with `vercel env add`, is preserved and not reported as drift. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -159,6 +186,7 @@ type ProjectEnvironmentVariable struct {
 	Target               types.Set    `tfsdk:"target"`
 	CustomEnvironmentIDs types.Set    `tfsdk:"custom_environment_ids"`
 	GitBranch            types.String `tfsdk:"git_branch"`
+	EffectiveGitBranch   types.String `tfsdk:"effective_git_branch"`
 	Key                  types.String `tfsdk:"key"`
 	Value                types.String `tfsdk:"value"`
 	TeamID               types.String `tfsdk:"team_id"`
@@ -166,6 +194,8 @@ type ProjectEnvironmentVariable struct {
 	ID                   types.String `tfsdk:"id"`
 	Sensitive            types.Bool   `tfsdk:"sensitive"`
 	Comment              types.String `tfsdk:"comment"`
+	RetainOnDelete       types.Bool   `tfsdk:"retain_on_delete"`
+	ExcludeDevelopment   types.Bool   `tfsdk:"exclude_development_target"`
 }
 
 func (r *projectEnvironmentVariableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
@@ -179,6 +209,55 @@ func (r *projectEnvironmentVariableResource) ModifyPlan(ctx context.Context, req
 		return
 	}
 
+	if config.ExcludeDevelopment.ValueBool() && targetsDevelopment(config.Target) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("target"),
+			"Project Environment Variable Invalid",
+			"The `development` target cannot be used when `exclude_development_target` is `true`.",
+		)
+		return
+	}
+
+	if r.client != nil && !config.ProjectID.IsUnknown() && referencesChanged(ctx, req, "project_id", "team_id") {
+		resp.Diagnostics.Append(checkProjectTeam(ctx, r.client, config.ProjectID.ValueString(), config.TeamID.ValueString())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if r.client != nil && !config.ProjectID.IsUnknown() {
+		// Check any newly referenced custom environments exist, as the API error for an unknown ID is opaque.
+		prior := types.SetNull(types.StringType)
+		if !referencesChanged(ctx, req, "project_id", "team_id") {
+			diags = req.State.GetAttribute(ctx, path.Root("custom_environment_ids"), &prior)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		diags = validateCustomEnvironmentIDs(
+			ctx,
+			r.client,
+			config.ProjectID.ValueString(),
+			config.TeamID.ValueString(),
+			[]client.EnvironmentVariableRequest{{
+				Key:                  config.Key.ValueString(),
+				CustomEnvironmentIDs: newCustomEnvironmentIDs(config.CustomEnvironmentIDs, prior),
+			}},
+			func(int) path.Path { return path.Root("custom_environment_ids") },
+		)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = r.planCustomEnvironmentSlugs(ctx, config, req, resp)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
     prefix := fmt.Sprintf("vercel_env_%s_%s_", config.ProjectID.ValueString(), config.TeamID.ValueString())
 	hash := sha256.Sum256([]byte(config.Value.ValueString()))
     privateKey := prefix + config.Key.ValueString()
@@ -190,6 +269,19 @@ func (r *projectEnvironmentVariableResource) ModifyPlan(ctx context.Context, req
 			path.Root("value"))
 	}
 
+	if !req.State.Raw.IsNull() && envVariableChangedOutside(ctx, req.Private, config.ProjectID.ValueString(), config.TeamID.ValueString(), config.Key.ValueString()) {
+		// The value is write-only, so plan the ID as unknown for the configured value to be applied again.
+		resp.Diagnostics.AddWarning(
+			"Project Environment Variable changed outside of Terraform",
+			fmt.Sprintf("The Environment Variable %s has been updated outside of Terraform. Its value cannot be read, so it will be set to the configured value again.", config.Key.ValueString()),
+		)
+		diags = resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if config.ID.ValueString() != "" {
 		// The resource already exists, so this is okay.
 		return
@@ -222,12 +314,103 @@ func (r *projectEnvironmentVariableResource) ModifyPlan(ctx context.Context, req
 	)
 }
 
+// customEnvironmentSlugRe matches the built-in targets as well as Custom Environment slugs.
+var customEnvironmentSlugRe = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// planCustomEnvironmentSlugs resolves any Custom Environment slugs in `target` to their IDs, and plans them as the
+// `custom_environment_ids`. This is also done when slugs have been removed from `target`, so that the Custom
+// Environments they referred to are removed too.
+func (r *projectEnvironmentVariableResource) planCustomEnvironmentSlugs(ctx context.Context, config ProjectEnvironmentVariable, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) (diags diag.Diagnostics) {
+	if config.Target.IsUnknown() {
+		return nil
+	}
+	slugs := customEnvironmentSlugs(config.Target)
+
+	var state ProjectEnvironmentVariable
+	hasState := !req.State.Raw.IsNull()
+	if hasState {
+		diags = req.State.Get(ctx, &state)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	stateHasSlugs := hasState && len(customEnvironmentSlugs(state.Target)) > 0
+	if len(slugs) == 0 && !stateHasSlugs {
+		return nil
+	}
+
+	if len(slugs) > 0 && !config.CustomEnvironmentIDs.IsNull() {
+		diags.AddAttributeError(
+			path.Root("target"),
+			"Project Environment Variable Invalid",
+			"Custom Environment slugs in `target` cannot be combined with `custom_environment_ids`. Please use one or the other.",
+		)
+		return diags
+	}
+	if !config.CustomEnvironmentIDs.IsNull() {
+		return nil
+	}
+	if config.ProjectID.IsUnknown() || config.TeamID.IsUnknown() {
+		return resp.Plan.SetAttribute(ctx, path.Root("custom_environment_ids"), types.SetUnknown(types.StringType))
+	}
+
+	ids, diags := resolveCustomEnvironmentSlugs(ctx, r.client, config.ProjectID.ValueString(), config.TeamID.ValueString(), slugs, path.Root("target"))
+	if diags.HasError() {
+		return diags
+	}
+	var values []attr.Value
+	for _, id := range ids {
+		values = append(values, types.StringValue(id))
+	}
+	planned := types.SetValueMust(types.StringType, values)
+	if hasState && !state.CustomEnvironmentIDs.Equal(planned) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("custom_environment_ids"))
+	}
+	return resp.Plan.SetAttribute(ctx, path.Root("custom_environment_ids"), planned)
+}
+
+// withCustomEnvironmentSlugs adds back any Custom Environment slugs from target to the result, as the API only
+// returns the IDs of Custom Environments.
+func (r *projectEnvironmentVariableResource) withCustomEnvironmentSlugs(ctx context.Context, result *ProjectEnvironmentVariable, target types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	slugs := customEnvironmentSlugs(target)
+	if len(slugs) == 0 {
+		return nil
+	}
+	customEnvironments, err := r.client.ListCustomEnvironments(ctx, client.ListCustomEnvironmentsRequest{
+		ProjectID: result.ProjectID.ValueString(),
+		TeamID:    result.TeamID.ValueString(),
+	})
+	if err != nil {
+		diags.AddError(
+			"Error reading custom environments",
+			"Could not list project custom environments, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+	slugsByID := map[string]string{}
+	for _, ce := range customEnvironments {
+		slugsByID[ce.ID] = ce.Slug
+	}
+
+	values := result.Target.Elements()
+	for _, id := range result.CustomEnvironmentIDs.Elements() {
+		slug, ok := slugsByID[id.(types.String).ValueString()]
+		if ok && contains(slugs, slug) {
+			values = append(values, types.StringValue(slug))
+		}
+	}
+	result.Target = types.SetValueMust(types.StringType, values)
+	return nil
+}
+
 func (e *ProjectEnvironmentVariable) toCreateEnvironmentVariableRequest(ctx context.Context) (req client.CreateEnvironmentVariableRequest, diags diag.Diagnostics) {
 	var target []string
 	diags = e.Target.ElementsAs(ctx, &target, true)
 	if diags.HasError() {
 		return req, diags
 	}
+	target, _ = splitTargets(target)
 	var customEnvironmentIDs []string
 	diags = e.CustomEnvironmentIDs.ElementsAs(ctx, &customEnvironmentIDs, true)
 	if diags.HasError() {
@@ -261,6 +444,7 @@ func (e *ProjectEnvironmentVariable) toUpdateEnvironmentVariableRequest(ctx cont
 	if diags.HasError() {
 		return r, diags
 	}
+	target, _ = splitTargets(target)
 	var customEnvironmentIDs []string
 	diags = e.CustomEnvironmentIDs.ElementsAs(ctx, &customEnvironmentIDs, true)
 	if diags.HasError() {
@@ -290,7 +474,7 @@ func (e *ProjectEnvironmentVariable) toUpdateEnvironmentVariableRequest(ctx cont
 // convertResponseToProjectEnvironmentVariable is used to populate terraform state based on an API response.
 // Where possible, values from the API response are used to populate state. If not possible,
 // values from plan are used.
-func convertResponseToProjectEnvironmentVariable(response client.EnvironmentVariable, projectID types.String, v types.String) ProjectEnvironmentVariable {
+func convertResponseToProjectEnvironmentVariable(response client.EnvironmentVariable, projectID types.String, v types.String, gitBranch types.String) ProjectEnvironmentVariable {
 	var target []attr.Value
 	for _, t := range response.Target {
 		target = append(target, types.StringValue(t))
@@ -309,7 +493,8 @@ func convertResponseToProjectEnvironmentVariable(response client.EnvironmentVari
 	return ProjectEnvironmentVariable{
 		Target:               types.SetValueMust(types.StringType, target),
 		CustomEnvironmentIDs: types.SetValueMust(types.StringType, customEnvironmentIDs),
-		GitBranch:            types.StringPointerValue(response.GitBranch),
+		GitBranch:            gitBranchValue(gitBranch, response.GitBranch),
+		EffectiveGitBranch:   gitBranchValue(types.StringNull(), response.GitBranch),
 		Key:                  types.StringValue(response.Key),
 		Value:                value,
 		TeamID:               toTeamID(response.TeamID),
@@ -344,7 +529,38 @@ func (r *projectEnvironmentVariableResource) Create(ctx context.Context, req res
 		resp.Diagnostics.Append(diags...)
 		return
 	}
-	response, err := r.client.CreateEnvironmentVariable(ctx, request)
+	ids, diags := resolveCustomEnvironmentSlugs(
+		ctx,
+		r.client,
+		plan.ProjectID.ValueString(),
+		plan.TeamID.ValueString(),
+		customEnvironmentSlugs(plan.Target),
+		path.Root("target"),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	request.EnvironmentVariable.CustomEnvironmentIDs = append(request.EnvironmentVariable.CustomEnvironmentIDs, ids...)
+	diags = validateCustomEnvironmentIDs(
+		ctx,
+		r.client,
+		plan.ProjectID.ValueString(),
+		plan.TeamID.ValueString(),
+		[]client.EnvironmentVariableRequest{request.EnvironmentVariable},
+		func(int) path.Path { return path.Root("custom_environment_ids") },
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var retainOnDelete types.Bool
+	diags = req.Plan.GetAttribute(ctx, path.Root("retain_on_delete"), &retainOnDelete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	response, err := r.createOrAdoptEnvironmentVariable(ctx, request, retainOnDelete.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating project environment variable",
@@ -353,13 +569,31 @@ func (r *projectEnvironmentVariableResource) Create(ctx context.Context, req res
 		return
 	}
 
-	result := convertResponseToProjectEnvironmentVariable(response, plan.ProjectID, plan.Value)
+	result := convertResponseToProjectEnvironmentVariable(response, plan.ProjectID, plan.Value, plan.GitBranch)
+	diags = r.withCustomEnvironmentSlugs(ctx, &result, plan.Target)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The value is read from config as it is write-only, so take the defaulted retain_on_delete from the plan.
+	diags = req.Plan.GetAttribute(ctx, path.Root("retain_on_delete"), &result.RetainOnDelete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.Plan.GetAttribute(ctx, path.Root("exclude_development_target"), &result.ExcludeDevelopment)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Set the hash of the environment variable value in the private state.
 	prefix := fmt.Sprintf("vercel_env_%s_%s_", plan.ProjectID.ValueString(), plan.TeamID.ValueString())
 	hash := sha256.Sum256([]byte(plan.Value.ValueString()))
 	privateKey := prefix + plan.Key.ValueString()
 	resp.Private.SetKey(ctx, privateKey, []byte(fmt.Sprintf("\"%x\"", hash)))
+	diags = recordEnvVariableWrite(ctx, resp.Private, plan.ProjectID.ValueString(), plan.TeamID.ValueString(), response.Key, response)
+	resp.Diagnostics.Append(diags...)
 
 	tflog.Info(ctx, "created project environment variable", map[string]any{
 		"id":         result.ID.ValueString(),
@@ -374,6 +608,54 @@ func (r *projectEnvironmentVariableResource) Create(ctx context.Context, req res
 	}
 }
 
+// createOrAdoptEnvironmentVariable creates the environment variable. When adopt is set, an existing environment
+// variable that it would conflict with, such as the one left in place by retain_on_delete when this resource is
+// replaced, is updated instead.
+func (r *projectEnvironmentVariableResource) createOrAdoptEnvironmentVariable(ctx context.Context, request client.CreateEnvironmentVariableRequest, adopt bool) (client.EnvironmentVariable, error) {
+	if !adopt {
+		return r.client.CreateEnvironmentVariable(ctx, request)
+	}
+	envs, err := r.client.GetEnvironmentVariables(ctx, request.ProjectID, request.TeamID)
+	if err != nil {
+		return client.EnvironmentVariable{}, fmt.Errorf("unable to list environment variables: %w", err)
+	}
+	existing, ok := findRetainedEnvironmentVariable(envs, request.EnvironmentVariable)
+	if !ok {
+		return r.client.CreateEnvironmentVariable(ctx, request)
+	}
+	tflog.Info(ctx, "adopting retained project environment variable", map[string]any{
+		"id":         existing.ID,
+		"team_id":    request.TeamID,
+		"project_id": request.ProjectID,
+	})
+	v := request.EnvironmentVariable
+	return r.client.UpdateEnvironmentVariable(ctx, client.UpdateEnvironmentVariableRequest{
+		Value:                v.Value,
+		Target:               v.Target,
+		CustomEnvironmentIDs: v.CustomEnvironmentIDs,
+		GitBranch:            v.GitBranch,
+		Type:                 v.Type,
+		Comment:              v.Comment,
+		ProjectID:            request.ProjectID,
+		TeamID:               request.TeamID,
+		EnvID:                existing.ID,
+	})
+}
+
+// findRetainedEnvironmentVariable returns the environment variable that creating the requested one would conflict
+// with: one with the same key, sensitivity and git branch, that shares a target or custom environment.
+func findRetainedEnvironmentVariable(envs []client.EnvironmentVariable, request client.EnvironmentVariableRequest) (client.EnvironmentVariable, bool) {
+	for _, e := range envs {
+		if e.Key != request.Key || (e.Type == "sensitive") != (request.Type == "sensitive") || !sameGitBranch(e.GitBranch, request.GitBranch) {
+			continue
+		}
+		if len(intersectStrings(e.Target, request.Target)) > 0 || len(intersectStrings(e.CustomEnvironmentIDs, request.CustomEnvironmentIDs)) > 0 {
+			return e, true
+		}
+	}
+	return client.EnvironmentVariable{}, false
+}
+
 // Read will read an environment variable of a Vercel project by requesting it from the Vercel API, and will update terraform
 // with this information.
 func (r *projectEnvironmentVariableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -385,6 +667,10 @@ func (r *projectEnvironmentVariableResource) Read(ctx context.Context, req resou
 	}
 
 	out, err := r.client.GetEnvironmentVariable(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString(), state.ID.ValueString())
+	if client.NotFound(err) && projectPendingDeletion(ctx, r.client, state.ProjectID.ValueString(), state.TeamID.ValueString()) {
+		addProjectPendingDeletionWarning(&resp.Diagnostics, "environment variable "+state.Key.ValueString(), state.ProjectID.ValueString())
+		return
+	}
 	if client.NotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
@@ -402,7 +688,19 @@ func (r *projectEnvironmentVariableResource) Read(ctx context.Context, req resou
 		return
 	}
 
-	result := convertResponseToProjectEnvironmentVariable(out, state.ProjectID, state.Value)
+	diags = detectEnvVariableChangedOutside(ctx, resp.Private, state.ProjectID.ValueString(), state.TeamID.ValueString(), out.Key, out)
+	resp.Diagnostics.Append(diags...)
+	if state.ExcludeDevelopment.ValueBool() {
+		out = withoutDevelopmentTarget(out)
+	}
+	result := convertResponseToProjectEnvironmentVariable(out, state.ProjectID, state.Value, state.GitBranch)
+	diags = r.withCustomEnvironmentSlugs(ctx, &result, state.Target)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	result.RetainOnDelete = state.RetainOnDelete
+	result.ExcludeDevelopment = state.ExcludeDevelopment
 	tflog.Info(ctx, "read project environment variable", map[string]any{
 		"id":         result.ID.ValueString(),
 		"team_id":    result.TeamID.ValueString(),
@@ -432,7 +730,13 @@ func (r *projectEnvironmentVariableResource) Update(ctx context.Context, req res
 		return
 	}
 
-	// Config contains value (but no ID), but plan contains ID (but no value).
+	// Config contains value (but no ID), and state contains the ID, which may be planned as unknown.
+	var id types.String
+	diags = req.State.GetAttribute(ctx, path.Root("id"), &id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	updateVariable := &ProjectEnvironmentVariable{
 		Target: config.Target,
 		CustomEnvironmentIDs: config.CustomEnvironmentIDs,
@@ -441,16 +745,48 @@ func (r *projectEnvironmentVariableResource) Update(ctx context.Context, req res
 		Value: config.Value,
 		TeamID: config.TeamID,
 		ProjectID: config.ProjectID,
-		ID: plan.ID,
+		ID: id,
 		Sensitive: config.Sensitive,
 		Comment: config.Comment,
 	}
 
+	if len(customEnvironmentSlugs(config.Target)) > 0 {
+		// Custom Environment slugs were resolved to IDs when planning.
+		updateVariable.CustomEnvironmentIDs = plan.CustomEnvironmentIDs
+	}
+
 	request, diags := updateVariable.toUpdateEnvironmentVariableRequest(ctx)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	diags = validateCustomEnvironmentIDs(
+		ctx,
+		r.client,
+		plan.ProjectID.ValueString(),
+		plan.TeamID.ValueString(),
+		[]client.EnvironmentVariableRequest{{Key: config.Key.ValueString(), CustomEnvironmentIDs: request.CustomEnvironmentIDs}},
+		func(int) path.Path { return path.Root("custom_environment_ids") },
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ExcludeDevelopment.ValueBool() {
+		// Keep any development target that was added outside of Terraform.
+		existing, err := r.client.GetEnvironmentVariable(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString(), id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating project environment variable",
+				"Could not read project environment variable, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		if contains(existing.Target, "development") {
+			request.Target = append(request.Target, "development")
+		}
+	}
 
 	response, err := r.client.UpdateEnvironmentVariable(ctx, request)
 	if err != nil {
@@ -461,7 +797,20 @@ func (r *projectEnvironmentVariableResource) Update(ctx context.Context, req res
 		return
 	}
 
-	result := convertResponseToProjectEnvironmentVariable(response, plan.ProjectID, plan.Value)
+	diags = recordEnvVariableWrite(ctx, resp.Private, plan.ProjectID.ValueString(), plan.TeamID.ValueString(), response.Key, response)
+	resp.Diagnostics.Append(diags...)
+
+	if plan.ExcludeDevelopment.ValueBool() {
+		response = withoutDevelopmentTarget(response)
+	}
+	result := convertResponseToProjectEnvironmentVariable(response, plan.ProjectID, plan.Value, plan.GitBranch)
+	diags = r.withCustomEnvironmentSlugs(ctx, &result, plan.Target)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	result.RetainOnDelete = plan.RetainOnDelete
+	result.ExcludeDevelopment = plan.ExcludeDevelopment
 
 	tflog.Info(ctx, "updated project environment variable", map[string]any{
 		"id":         result.ID.ValueString(),
@@ -485,6 +834,15 @@ func (r *projectEnvironmentVariableResource) Delete(ctx context.Context, req res
 		return
 	}
 
+	if state.RetainOnDelete.ValueBool() {
+		tflog.Info(ctx, "retaining project environment variable on delete", map[string]any{
+			"id":         state.ID.ValueString(),
+			"team_id":    state.TeamID.ValueString(),
+			"project_id": state.ProjectID.ValueString(),
+		})
+		return
+	}
+
 	err := r.client.DeleteEnvironmentVariable(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString(), state.ID.ValueString())
 	if client.NotFound(err) {
 		return
@@ -538,7 +896,9 @@ func (r *projectEnvironmentVariableResource) ImportState(ctx context.Context, re
 		return
 	}
 
-	result := convertResponseToProjectEnvironmentVariable(out, types.StringValue(projectID), types.StringNull())
+	result := convertResponseToProjectEnvironmentVariable(out, types.StringValue(projectID), types.StringNull(), types.StringNull())
+	result.RetainOnDelete = types.BoolValue(false)
+	result.ExcludeDevelopment = types.BoolValue(false)
 	tflog.Info(ctx, "imported project environment variable", map[string]any{
 		"team_id":    result.TeamID.ValueString(),
 		"project_id": result.ProjectID.ValueString(),
@@ -551,3 +911,78 @@ func (r *projectEnvironmentVariableResource) ImportState(ctx context.Context, re
 		return
 	}
 }
+
+// MoveState allows a `vercel_project_environment_variables` resource with a single Environment Variable to be moved
+// to a `vercel_project_environment_variable` resource with a `moved` block, without the Environment Variable being
+// deleted and created again.
+func (r *projectEnvironmentVariableResource) MoveState(ctx context.Context) []resource.StateMover {
+	var source resource.SchemaResponse
+	(&projectEnvironmentVariablesResource{}).Schema(ctx, resource.SchemaRequest{}, &source)
+	return []resource.StateMover{
+		{
+			SourceSchema: &source.Schema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "vercel_project_environment_variables" || !strings.HasSuffix(req.SourceProviderAddress, "/vercel") {
+					return
+				}
+				if req.SourceState == nil {
+					resp.Diagnostics.AddError(
+						"Unable to move Project Environment Variables",
+						"The state of the vercel_project_environment_variables resource could not be read. Please upgrade it with the current version of the provider before moving it.",
+					)
+					return
+				}
+				var state ProjectEnvironmentVariables
+				diags := req.SourceState.Get(ctx, &state)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				envs, diags := state.environment(ctx)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				if len(envs) != 1 {
+					resp.Diagnostics.AddError(
+						"Unable to move Project Environment Variables",
+						fmt.Sprintf("Only a vercel_project_environment_variables resource with a single Environment Variable can be moved to a vercel_project_environment_variable resource, but it has %d. Please reduce it to the Environment Variable being moved first.", len(envs)),
+					)
+					return
+				}
+
+				for key, e := range envs {
+					if !e.EdgeConfigItem.IsNull() {
+						resp.Diagnostics.AddError(
+							"Unable to move Project Environment Variables",
+							fmt.Sprintf("The Environment Variable %s takes its value from an Edge Config item, which is not supported by the vercel_project_environment_variable resource.", key),
+						)
+						return
+					}
+					name := e.name(key)
+					diags = resp.TargetState.Set(ctx, ProjectEnvironmentVariable{
+						Target:               e.Target,
+						CustomEnvironmentIDs: e.CustomEnvironmentIDs,
+						GitBranch:            e.GitBranch,
+						EffectiveGitBranch:   e.GitBranch,
+						Key:                  types.StringValue(name),
+						Value:                types.StringNull(),
+						TeamID:               state.TeamID,
+						ProjectID:            state.ProjectID,
+						ID:                   e.ID,
+						Sensitive:            e.Sensitive,
+						Comment:              e.Comment,
+						RetainOnDelete:       state.RetainOnDelete,
+						ExcludeDevelopment:   state.ExcludeDevelopment,
+					})
+					resp.Diagnostics.Append(diags...)
+					if resp.Diagnostics.HasError() {
+						return
+					}
+					diags = moveEnvVariablePrivateState(ctx, req.SourcePrivate, resp.TargetPrivate, state.ProjectID.ValueString(), state.TeamID.ValueString(), key, name)
+					resp.Diagnostics.Append(diags...)
+				}
+			},
+		},
+	}
+}
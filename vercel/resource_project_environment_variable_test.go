@@ -3,11 +3,15 @@ package vercel_test
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"slices"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/vercel/terraform-provider-vercel/v3/client"
 )
 
@@ -67,12 +71,16 @@ func TestAcc_ProjectEnvironmentVariable(t *testing.T) {
 					resource.TestCheckResourceAttr("vercel_project_environment_variable.example", "value", "bar"),
 					resource.TestCheckTypeSetElemAttr("vercel_project_environment_variable.example", "target.*", "production"),
 					resource.TestCheckResourceAttr("vercel_project_environment_variable.example", "comment", "this is with a comment"),
+					resource.TestCheckResourceAttr("vercel_project_environment_variable.example", "retain_on_delete", "false"),
+					resource.TestCheckResourceAttr("vercel_project_environment_variable.example", "exclude_development_target", "false"),
+					resource.TestCheckNoResourceAttr("vercel_project_environment_variable.example", "effective_git_branch"),
 
 					testAccProjectEnvironmentVariableExists(testClient(t), "vercel_project_environment_variable.example_git_branch", testTeam(t)),
 					resource.TestCheckResourceAttr("vercel_project_environment_variable.example_git_branch", "key", "foo"),
 					resource.TestCheckResourceAttr("vercel_project_environment_variable.example_git_branch", "value", "bar-staging"),
 					resource.TestCheckTypeSetElemAttr("vercel_project_environment_variable.example_git_branch", "target.*", "preview"),
 					resource.TestCheckResourceAttr("vercel_project_environment_variable.example_git_branch", "git_branch", "production"),
+					resource.TestCheckResourceAttr("vercel_project_environment_variable.example_git_branch", "effective_git_branch", "production"),
 
 					testAccProjectEnvironmentVariableExists(testClient(t), "vercel_project_environment_variable.example_sensitive", testTeam(t)),
 					resource.TestCheckResourceAttr("vercel_project_environment_variable.example_sensitive", "key", "foo_sensitive"),
@@ -244,3 +252,424 @@ resource "vercel_project" "example" {
 }
 `, projectName, githubRepo)
 }
+
+func TestAcc_ProjectEnvironmentVariableCustomEnvironmentSlug(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.example", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(fmt.Sprintf(`
+resource "vercel_project" "example" {
+  name = "test-acc-env-slug-%[1]s"
+}
+
+resource "vercel_custom_environment" "staging" {
+  project_id = vercel_project.example.id
+  name       = "staging"
+}
+
+resource "vercel_project_environment_variable" "example" {
+  project_id = vercel_project.example.id
+  key        = "foo"
+  value      = "bar"
+  target     = ["production", "staging"]
+
+  depends_on = [vercel_custom_environment.staging]
+}
+`, nameSuffix)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectEnvironmentVariableExists(testClient(t), "vercel_project_environment_variable.example", testTeam(t)),
+					resource.TestCheckResourceAttr("vercel_project_environment_variable.example", "target.#", "2"),
+					resource.TestCheckTypeSetElemAttr("vercel_project_environment_variable.example", "target.*", "production"),
+					resource.TestCheckTypeSetElemAttr("vercel_project_environment_variable.example", "target.*", "staging"),
+					resource.TestCheckResourceAttr("vercel_project_environment_variable.example", "custom_environment_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("vercel_project_environment_variable.example", "custom_environment_ids.*", "vercel_custom_environment.staging", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectEnvironmentVariableInvalidCustomEnvironment(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	config := func(customEnvironmentIDs string) string {
+		return cfg(fmt.Sprintf(`
+resource "vercel_project" "example" {
+  name = "test-acc-env-invalid-custom-%[1]s"
+}
+
+resource "vercel_custom_environment" "staging" {
+  project_id = vercel_project.example.id
+  name       = "staging"
+}
+
+resource "vercel_project_environment_variable" "example" {
+  project_id             = vercel_project.example.id
+  key                    = "foo"
+  value                  = "bar"
+  custom_environment_ids = %[2]s
+}
+`, nameSuffix, customEnvironmentIDs))
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.example", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config(`[vercel_custom_environment.staging.id]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectEnvironmentVariableExists(testClient(t), "vercel_project_environment_variable.example", testTeam(t)),
+					resource.TestCheckResourceAttr("vercel_project_environment_variable.example", "custom_environment_ids.#", "1"),
+				),
+			},
+			{
+				// The unknown ID is reported when planning, against the attribute that references it.
+				Config:      config(`[vercel_custom_environment.staging.id, "env_doesnotexist"]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`The custom environment "env_doesnotexist" does not exist`),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectEnvironmentVariableMoveState(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	var fooID, barID string
+	storeID := func(id *string) resource.CheckResourceAttrWithFunc {
+		return func(value string) error {
+			*id = value
+			return nil
+		}
+	}
+	sameID := func(id *string) resource.CheckResourceAttrWithFunc {
+		return func(value string) error {
+			if value != *id {
+				return fmt.Errorf("expected the Environment Variable to keep the ID %s, got %s", *id, value)
+			}
+			return nil
+		}
+	}
+	project := fmt.Sprintf(`
+resource "vercel_project" "example" {
+  name = "test-acc-env-move-%s"
+}
+`, nameSuffix)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.example", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(project + `
+resource "vercel_project_environment_variable" "foo" {
+  project_id = vercel_project.example.id
+  key        = "FOO"
+  value      = "foo"
+  target     = ["production"]
+}
+
+resource "vercel_project_environment_variable" "bar" {
+  project_id = vercel_project.example.id
+  key        = "BAR"
+  value      = "bar"
+  target     = ["production"]
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("vercel_project_environment_variable.foo", "id", storeID(&fooID)),
+					resource.TestCheckResourceAttrWith("vercel_project_environment_variable.bar", "id", storeID(&barID)),
+				),
+			},
+			{
+				Config: cfg(project + `
+resource "vercel_project_environment_variables" "all" {
+  project_id = vercel_project.example.id
+  variables = {
+    FOO = {
+      value  = "foo"
+      target = ["production"]
+    }
+    BAR = {
+      value  = "bar"
+      target = ["production"]
+    }
+  }
+}
+
+moved {
+  from = vercel_project_environment_variable.foo
+  to   = vercel_project_environment_variables.all
+}
+
+removed {
+  from = vercel_project_environment_variable.bar
+  lifecycle {
+    destroy = false
+  }
+}
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("vercel_project_environment_variables.all", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("vercel_project_environment_variables.all", "variables.FOO.id", sameID(&fooID)),
+					resource.TestCheckResourceAttrWith("vercel_project_environment_variables.all", "variables.BAR.id", sameID(&barID)),
+				),
+			},
+			{
+				Config: cfg(project + `
+resource "vercel_project_environment_variables" "all" {
+  project_id = vercel_project.example.id
+  variables = {
+    FOO = {
+      value  = "foo"
+      target = ["production"]
+    }
+  }
+}
+`),
+			},
+			{
+				Config: cfg(project + `
+resource "vercel_project_environment_variable" "foo" {
+  project_id = vercel_project.example.id
+  key        = "FOO"
+  value      = "foo"
+  target     = ["production"]
+}
+
+moved {
+  from = vercel_project_environment_variables.all
+  to   = vercel_project_environment_variable.foo
+}
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("vercel_project_environment_variable.foo", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("vercel_project_environment_variable.foo", "id", sameID(&fooID)),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectEnvironmentVariableRetainOnDeleteReplaced(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	var id string
+	config := func(value string) string {
+		return fmt.Sprintf(`
+resource "vercel_project" "example" {
+  name = "test-acc-env-retain-%[1]s"
+}
+
+resource "vercel_project_environment_variable" "example" {
+  project_id       = vercel_project.example.id
+  key              = "FOO"
+  value            = "%[2]s"
+  target           = ["production"]
+  retain_on_delete = true
+}
+`, nameSuffix, value)
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.example", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(config("foo")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectEnvironmentVariableExists(testClient(t), "vercel_project_environment_variable.example", testTeam(t)),
+					resource.TestCheckResourceAttrWith("vercel_project_environment_variable.example", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+			{
+				// Changing the value replaces the resource, which leaves the variable in place, so it is adopted and
+				// updated rather than failing to be created again.
+				Config: cfg(config("bar")),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("vercel_project_environment_variable.example", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectEnvironmentVariableExists(testClient(t), "vercel_project_environment_variable.example", testTeam(t)),
+					resource.TestCheckResourceAttr("vercel_project_environment_variable.example", "value", "bar"),
+					resource.TestCheckResourceAttrWith("vercel_project_environment_variable.example", "id", func(value string) error {
+						if value != id {
+							return fmt.Errorf("expected the retained Environment Variable %s to be adopted, got %s", id, value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectEnvironmentVariableRetainOnDelete(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	var projectID, id string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.example", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(fmt.Sprintf(`
+resource "vercel_project" "example" {
+  name = "test-acc-env-retain-%[1]s"
+}
+
+resource "vercel_project_environment_variable" "example" {
+  project_id       = vercel_project.example.id
+  key              = "FOO"
+  value            = "bar"
+  target           = ["production"]
+  retain_on_delete = true
+}
+`, nameSuffix)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectEnvironmentVariableExists(testClient(t), "vercel_project_environment_variable.example", testTeam(t)),
+					resource.TestCheckResourceAttr("vercel_project_environment_variable.example", "retain_on_delete", "true"),
+					resource.TestCheckResourceAttrWith("vercel_project_environment_variable.example", "project_id", func(value string) error {
+						projectID = value
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("vercel_project_environment_variable.example", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+			{
+				// Removing the resource destroys it, which should leave the variable in place on the project.
+				Config: cfg(fmt.Sprintf(`
+resource "vercel_project" "example" {
+  name = "test-acc-env-retain-%[1]s"
+}
+`, nameSuffix)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("vercel_project_environment_variable.example", plancheck.ResourceActionDestroy),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(*terraform.State) error {
+						_, err := testClient(t).GetEnvironmentVariable(context.TODO(), projectID, testTeam(t), id)
+						if err != nil {
+							return fmt.Errorf("expected Environment Variable %s to be retained, got: %w", id, err)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectEnvironmentVariableExcludeDevelopmentTarget(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	resourceName := "vercel_project_environment_variable.example"
+	config := func(target string) string {
+		return cfg(fmt.Sprintf(`
+resource "vercel_project" "example" {
+  name = "test-acc-env-exclude-dev-%[1]s"
+}
+
+resource "vercel_project_environment_variable" "example" {
+  project_id                 = vercel_project.example.id
+  key                        = "FOO"
+  value                      = "bar"
+  target                     = [%[2]s]
+  sensitive                  = false
+  exclude_development_target = true
+}
+`, nameSuffix, target))
+	}
+
+	var projectID, id string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.example", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config(`"production"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectEnvironmentVariableExists(testClient(t), resourceName, testTeam(t)),
+					resource.TestCheckResourceAttr(resourceName, "exclude_development_target", "true"),
+					resource.TestCheckResourceAttrWith(resourceName, "project_id", func(value string) error {
+						projectID = value
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith(resourceName, "id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+			{
+				// A development target added outside of Terraform, such as with `vercel env add`, is not drift.
+				PreConfig: func() {
+					_, err := testClient(t).UpdateEnvironmentVariable(context.TODO(), client.UpdateEnvironmentVariableRequest{
+						Key:       "FOO",
+						Value:     "bar",
+						Target:    []string{"production", "development"},
+						Type:      "encrypted",
+						ProjectID: projectID,
+						TeamID:    testTeam(t),
+						EnvID:     id,
+					})
+					if err != nil {
+						t.Fatalf("could not update environment variable: %s", err)
+					}
+				},
+				Config: config(`"production"`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "target.#", "1"),
+					func(*terraform.State) error {
+						e, err := testClient(t).GetEnvironmentVariable(context.TODO(), projectID, testTeam(t), id)
+						if err != nil {
+							return err
+						}
+						if !slices.Contains(e.Target, "development") {
+							return fmt.Errorf("expected the development target to be preserved, got %v", e.Target)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config:      config(`"production", "development"`),
+				ExpectError: regexp.MustCompile("The `development` target cannot be used"),
+			},
+		},
+	})
+}
@@ -1,11 +1,13 @@
 package vercel
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -14,11 +16,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/vercel/terraform-provider-vercel/v3/client"
 )
@@ -27,6 +32,7 @@ var (
 	_ resource.Resource               = &projectEnvironmentVariablesResource{}
 	_ resource.ResourceWithConfigure  = &projectEnvironmentVariablesResource{}
 	_ resource.ResourceWithModifyPlan = &projectEnvironmentVariablesResource{}
+	_ resource.ResourceWithMoveState  = &projectEnvironmentVariablesResource{}
 )
 
 func newProjectEnvironmentVariablesResource() resource.Resource {
@@ -71,6 +77,10 @@ For more detailed information, please see the [Vercel documentation](https://ver
 
 ~> Terraform currently provides this Project Environment Variables resource (multiple Environment Variables), a single Project Environment Variable Resource, and a Project resource with Environment Variables defined in-line via the ` + "`environment` field" + `.
 At this time you cannot use a Vercel Project resource with in-line ` + "`environment` in conjunction with any `vercel_project_environment_variables` or `vercel_project_environment_variable`" + ` resources. Doing so will cause a conflict of settings and will overwrite Environment Variables.
+
+An existing ` + "`vercel_project_environment_variable`" + ` resource can be moved into this resource with a ` + "`moved`" + ` block, without the Environment Variable being deleted and created again. Terraform only allows one resource to be moved to each address, so to combine several of them, move one and remove the others from state with ` + "`removed`" + ` blocks that set ` + "`destroy = false`" + `. Environment Variables in ` + "`variables`" + ` that already exist with the same name and target are taken over by this resource and updated in place.
+
+~> If creating some of the Environment Variables fails, the ones that were created are still recorded in state, and the error lists the ones that were not. Terraform always marks a resource as tainted when creating it fails, so the next apply will delete every Environment Variable in the resource, including the ones that were created, and then create them all again. To only create the failed Environment Variables, run ` + "`terraform untaint`" + ` on the resource before applying again.
 `,
 		Attributes: map[string]schema.Attribute{
 			"project_id": schema.StringAttribute{
@@ -84,20 +94,86 @@ At this time you cannot use a Vercel Project resource with in-line ` + "`environ
 				Description:   "The ID of the Vercel team. Required when configuring a team resource if a default team has not been set in the provider.",
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
 			},
+			"retain_on_delete": schema.BoolAttribute{
+				Description: "When `true`, destroying this resource only removes it from Terraform state, and the Environment Variables are left in place on the Vercel project. If the resource is replaced, such as when a value changes, the retained Environment Variables are updated in place rather than created again. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"exclude_development_target": schema.BoolAttribute{
+				Description: "When `true`, the `development` target is not managed by Terraform. `target` may not include `development`, any `development` target added to these Environment Variables outside of Terraform is preserved and not reported as drift, and development-only Environment Variables with the same name are ignored. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"preserve_comment_on_rename": schema.BoolAttribute{
+				Description: "When `true`, an Environment Variable that is renamed keeps its comment, unless a new `comment` is configured. Vercel does not support renaming Environment Variables, so a renamed variable is deleted and created again with the new name. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"update_strategy": schema.StringAttribute{
+				Description: "How Environment Variables that have to be re-created are replaced. With `destroy_before_create`, the existing variable is deleted and the deletion confirmed before the new one is created, so the variable is briefly absent. With `create_before_destroy`, the new variable is created before the existing one is deleted, so both are briefly present. Vercel does not allow two variables with the same name and an overlapping target, so variables whose old and new targets overlap are always replaced using `destroy_before_create`. Defaults to `destroy_before_create`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(updateStrategyDestroyBeforeCreate),
+				Validators: []validator.String{
+					stringvalidator.OneOf(updateStrategyDestroyBeforeCreate, updateStrategyCreateBeforeDestroy),
+				},
+			},
+			"unmanaged_variable_behavior": schema.StringAttribute{
+				Description: "What happens to Environment Variables on the project that are not in `variables`, such as those added in the Vercel dashboard or by an integration. With `ignore`, they are left alone. With `delete`, they are reported as drift when the resource is refreshed, and deleted by the next apply. Variables with the same name as one in `variables` are always left alone. Defaults to `ignore`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(unmanagedVariableBehaviorIgnore),
+				Validators: []validator.String{
+					stringvalidator.OneOf(unmanagedVariableBehaviorIgnore, unmanagedVariableBehaviorDelete),
+				},
+			},
 			"variables": schema.MapNestedAttribute{
 				Required:    true,
-				Description: "A map of Environment Variables that should be configured for the project. The map key is the environment variable name.",
+				Description: "A map of Environment Variables that should be configured for the project. The map key is the environment variable name, unless `key` is set. Names can only contain letters, digits and underscores, and names that Vercel reserves, such as `TZ`, cannot be used.",
+				Validators: []validator.Map{
+					validateEnvVariableKeys(),
+				},
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"id": schema.StringAttribute{
 							Description: "The ID of the Environment Variable.",
 							Computed:    true,
 						},
+						"key": schema.StringAttribute{
+							Optional:    true,
+							Description: "The name of the Environment Variable, if it is not the map key. This allows the same Environment Variable to be defined more than once with different values, for example with the map keys `DATABASE_URL_production` and `DATABASE_URL_preview`, as long as each definition has a different `target`, `custom_environment_ids` or `git_branch`. Changing the map key of a variable re-creates it.",
+							Validators: []validator.String{
+								validateEnvVariableKey(),
+							},
+						},
 						"value": schema.StringAttribute{
-							Required:    true,
-							Description: "The value of the Environment Variable.",
+							Optional:    true,
+							Description: "The value of the Environment Variable. Exactly one of `value` or `edge_config_item` must be set. As the value of a sensitive Environment Variable cannot be read, a change made to it outside of Terraform is detected from the time it was last updated, and the configured value is applied again.",
 							Sensitive:   true,
 							WriteOnly:   true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("value"),
+									path.MatchRelative().AtParent().AtName("edge_config_item"),
+								),
+							},
+						},
+						"edge_config_item": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "An Edge Config item to take the value of the Environment Variable from, instead of setting `value`. The item is read each time a plan is made, so a change to it is applied without having to change `value_version`. String items are used as they are, and other items are used in their JSON form.",
+							Attributes: map[string]schema.Attribute{
+								"edge_config_id": schema.StringAttribute{
+									Description: "The ID of the Edge Config.",
+									Required:    true,
+								},
+								"key": schema.StringAttribute{
+									Description: "The key of the item within the Edge Config.",
+									Required:    true,
+								},
+							},
 						},
 						"target": schema.SetAttribute{
 							Optional:    true,
@@ -136,6 +212,15 @@ At this time you cannot use a Vercel Project resource with in-line ` + "`environ
 							Computed:      true,
 							PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
 						},
+						"type": schema.StringAttribute{
+							Description:   "The type of the Environment Variable: one of `plain`, `encrypted` or `sensitive`. `plain` values are stored as plain text, and are intended for non-secret settings such as build flags. Defaults to `sensitive` if `sensitive` is `true`, and `encrypted` otherwise. Changes made in the Vercel dashboard are detected when this or `sensitive` is set.",
+							Optional:      true,
+							Computed:      true,
+							PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+							Validators: []validator.String{
+								stringvalidator.OneOf("plain", "encrypted", "sensitive"),
+							},
+						},
 						"comment": schema.StringAttribute{
 							Description: "A comment explaining what the environment variable is for.",
 							Optional:    true,
@@ -144,6 +229,10 @@ At this time you cannot use a Vercel Project resource with in-line ` + "`environ
 								stringvalidator.LengthBetween(0, 1000),
 							},
 						},
+						"value_version": schema.StringAttribute{
+							Description: "An arbitrary version for the value of the Environment Variable, such as a number or the date it was rotated. As `value` is write-only, Terraform cannot tell when it has changed outside of the configuration, for example when it is read from a secret store. Changing `value_version` sends the current `value` to Vercel again.",
+							Optional:    true,
+						},
 					},
 				},
 			},
@@ -153,11 +242,24 @@ At this time you cannot use a Vercel Project resource with in-line ` + "`environ
 
 // ProjectEnvironmentVariables reflects the state terraform stores internally for project environment variables.
 type ProjectEnvironmentVariables struct {
-	TeamID    types.String `tfsdk:"team_id"`
-	ProjectID types.String `tfsdk:"project_id"`
-	Variables types.Map    `tfsdk:"variables"`
+	TeamID             types.String `tfsdk:"team_id"`
+	ProjectID          types.String `tfsdk:"project_id"`
+	Variables          types.Map    `tfsdk:"variables"`
+	RetainOnDelete     types.Bool   `tfsdk:"retain_on_delete"`
+	ExcludeDevelopment types.Bool   `tfsdk:"exclude_development_target"`
+	UpdateStrategy     types.String `tfsdk:"update_strategy"`
+	PreserveComment    types.Bool   `tfsdk:"preserve_comment_on_rename"`
+	UnmanagedBehavior  types.String `tfsdk:"unmanaged_variable_behavior"`
 }
 
+const (
+	updateStrategyDestroyBeforeCreate = "destroy_before_create"
+	updateStrategyCreateBeforeDestroy = "create_before_destroy"
+
+	unmanagedVariableBehaviorIgnore = "ignore"
+	unmanagedVariableBehaviorDelete = "delete"
+)
+
 func (p *ProjectEnvironmentVariables) environment(ctx context.Context) (EnvironmentItemsMap, diag.Diagnostics) {
 	if p.Variables.IsNull() {
 		return nil, nil
@@ -168,17 +270,79 @@ func (p *ProjectEnvironmentVariables) environment(ctx context.Context) (Environm
 	return vars, diags
 }
 
+// resolveEdgeConfigItemValues sets the value of each environment variable that references an Edge Config item to
+// the current value of that item. Each Edge Config is only listed once. Where the reference is not yet known, the
+// value is left unknown.
+func resolveEdgeConfigItemValues(ctx context.Context, c *client.Client, teamID string, envs EnvironmentItemsMap) (diags diag.Diagnostics) {
+	items := map[string]map[string]json.RawMessage{}
+	for key, e := range envs {
+		if e.EdgeConfigItem.IsNull() {
+			continue
+		}
+		var ref EdgeConfigItemReference
+		if !e.EdgeConfigItem.IsUnknown() {
+			diags.Append(e.EdgeConfigItem.As(ctx, &ref, basetypes.ObjectAsOptions{})...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+		if e.EdgeConfigItem.IsUnknown() || ref.EdgeConfigID.IsUnknown() || ref.Key.IsUnknown() {
+			e.Value = types.StringUnknown()
+			envs[key] = e
+			continue
+		}
+
+		edgeConfigID := ref.EdgeConfigID.ValueString()
+		if _, ok := items[edgeConfigID]; !ok {
+			list, err := c.ListEdgeConfigItems(ctx, edgeConfigID, teamID)
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("variables").AtMapKey(key).AtName("edge_config_item"),
+					"Error reading Edge Config Items",
+					fmt.Sprintf("Could not list Edge Config Items %s, unexpected error: %s", edgeConfigID, err),
+				)
+				return diags
+			}
+			items[edgeConfigID] = map[string]json.RawMessage{}
+			for _, item := range list {
+				items[edgeConfigID][item.Key] = item.Value
+			}
+		}
+
+		raw, ok := items[edgeConfigID][ref.Key.ValueString()]
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("variables").AtMapKey(key).AtName("edge_config_item"),
+				"Edge Config Item not found",
+				fmt.Sprintf("The Edge Config %s has no item with the key %q, so the value of %s could not be set.", edgeConfigID, ref.Key.ValueString(), key),
+			)
+			continue
+		}
+		e.Value = types.StringValue(edgeConfigItemValueString(raw))
+		envs[key] = e
+	}
+	return diags
+}
+
+// edgeConfigItemValueString returns the value of an Edge Config item as an environment variable value. Strings are
+// used as they are, and any other JSON value is compacted.
+func edgeConfigItemValueString(raw json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil {
+		return string(raw)
+	}
+	return buf.String()
+}
+
 
 // Updated: now takes resp *resource.ModifyPlanResponse and triggers RequiresReplace on value changes
-func suppressWriteOnlyEnvVarUpdates(ctx context.Context, config *ProjectEnvironmentVariables, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+func suppressWriteOnlyEnvVarUpdates(ctx context.Context, config *ProjectEnvironmentVariables, environment EnvironmentItemsMap, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
     var diags diag.Diagnostics
 
-    environment, diags := config.environment(ctx)
-    if diags.HasError() {
-        diags.Append(diags...)
-        return diags
-    }
-
     prefix := fmt.Sprintf("vercel_env_%s_%s_", config.ProjectID.ValueString(), config.TeamID.ValueString())
 
     for key, env := range environment {
@@ -209,6 +373,13 @@ func (r *projectEnvironmentVariablesResource) ModifyPlan(ctx context.Context, re
 		return
 	}
 
+	if r.client != nil && !config.ProjectID.IsUnknown() && referencesChanged(ctx, req, "project_id", "team_id") {
+		resp.Diagnostics.Append(checkProjectTeam(ctx, r.client, config.ProjectID.ValueString(), config.TeamID.ValueString())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	environment, diags := config.environment(ctx)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
@@ -220,6 +391,71 @@ func (r *projectEnvironmentVariablesResource) ModifyPlan(ctx context.Context, re
 		return
 	}
 
+	if r.client != nil {
+		diags = resolveEdgeConfigItemValues(ctx, r.client, config.TeamID.ValueString(), environment)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if config.ExcludeDevelopment.ValueBool() {
+		for key, e := range environment {
+			if targetsDevelopment(e.Target) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("variables").AtMapKey(key).AtName("target"),
+					"Project Environment Variables Invalid",
+					"The `development` target cannot be used when `exclude_development_target` is `true`.",
+				)
+			}
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = validateDistinctEnvironmentVariables(ctx, environment)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client != nil && !config.ProjectID.IsUnknown() {
+		diags = validatePlannedCustomEnvironmentIDs(ctx, r.client, config, environment, req)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	for key, e := range environment {
+		if e.Type.IsNull() || e.Type.IsUnknown() || e.Sensitive.IsNull() || e.Sensitive.IsUnknown() {
+			continue
+		}
+		if e.Sensitive.ValueBool() != (e.Type.ValueString() == "sensitive") {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("variables").AtMapKey(key).AtName("type"),
+				"Project Environment Variables Invalid",
+				fmt.Sprintf("The `type` of %s is %q, which does not agree with `sensitive`. Please set only one of `type` or `sensitive`.", key, e.Type.ValueString()),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Where the type is derived from `sensitive`, plan it, so that changing `sensitive` is reflected in the type.
+	for key, e := range environment {
+		if !e.Type.IsNull() || e.Sensitive.IsNull() || e.Sensitive.IsUnknown() {
+			continue
+		}
+		diags = resp.Plan.SetAttribute(ctx, path.Root("variables").AtMapKey(key).AtName("type"), types.StringValue(e.envVariableType()))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	var plan ProjectEnvironmentVariables
 	diags = resp.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -227,12 +463,66 @@ func (r *projectEnvironmentVariablesResource) ModifyPlan(ctx context.Context, re
 		return
 	}
 
-	diags = suppressWriteOnlyEnvVarUpdates(ctx, &config, req, resp)
+	diags = suppressWriteOnlyEnvVarUpdates(ctx, &config, environment, req, resp)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if !req.State.Raw.IsNull() {
+		var state ProjectEnvironmentVariables
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		stateEnvs, diags := state.environment(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// As values are write-only, a change to an Edge Config item, or to a sensitive value outside of Terraform,
+		// would not otherwise be planned, so plan the ID as unknown to apply it.
+		prefix := fmt.Sprintf("vercel_env_%s_%s_", config.ProjectID.ValueString(), config.TeamID.ValueString())
+		for key, e := range environment {
+			if _, ok := stateEnvs[key]; !ok {
+				continue
+			}
+			changedOutside := envVariableChangedOutside(ctx, req.Private, config.ProjectID.ValueString(), config.TeamID.ValueString(), key)
+			if changedOutside {
+				resp.Diagnostics.AddAttributeWarning(
+					path.Root("variables").AtMapKey(key),
+					"Project Environment Variable changed outside of Terraform",
+					fmt.Sprintf("The Environment Variable %s has been updated outside of Terraform. Its value cannot be read, so it will be set to the configured value again.", key),
+				)
+			}
+			storedHash, _ := req.Private.GetKey(ctx, prefix+key)
+			edgeConfigItemChanged := !e.EdgeConfigItem.IsNull() && !e.Value.IsUnknown() &&
+				strings.Trim(string(storedHash), "\"") != fmt.Sprintf("%x", sha256.Sum256([]byte(e.Value.ValueString())))
+			if !changedOutside && !edgeConfigItemChanged {
+				continue
+			}
+			diags = resp.Plan.SetAttribute(ctx, path.Root("variables").AtMapKey(key).AtName("id"), types.StringUnknown())
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		diags = planRenamedEnvironmentVariables(ctx, config, stateEnvs, environment, req, resp)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		diags = summarizeEnvironmentVariableChanges(ctx, config, stateEnvs, environment, req)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	/*diags = resp.Plan.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -245,6 +535,15 @@ func (r *projectEnvironmentVariablesResource) ModifyPlan(ctx context.Context, re
 		if e.ID.ValueString() != "" {
 			continue
 		}
+		if !e.Type.IsUnknown() && !e.Type.IsNull() && e.Type.ValueString() != "sensitive" {
+			nonSensitiveEnvVars = append(
+				nonSensitiveEnvVars,
+				path.Root("variables").
+					AtMapKey(key).
+					AtName("type"),
+			)
+			continue
+		}
 		if e.Sensitive.IsUnknown() || e.Sensitive.IsNull() || e.Sensitive.ValueBool() {
 			continue
 		}
@@ -280,40 +579,265 @@ func (r *projectEnvironmentVariablesResource) ModifyPlan(ctx context.Context, re
 		resp.Diagnostics.AddAttributeError(
 			p,
 			"Project Environment Variables Invalid",
-			"This team has a policy that forces all environment variables to be sensitive. Please remove the `sensitive` field for your environment variables or set the `sensitive` field to `true` in your configuration.",
+			"This team has a policy that forces all environment variables to be sensitive. Please remove the `sensitive` and `type` fields for your environment variables, or set `sensitive` to `true` in your configuration.",
+		)
+	}
+}
+
+// validateDistinctEnvironmentVariables checks that variables defined more than once with the same name can all exist
+// at once, as Vercel does not allow two variables with the same name, git branch and an overlapping target or custom
+// environment.
+func validateDistinctEnvironmentVariables(ctx context.Context, environment EnvironmentItemsMap) (diags diag.Diagnostics) {
+	byName := map[string][]string{}
+	for key, e := range environment {
+		if e.Key.IsUnknown() {
+			continue
+		}
+		byName[e.name(key)] = append(byName[e.name(key)], key)
+	}
+	for name, keys := range byName {
+		sort.Strings(keys)
+		for i, a := range keys {
+			for _, b := range keys[i+1:] {
+				x, y := environment[a], environment[b]
+				if x.Target.IsUnknown() || y.Target.IsUnknown() ||
+					x.CustomEnvironmentIDs.IsUnknown() || y.CustomEnvironmentIDs.IsUnknown() ||
+					x.GitBranch.IsUnknown() || y.GitBranch.IsUnknown() ||
+					!sameGitBranch(x.GitBranch.ValueStringPointer(), y.GitBranch.ValueStringPointer()) {
+					continue
+				}
+				overlap, d := targetsOverlap(ctx, x, y)
+				diags.Append(d...)
+				if d.HasError() {
+					return diags
+				}
+				if overlap {
+					diags.AddAttributeError(
+						path.Root("variables").AtMapKey(b),
+						"Project Environment Variables Invalid",
+						fmt.Sprintf("%s and %s both define the Environment Variable %s for the same target or custom environment. Vercel only allows one value of an Environment Variable for each environment and git branch.", a, b, name),
+					)
+				}
+			}
+		}
+	}
+	return diags
+}
+
+// validatePlannedCustomEnvironmentIDs checks that the custom environments newly referenced by any of the variables
+// exist on the project, so that an unknown ID fails the plan with an error against the variable that references
+// it, rather than failing the apply with an opaque API error.
+func validatePlannedCustomEnvironmentIDs(ctx context.Context, c *client.Client, config ProjectEnvironmentVariables, environment EnvironmentItemsMap, req resource.ModifyPlanRequest) (diags diag.Diagnostics) {
+	var prior EnvironmentItemsMap
+	if !referencesChanged(ctx, req, "project_id", "team_id") {
+		var state ProjectEnvironmentVariables
+		diags = req.State.Get(ctx, &state)
+		if diags.HasError() {
+			return diags
+		}
+		prior, diags = state.environment(ctx)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	keys := sortedKeys(environment)
+	envs := make([]client.EnvironmentVariableRequest, 0, len(keys))
+	for _, key := range keys {
+		priorIDs := types.SetNull(types.StringType)
+		if p, ok := prior[key]; ok {
+			priorIDs = p.CustomEnvironmentIDs
+		}
+		envs = append(envs, client.EnvironmentVariableRequest{
+			Key:                  environment[key].name(key),
+			CustomEnvironmentIDs: newCustomEnvironmentIDs(environment[key].CustomEnvironmentIDs, priorIDs),
+		})
+	}
+	return validateCustomEnvironmentIDs(
+		ctx,
+		c,
+		config.ProjectID.ValueString(),
+		config.TeamID.ValueString(),
+		envs,
+		func(i int) path.Path { return customEnvironmentIDsPath(keys[i]) },
+	)
+}
+
+// renamedEnvironmentVariables finds configured variables that appear to be existing variables with a new name: the
+// old name has been removed from the configuration, and the new variable has the same value, targets and git branch.
+// It returns a map of new names to old names.
+func renamedEnvironmentVariables(ctx context.Context, prefix string, state, config EnvironmentItemsMap, req resource.ModifyPlanRequest) (map[string]string, diag.Diagnostics) {
+	var added, removed []string
+	for key := range config {
+		if _, ok := state[key]; !ok {
+			added = append(added, key)
+		}
+	}
+	for key := range state {
+		if _, ok := config[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	if len(added) == 0 || len(removed) == 0 {
+		return nil, nil
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+
+	renamed := map[string]string{}
+	used := map[string]bool{}
+	for _, newKey := range added {
+		n := config[newKey]
+		if n.Value.IsUnknown() || n.Target.IsUnknown() || n.CustomEnvironmentIDs.IsUnknown() {
+			continue
+		}
+		var newTarget, newCustomEnvironmentIDs []string
+		diags := n.Target.ElementsAs(ctx, &newTarget, true)
+		diags.Append(n.CustomEnvironmentIDs.ElementsAs(ctx, &newCustomEnvironmentIDs, true)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		hash := fmt.Sprintf("%x", sha256.Sum256([]byte(n.Value.ValueString())))
+		for _, oldKey := range removed {
+			if used[oldKey] {
+				continue
+			}
+			o := state[oldKey]
+			if n.name(newKey) == o.name(oldKey) {
+				// Only the map key has changed, which is not a rename as far as Vercel is concerned.
+				continue
+			}
+			storedHash, _ := req.Private.GetKey(ctx, prefix+oldKey)
+			if strings.Trim(string(storedHash), "\"") != hash || !sameGitBranch(n.GitBranch.ValueStringPointer(), o.GitBranch.ValueStringPointer()) {
+				continue
+			}
+			var oldTarget, oldCustomEnvironmentIDs []string
+			diags := o.Target.ElementsAs(ctx, &oldTarget, true)
+			diags.Append(o.CustomEnvironmentIDs.ElementsAs(ctx, &oldCustomEnvironmentIDs, true)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			if !isSameStringSet(newTarget, oldTarget) || !isSameStringSet(newCustomEnvironmentIDs, oldCustomEnvironmentIDs) {
+				continue
+			}
+			renamed[newKey] = oldKey
+			used[oldKey] = true
+			break
+		}
+	}
+	return renamed, nil
+}
+
+// planRenamedEnvironmentVariables warns about variables that are being renamed, as this is planned as the removal
+// of one variable and the addition of another, which is easy to misread in a large plan. If
+// preserve_comment_on_rename is set, the comment of the old variable is planned for the new one.
+func planRenamedEnvironmentVariables(ctx context.Context, config ProjectEnvironmentVariables, stateEnvs, environment EnvironmentItemsMap, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	prefix := fmt.Sprintf("vercel_env_%s_%s_", config.ProjectID.ValueString(), config.TeamID.ValueString())
+	renamed, diags := renamedEnvironmentVariables(ctx, prefix, stateEnvs, environment, req)
+	if diags.HasError() {
+		return diags
+	}
+
+	var preserveComment types.Bool
+	diags = resp.Plan.GetAttribute(ctx, path.Root("preserve_comment_on_rename"), &preserveComment)
+	if diags.HasError() {
+		return diags
+	}
+	for _, newKey := range sortedKeys(renamed) {
+		oldKey := renamed[newKey]
+		detail := fmt.Sprintf(
+			"The Environment Variable %s has the same value and targets as %s, which has been removed from the configuration, so it appears to have been renamed. Vercel does not support renaming Environment Variables, so %s will be deleted and %s created.",
+			newKey,
+			oldKey,
+			oldKey,
+			newKey,
 		)
+		if preserveComment.ValueBool() && environment[newKey].Comment.IsNull() {
+			diags.Append(resp.Plan.SetAttribute(ctx, path.Root("variables").AtMapKey(newKey).AtName("comment"), stateEnvs[oldKey].Comment)...)
+			detail += fmt.Sprintf(" The comment of %s will be kept.", oldKey)
+		}
+		diags.AddAttributeWarning(path.Root("variables").AtMapKey(newKey), "Environment Variable renamed", detail)
 	}
+	return diags
+}
+
+// summarizeEnvironmentVariableChanges adds a warning counting the variables that are planned to be added, changed
+// and removed, as the diff of a map with hundreds of variables is hard to read. Terraform has no informational
+// diagnostics, so a warning is the only way to show this alongside the plan.
+func summarizeEnvironmentVariableChanges(ctx context.Context, config ProjectEnvironmentVariables, stateEnvs, environment EnvironmentItemsMap, req resource.ModifyPlanRequest) (diags diag.Diagnostics) {
+	prefix := fmt.Sprintf("vercel_env_%s_%s_", config.ProjectID.ValueString(), config.TeamID.ValueString())
+	var added, changed, removed int
+	for key, e := range environment {
+		prior, ok := stateEnvs[key]
+		if !ok {
+			added++
+			continue
+		}
+		storedHash, _ := req.Private.GetKey(ctx, prefix+key)
+		hash := fmt.Sprintf("%x", sha256.Sum256([]byte(e.Value.ValueString())))
+		if !e.Value.IsUnknown() && strings.Trim(string(storedHash), "\"") != hash ||
+			!e.ValueVersion.Equal(prior.ValueVersion) ||
+			!e.Target.IsNull() && !e.Target.Equal(prior.Target) ||
+			!e.CustomEnvironmentIDs.IsNull() && !e.CustomEnvironmentIDs.Equal(prior.CustomEnvironmentIDs) ||
+			!e.Sensitive.IsNull() && !e.Sensitive.Equal(prior.Sensitive) ||
+			!e.Type.IsNull() && !e.Type.Equal(prior.Type) ||
+			!e.Comment.IsNull() && !e.Comment.Equal(prior.Comment) ||
+			!e.GitBranch.IsUnknown() && !sameGitBranch(e.GitBranch.ValueStringPointer(), prior.GitBranch.ValueStringPointer()) {
+			changed++
+		}
+	}
+	for key := range stateEnvs {
+		if _, ok := environment[key]; !ok {
+			removed++
+		}
+	}
+	if added+changed+removed == 0 {
+		return nil
+	}
+	diags.AddWarning(
+		"Project Environment Variables changes",
+		fmt.Sprintf(
+			"The Environment Variables of project %s have %d added, %d changed and %d removed, out of %d configured.",
+			config.ProjectID.ValueString(),
+			added,
+			changed,
+			removed,
+			len(environment),
+		),
+	)
+	return diags
 }
 
 // EnvironmentItems represents a set of environment variables
 // for use with MapNestedAttribute
 type EnvironmentItemsMap map[string]EnvironmentItem
 
-func (e *EnvironmentItemsMap) toCreateEnvironmentVariablesRequest(ctx context.Context, projectID types.String, teamID types.String) (r client.CreateEnvironmentVariablesRequest, diags diag.Diagnostics) {
+// toCreateEnvironmentVariablesRequest builds a request to create the environment variables. The map key of each
+// variable in the request is returned at the same index in keys.
+func (e *EnvironmentItemsMap) toCreateEnvironmentVariablesRequest(ctx context.Context, projectID types.String, teamID types.String) (r client.CreateEnvironmentVariablesRequest, keys []string, diags diag.Diagnostics) {
 	variables := []client.EnvironmentVariableRequest{}
-	for key, env := range *e {
+	for key := range *e {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		env := (*e)[key]
 		var target []string
 		diags = env.Target.ElementsAs(ctx, &target, true)
 		if diags.HasError() {
-			return r, diags
+			return r, nil, diags
 		}
 		var customEnvironmentIDs []string
 		diags = env.CustomEnvironmentIDs.ElementsAs(ctx, &customEnvironmentIDs, true)
 		if diags.HasError() {
-			return r, diags
-		}
-		var envVariableType string
-		if env.Sensitive.ValueBool() {
-			envVariableType = "sensitive"
-		} else {
-			envVariableType = "encrypted"
+			return r, nil, diags
 		}
 		variables = append(variables, client.EnvironmentVariableRequest{
-			Key:                  key,
+			Key:                  env.name(key),
 			Value:                env.Value.ValueString(),
 			Target:               target,
 			CustomEnvironmentIDs: customEnvironmentIDs,
-			Type:                 envVariableType,
+			Type:                 env.envVariableType(),
 			GitBranch:            env.GitBranch.ValueStringPointer(),
 			Comment:              env.Comment.ValueString(),
 		})
@@ -323,6 +847,49 @@ func (e *EnvironmentItemsMap) toCreateEnvironmentVariablesRequest(ctx context.Co
 		ProjectID:            projectID.ValueString(),
 		TeamID:               teamID.ValueString(),
 		EnvironmentVariables: variables,
+	}, keys, nil
+}
+
+// sameSensitivity returns whether an environment variable can be updated in place, as the API does not allow a
+// variable to be changed to or from being sensitive.
+func sameSensitivity(ee EnvironmentItem, e client.EnvironmentVariable) bool {
+	if !ee.Type.IsNull() && !ee.Type.IsUnknown() {
+		return (ee.Type.ValueString() == "sensitive") == (e.Type == "sensitive")
+	}
+	if ee.Sensitive.IsNull() || ee.Sensitive.IsUnknown() {
+		return true
+	}
+	return ee.Sensitive.ValueBool() == (e.Type == "sensitive")
+}
+
+// toUpdateEnvironmentVariableRequest builds a request to update an existing environment variable in place.
+func (e EnvironmentItem) toUpdateEnvironmentVariableRequest(ctx context.Context, existing client.EnvironmentVariable, projectID types.String, teamID types.String) (r client.UpdateEnvironmentVariableRequest, diags diag.Diagnostics) {
+	var target []string
+	diags = e.Target.ElementsAs(ctx, &target, true)
+	if diags.HasError() {
+		return r, diags
+	}
+	var customEnvironmentIDs []string
+	diags = e.CustomEnvironmentIDs.ElementsAs(ctx, &customEnvironmentIDs, true)
+	if diags.HasError() {
+		return r, diags
+	}
+	envVariableType := existing.Type
+	if !e.Type.IsNull() && !e.Type.IsUnknown() || !e.Sensitive.IsNull() && !e.Sensitive.IsUnknown() {
+		envVariableType = e.envVariableType()
+	}
+
+	return client.UpdateEnvironmentVariableRequest{
+		Key:                  existing.Key,
+		Value:                e.Value.ValueString(),
+		Target:               target,
+		CustomEnvironmentIDs: customEnvironmentIDs,
+		GitBranch:            e.GitBranch.ValueStringPointer(),
+		Type:                 envVariableType,
+		Comment:              e.Comment.ValueString(),
+		ProjectID:            projectID.ValueString(),
+		TeamID:               teamID.ValueString(),
+		EnvID:                existing.ID,
 	}, nil
 }
 
@@ -340,10 +907,15 @@ func convertResponseToProjectEnvironmentVariables(
 		return ProjectEnvironmentVariables{}, diags
 	}
 
-	// Build a map of environment variables keyed by variable name
+	// Build a map of environment variables keyed by their map key, which is the variable name unless `key` is set.
+	index := environment.index()
 	env := make(map[string]attr.Value)
 	alreadyPresent := map[string]struct{}{}
 	for _, e := range response {
+		key, ok := index.keyFor(ctx, e)
+		if !ok {
+			key = e.Key
+		}
 		var targetValue attr.Value
 		if len(e.Target) > 0 {
 			target := make([]attr.Value, 0, len(e.Target))
@@ -370,7 +942,7 @@ func convertResponseToProjectEnvironmentVariables(
 			value = types.StringNull()
 		}
 		if e.Decrypted != nil && !*e.Decrypted || e.Type == "sensitive" {
-			for key, p := range environment {
+			if p, ok := environment[key]; ok {
 				var target []string
 				diags := p.Target.ElementsAs(ctx, &target, true)
 				if diags.HasError() {
@@ -381,9 +953,8 @@ func convertResponseToProjectEnvironmentVariables(
 				if diags.HasError() {
 					return ProjectEnvironmentVariables{}, diags
 				}
-				if key == e.Key && isSameStringSet(target, e.Target) && isSameStringSet(customEnvironmentIDs, e.CustomEnvironmentIDs) {
+				if isSameStringSet(target, e.Target) && isSameStringSet(customEnvironmentIDs, e.CustomEnvironmentIDs) {
 					value = p.Value
-					break
 				}
 			}
 		}
@@ -394,17 +965,25 @@ func convertResponseToProjectEnvironmentVariables(
 		}
 		alreadyPresent[e.ID] = struct{}{}
 
-		// Use the env var key as the map key
-		env[e.Key] = types.ObjectValueMust(
+		edgeConfigItem := types.ObjectNull(edgeConfigItemReferenceType.AttrTypes)
+		if p, ok := environment[key]; ok {
+			edgeConfigItem = p.EdgeConfigItem
+		}
+
+		env[key] = types.ObjectValueMust(
 			EnvVariableElemType.AttrTypes,
 			map[string]attr.Value{
+				"key":                    environment[key].Key,
 				"value":                  value,
 				"target":                 targetValue,
 				"custom_environment_ids": customEnvIDsValue,
-				"git_branch":             types.StringPointerValue(e.GitBranch),
+				"git_branch":             gitBranchValue(environment[key].GitBranch, e.GitBranch),
 				"id":                     types.StringValue(e.ID),
 				"sensitive":              types.BoolValue(e.Type == "sensitive"),
+				"type":                   types.StringValue(e.Type),
 				"comment":                types.StringValue(e.Comment),
+				"value_version":          environment[key].ValueVersion,
+				"edge_config_item":       edgeConfigItem,
 			},
 		)
 	}
@@ -419,9 +998,14 @@ func convertResponseToProjectEnvironmentVariables(
 	// No need to sort, as maps are order-insensitive
 
 	return ProjectEnvironmentVariables{
-		TeamID:    toTeamID(plan.TeamID.ValueString()),
-		ProjectID: plan.ProjectID,
-		Variables: types.MapValueMust(EnvVariableElemType, env),
+		TeamID:             toTeamID(plan.TeamID.ValueString()),
+		ProjectID:          plan.ProjectID,
+		Variables:          types.MapValueMust(EnvVariableElemType, env),
+		RetainOnDelete:     plan.RetainOnDelete,
+		ExcludeDevelopment: plan.ExcludeDevelopment,
+		UpdateStrategy:     plan.UpdateStrategy,
+		PreserveComment:    plan.PreserveComment,
+		UnmanagedBehavior:  plan.UnmanagedBehavior,
 	}, nil
 }
 
@@ -449,18 +1033,122 @@ func (r *projectEnvironmentVariablesResource) Create(ctx context.Context, req re
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	diags = resolveEdgeConfigItemValues(ctx, r.client, plan.TeamID.ValueString(), envs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Environment variables left in place by retain_on_delete, such as when this resource is replaced because a
+	// value changed, are adopted and updated rather than created again, as creating them would conflict.
+	var retainOnDelete types.Bool
+	diags = req.Plan.GetAttribute(ctx, path.Root("retain_on_delete"), &retainOnDelete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	retained := map[string]client.EnvironmentVariable{}
+	if retainOnDelete.ValueBool() {
+		retained, err = retainedEnvironmentVariables(ctx, r.client, plan.ProjectID.ValueString(), plan.TeamID.ValueString(), envs)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating project environment variables",
+				"Could not list existing project environment variables, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+	var updateRequests []client.UpdateEnvironmentVariableRequest
+	var updateVariables []client.EnvironmentVariableRequest
+	var updateKeys []string
+	for _, key := range sortedKeys(retained) {
+		u, diags := envs[key].toUpdateEnvironmentVariableRequest(ctx, retained[key], plan.ProjectID, plan.TeamID)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		updateRequests = append(updateRequests, u)
+		updateVariables = append(updateVariables, client.EnvironmentVariableRequest{Key: u.Key, CustomEnvironmentIDs: u.CustomEnvironmentIDs})
+		updateKeys = append(updateKeys, key)
+	}
+	toCreate := make(EnvironmentItemsMap, len(envs))
+	for key, e := range envs {
+		if _, ok := retained[key]; !ok {
+			toCreate[key] = e
+		}
+	}
 
-	request, diags := envs.toCreateEnvironmentVariablesRequest(ctx, plan.ProjectID, plan.TeamID)
+	request, keys, diags := toCreate.toCreateEnvironmentVariablesRequest(ctx, plan.ProjectID, plan.TeamID)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	diags = validateCustomEnvironmentIDs(
+		ctx,
+		r.client,
+		plan.ProjectID.ValueString(),
+		plan.TeamID.ValueString(),
+		request.EnvironmentVariables,
+		func(i int) path.Path { return customEnvironmentIDsPath(keys[i]) },
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = validateCustomEnvironmentIDs(
+		ctx,
+		r.client,
+		plan.ProjectID.ValueString(),
+		plan.TeamID.ValueString(),
+		updateVariables,
+		func(i int) path.Path { return customEnvironmentIDsPath(updateKeys[i]) },
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	created, err := r.client.CreateEnvironmentVariables(ctx, request)
-	if err != nil {
+	created, failures := createEnvironmentVariablesInChunks(ctx, r.client, request, keys, toCreate)
+	updated := make([]client.EnvironmentVariable, len(updateRequests))
+	errs := runConcurrently(len(updateRequests), r.client.Features().EnvVars.Parallelism, func(i int) error {
+		var err error
+		updated[i], err = r.client.UpdateEnvironmentVariable(ctx, updateRequests[i])
+		return err
+	})
+	for i, err := range errs {
+		if err != nil {
+			failures = append(failures, environmentVariablesCreateFailure{keys: []string{updateKeys[i]}, err: err})
+			continue
+		}
+		created = append(created, updated[i])
+	}
+	for _, f := range failures {
+		if len(f.keys) == 0 {
+			resp.Diagnostics.AddError(
+				"Error creating project environment variables",
+				"Could not create project environment variables, unexpected error: "+f.err.Error(),
+			)
+			continue
+		}
 		resp.Diagnostics.AddError(
 			"Error creating project environment variables",
-			"Could not create project environment variables, unexpected error: "+err.Error(),
+			fmt.Sprintf(
+				"Could not create the project environment variables %s, unexpected error: %s",
+				strings.Join(f.keys, ", "),
+				f.err,
+			),
+		)
+	}
+	if len(failures) > 0 && len(created) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Project environment variables partially created",
+			fmt.Sprintf(
+				"%d of the %d project environment variables were created, and have been recorded in the state. "+
+					"As the create failed, Terraform will mark the resource as tainted, and the next apply will delete and re-create all of them. "+
+					"To only create the environment variables that failed instead, run `terraform untaint` on the resource before applying again.",
+				len(created),
+				len(envs),
+			),
 		)
 	}
 
@@ -469,13 +1157,40 @@ func (r *projectEnvironmentVariablesResource) Create(ctx context.Context, req re
 		resp.Diagnostics.Append(diags...)
 		return
 	}
-
-	// Set the hash of the environment variable values in the private state.
-	prefix := fmt.Sprintf("vercel_env_%s_%s_", plan.ProjectID.ValueString(), plan.TeamID.ValueString())
-	for key, env := range envs { 
-		hash := sha256.Sum256([]byte(env.Value.ValueString()))
-		privateKey := prefix + key
-		resp.Private.SetKey(ctx, privateKey, []byte(fmt.Sprintf("\"%x\"", hash)))
+	// The values are read from config as they are write-only, so take the defaulted retain_on_delete from the plan.
+	diags = req.Plan.GetAttribute(ctx, path.Root("retain_on_delete"), &result.RetainOnDelete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.Plan.GetAttribute(ctx, path.Root("exclude_development_target"), &result.ExcludeDevelopment)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.Plan.GetAttribute(ctx, path.Root("preserve_comment_on_rename"), &result.PreserveComment)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.Plan.GetAttribute(ctx, path.Root("unmanaged_variable_behavior"), &result.UnmanagedBehavior)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the hash of the created environment variable values in the private state.
+	prefix := fmt.Sprintf("vercel_env_%s_%s_", plan.ProjectID.ValueString(), plan.TeamID.ValueString())
+	index := envs.index()
+	for _, e := range created {
+		key, ok := index.keyFor(ctx, e)
+		if !ok {
+			continue
+		}
+		hash := sha256.Sum256([]byte(envs[key].Value.ValueString()))
+		resp.Private.SetKey(ctx, prefix+key, []byte(fmt.Sprintf("\"%x\"", hash)))
+		diags = recordEnvVariableWrite(ctx, resp.Private, plan.ProjectID.ValueString(), plan.TeamID.ValueString(), key, e)
+		resp.Diagnostics.Append(diags...)
 	}
 
 	tflog.Info(ctx, "created project environment variables", map[string]any{
@@ -490,6 +1205,138 @@ func (r *projectEnvironmentVariablesResource) Create(ctx context.Context, req re
 	}
 }
 
+// retainedEnvironmentVariables returns the existing environment variables that the configured ones would conflict
+// with if they were created, such as those left in place by retain_on_delete, by the map key they are configured with.
+func retainedEnvironmentVariables(ctx context.Context, c *client.Client, projectID, teamID string, envs EnvironmentItemsMap) (map[string]client.EnvironmentVariable, error) {
+	existing, err := c.GetEnvironmentVariables(ctx, projectID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	index := envs.index()
+	retained := map[string]client.EnvironmentVariable{}
+	for _, e := range existing {
+		key, ok := index.keyFor(ctx, e)
+		if !ok || !sameSensitivity(envs[key], e) {
+			continue
+		}
+		if _, ok := retained[key]; !ok {
+			retained[key] = e
+		}
+	}
+	return retained, nil
+}
+
+// environmentVariablesCreateChunkSize is the number of environment variables created in a single request, so that
+// a failure part way through a large batch only affects the environment variables in that request.
+const environmentVariablesCreateChunkSize = 50
+
+// environmentVariablesCreateFailure is a request to create environment variables that failed, along with the map
+// keys of the environment variables in it that were not created.
+type environmentVariablesCreateFailure struct {
+	keys []string
+	err  error
+}
+
+// createEnvironmentVariablesInChunks creates the environment variables in the request in chunks of
+// environmentVariablesCreateChunkSize. Every chunk is attempted, even if an earlier one fails, so that as many
+// environment variables as possible are created. keys holds the map key of each environment variable in the request,
+// as returned by toCreateEnvironmentVariablesRequest.
+func createEnvironmentVariablesInChunks(
+	ctx context.Context,
+	c *client.Client,
+	request client.CreateEnvironmentVariablesRequest,
+	keys []string,
+	envs EnvironmentItemsMap,
+) (created []client.EnvironmentVariable, failures []environmentVariablesCreateFailure) {
+	for start := 0; start < len(request.EnvironmentVariables); start += environmentVariablesCreateChunkSize {
+		end := min(start+environmentVariablesCreateChunkSize, len(request.EnvironmentVariables))
+		chunk := request
+		chunk.EnvironmentVariables = request.EnvironmentVariables[start:end]
+
+		response, err := c.CreateEnvironmentVariables(ctx, chunk)
+		created = append(created, response...)
+		if err == nil {
+			continue
+		}
+
+		// Work out which of the environment variables in the chunk were not created.
+		chunkEnvs := make(EnvironmentItemsMap, end-start)
+		for _, key := range keys[start:end] {
+			chunkEnvs[key] = envs[key]
+		}
+		index := chunkEnvs.index()
+		for _, e := range response {
+			if key, ok := index.keyFor(ctx, e); ok {
+				delete(chunkEnvs, key)
+			}
+		}
+		failures = append(failures, environmentVariablesCreateFailure{
+			keys: sortedKeys(chunkEnvs),
+			err:  err,
+		})
+	}
+	return created, failures
+}
+
+// managedEnvironmentVariableFilter returns a filter that matches every environment variable managed by the
+// resource, so that only those need to be listed from projects with many environment variables. If the managed
+// variables have no target or custom environment in common, nothing is filtered.
+func managedEnvironmentVariableFilter(ctx context.Context, environment EnvironmentItemsMap) (client.EnvironmentVariableFilter, diag.Diagnostics) {
+	var targets, customEnvironmentIDs []string
+	first := true
+	for _, e := range environment {
+		var target []string
+		diags := e.Target.ElementsAs(ctx, &target, true)
+		if diags.HasError() {
+			return client.EnvironmentVariableFilter{}, diags
+		}
+		var customEnvironmentID []string
+		diags = e.CustomEnvironmentIDs.ElementsAs(ctx, &customEnvironmentID, true)
+		if diags.HasError() {
+			return client.EnvironmentVariableFilter{}, diags
+		}
+		if first {
+			targets, customEnvironmentIDs = target, customEnvironmentID
+			first = false
+			continue
+		}
+		targets = intersectStrings(targets, target)
+		customEnvironmentIDs = intersectStrings(customEnvironmentIDs, customEnvironmentID)
+	}
+
+	sort.Strings(targets)
+	sort.Strings(customEnvironmentIDs)
+	switch {
+	case len(targets) > 0:
+		return client.EnvironmentVariableFilter{Target: targets[0]}, nil
+	case len(customEnvironmentIDs) > 0:
+		return client.EnvironmentVariableFilter{CustomEnvironmentID: customEnvironmentIDs[0]}, nil
+	default:
+		return client.EnvironmentVariableFilter{}, nil
+	}
+}
+
+func intersectStrings(a, b []string) []string {
+	var result []string
+	for _, s := range a {
+		if contains(b, s) {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// containsAllIDs returns whether every ID is present in a list of environment variables.
+func containsAllIDs(envs []client.EnvironmentVariable, ids map[string]struct{}) bool {
+	found := map[string]struct{}{}
+	for _, e := range envs {
+		if _, ok := ids[e.ID]; ok {
+			found[e.ID] = struct{}{}
+		}
+	}
+	return len(found) == len(ids)
+}
+
 // Read will read an environment variable of a Vercel project by requesting it from the Vercel API, and will update terraform
 // with this information.
 func (r *projectEnvironmentVariablesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -516,7 +1363,28 @@ func (r *projectEnvironmentVariablesResource) Read(ctx context.Context, req reso
 		return
 	}
 
-	envs, err := r.client.GetEnvironmentVariables(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString())
+	filter, diags := managedEnvironmentVariableFilter(ctx, existing)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	deleteUnmanaged := state.UnmanagedBehavior.ValueString() == unmanagedVariableBehaviorDelete
+	var envs []client.EnvironmentVariable
+	var err error
+	listAll := deleteUnmanaged || filter == (client.EnvironmentVariableFilter{})
+	if !listAll {
+		envs, err = r.client.ListEnvironmentVariables(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString(), filter)
+		// If a variable has been moved out of the filter since it was last read, read everything, so that this
+		// shows up as drift rather than the variable appearing to have been deleted.
+		listAll = err == nil && !containsAllIDs(envs, existingIDs)
+	}
+	if listAll {
+		envs, err = r.client.GetEnvironmentVariables(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString())
+	}
+	if client.NotFound(err) && projectPendingDeletion(ctx, r.client, state.ProjectID.ValueString(), state.TeamID.ValueString()) {
+		addProjectPendingDeletionWarning(&resp.Diagnostics, "environment variables", state.ProjectID.ValueString())
+		return
+	}
 	if client.NotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
@@ -529,7 +1397,13 @@ func (r *projectEnvironmentVariablesResource) Read(ctx context.Context, req reso
 		return
 	}
 
+	if state.ExcludeDevelopment.ValueBool() {
+		envs = excludeDevelopmentTarget(envs)
+	}
+
+	index := existing.index()
 	var toUse []client.EnvironmentVariable
+	var unmanagedKeys []string
 	for _, e := range envs {
 		if _, ok := existingIDs[e.ID]; ok {
 			// This ID exists in the env vars we have already. So use it.
@@ -541,26 +1415,46 @@ func (r *projectEnvironmentVariablesResource) Read(ctx context.Context, req reso
 			// The env var exists at the moment, but not in TF state (the ID isn't present).
 			// Check if it has the same `key`, `target` and `custom_environment_ids` as an existing env var.
 			// This detects drift for stuff like: deleting an env var and then creating it again (the ID changes).
-			for key, ee := range existing {
-				var target []string
-				diags := ee.Target.ElementsAs(ctx, &target, true)
-				if diags.HasError() {
-					resp.Diagnostics.Append(diags...)
-					return
-				}
-				var customEnvironmentIDs []string
-				diags = ee.CustomEnvironmentIDs.ElementsAs(ctx, &customEnvironmentIDs, true)
-				if diags.HasError() {
-					resp.Diagnostics.Append(diags...)
-					return
-				}
-				if key == e.Key && isSameStringSet(target, e.Target) && isSameStringSet(customEnvironmentIDs, e.CustomEnvironmentIDs) {
+			// Variables are indexed by name, so this is a single lookup rather than a comparison against every
+			// managed variable, which dominated refresh time for projects with many variables.
+			key, ok := index.keyFor(ctx, e)
+			if !ok {
+				// Unmanaged variables are added to state, so that they are planned to be deleted. Variables with the
+				// same name as a managed one are left alone.
+				if deleteUnmanaged && !index.managesName(e.Key) && !contains(unmanagedKeys, e.Key) {
+					unmanagedKeys = append(unmanagedKeys, e.Key)
 					toUse = append(toUse, e)
 				}
+				continue
+			}
+			ee := existing[key]
+			var target []string
+			diags := ee.Target.ElementsAs(ctx, &target, true)
+			if diags.HasError() {
+				resp.Diagnostics.Append(diags...)
+				return
+			}
+			var customEnvironmentIDs []string
+			diags = ee.CustomEnvironmentIDs.ElementsAs(ctx, &customEnvironmentIDs, true)
+			if diags.HasError() {
+				resp.Diagnostics.Append(diags...)
+				return
+			}
+			if isSameStringSet(target, e.Target) && isSameStringSet(customEnvironmentIDs, e.CustomEnvironmentIDs) {
+				toUse = append(toUse, e)
 			}
 		}
 	}
 
+	for _, e := range toUse {
+		key, ok := index.keyFor(ctx, e)
+		if !ok {
+			continue
+		}
+		diags = detectEnvVariableChangedOutside(ctx, resp.Private, state.ProjectID.ValueString(), state.TeamID.ValueString(), key, e)
+		resp.Diagnostics.Append(diags...)
+	}
+
 	result, diags := convertResponseToProjectEnvironmentVariables(ctx, toUse, state, nil)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
@@ -612,13 +1506,22 @@ func (r *projectEnvironmentVariablesResource) Update(ctx context.Context, req re
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	diags = resolveEdgeConfigItemValues(ctx, r.client, config.TeamID.ValueString(), configEnvs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 
-	// Update the hash of all the environment variable values in the private state.
+	// Update the hash of all the environment variable values in the private state, noting which values are unchanged
+	// before they are overwritten.
 	prefix := fmt.Sprintf("vercel_env_%s_%s_", plan.ProjectID.ValueString(), plan.TeamID.ValueString())
+	valueUnchanged := map[string]bool{}
 	for key, env := range configEnvs { 
 		hash := sha256.Sum256([]byte(env.Value.ValueString()))
 		privateKey := prefix + key
+		storedHash, _ := req.Private.GetKey(ctx, privateKey)
+		valueUnchanged[key] = len(storedHash) > 0 && strings.Trim(string(storedHash), "\"") == fmt.Sprintf("%x", hash)
 		resp.Private.SetKey(ctx, privateKey, []byte(fmt.Sprintf("\"%x\"", hash)))
 	}
 
@@ -627,7 +1530,17 @@ func (r *projectEnvironmentVariablesResource) Update(ctx context.Context, req re
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	// Use any type that was planned from `sensitive`, so that a change to it is applied.
+	for key, e := range configEnvs {
+		if e.Type.IsNull() && !planEnvs[key].Type.IsUnknown() && !e.Sensitive.IsNull() {
+			e.Type = planEnvs[key].Type
+			configEnvs[key] = e
+		}
+	}
 
+	// This list is not shared with Read. Terraform refreshes and applies in separate provider processes, so the
+	// environment variables listed by Read are never available here, and they may be out of date by the time a saved
+	// plan is applied.
 	envsFromAPI, err := r.client.GetEnvironmentVariables(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString())
 	if client.NotFound(err) {
 		resp.State.RemoveResource(ctx)
@@ -641,20 +1554,58 @@ func (r *projectEnvironmentVariablesResource) Update(ctx context.Context, req re
 		return
 	}
 
-	// Build a map of envs from API for efficient lookup by key
+	// apiKey returns the map key that a variable from the API is managed as, preferring the variable in state with
+	// the same ID.
+	stateIndex, planIndex := stateEnvs.index(), planEnvs.index()
+	apiKey := func(e client.EnvironmentVariable) (string, bool) {
+		if key, ok := stateIndex.byID[e.ID]; ok {
+			return key, true
+		}
+		return planIndex.keyFor(ctx, e)
+	}
+
+	// Track which environment variables have a development target added outside of Terraform, so it can be kept.
+	keepDevelopment := map[string]bool{}
+	if plan.ExcludeDevelopment.ValueBool() {
+		for _, e := range envsFromAPI {
+			if key, ok := apiKey(e); ok && !isDevelopmentOnly(e) && contains(e.Target, "development") {
+				keepDevelopment[key] = true
+			}
+		}
+		envsFromAPI = excludeDevelopmentTarget(envsFromAPI)
+	}
+
+	// Build a map of envs from API for efficient lookup by key. Where there are several variables with the same name,
+	// such as one added in the dashboard for another target, prefer the one that is managed by this resource.
 	envsFromAPIMap := make(map[string]client.EnvironmentVariable, len(envsFromAPI))
 	for _, e := range envsFromAPI {
-		envsFromAPIMap[e.Key] = e
+		key, ok := apiKey(e)
+		if !ok {
+			continue
+		}
+		if existing, ok := envsFromAPIMap[key]; ok && stateIndex.byID[existing.ID] != "" {
+			continue
+		}
+		envsFromAPIMap[key] = e
 	}
 	
 	toAdd := make(EnvironmentItemsMap)
 	for key := range planEnvs {
 		_, ok := envsFromAPIMap[key]
 		if !ok {
-			toAdd[key] = configEnvs[key]
+			e := configEnvs[key]
+			// The comment of a renamed variable may have been planned from the variable it replaces.
+			if e.Comment.IsNull() && !planEnvs[key].Comment.IsUnknown() {
+				e.Comment = planEnvs[key].Comment
+			}
+			toAdd[key] = e
 		}
 	}
-	
+
+	// Unless destructive updates are enabled, changed variables are updated in place, keeping their ID. Variables
+	// where only the target, git branch or comment changed are always updated in place, as their value is unchanged.
+	destructiveUpdates := r.client.Features().EnvVars.DestructiveUpdates
+	toUpdate := map[string]client.EnvironmentVariable{}
 	toRemove := make(EnvironmentItemsMap)
 	unchanged := make(EnvironmentItemsMap)
 	for key, e := range stateEnvs {
@@ -666,10 +1617,19 @@ func (r *projectEnvironmentVariablesResource) Update(ctx context.Context, req re
 			// As this is fully deleted, remove the hash from the private state.
 			privateKey := prefix + key
 			resp.Private.SetKey(ctx, privateKey, nil)
+			resp.Private.SetKey(ctx, envVariableWriteKey(plan.ProjectID.ValueString(), plan.TeamID.ValueString(), key), nil)
 			continue
 		}
+		// A new value_version means the value has been rotated outside of the configuration, so it is sent again.
+		rotated := !e.ValueVersion.Equal(planEnvs[key].ValueVersion)
 		apiEnv, ok := envsFromAPIMap[key]
-		if ok && (e.ID.ValueString() != apiEnv.ID || !envVarMatches(ctx, key, configEnvs[key], apiEnv)) {
+		name := configEnvs[key].name(key)
+		if ok && (rotated || e.ID.ValueString() != apiEnv.ID || !envVarMatches(ctx, name, configEnvs[key], apiEnv)) {
+			// A variable that is given a different name with `key` is re-created, like one that is renamed.
+			if (!destructiveUpdates || (!rotated && valueUnchanged[key])) && e.ID.ValueString() == apiEnv.ID && apiEnv.Key == name && sameSensitivity(configEnvs[key], apiEnv) {
+				toUpdate[key] = apiEnv
+				continue
+			}
 			toRemove[key] = e
 			toAdd[key] = configEnvs[key]
 			continue
@@ -677,51 +1637,161 @@ func (r *projectEnvironmentVariablesResource) Update(ctx context.Context, req re
 		unchanged[key] = e
 	}
 
+	// A variable that is not yet managed by this resource, but that already exists with the same name and target,
+	// such as one that was managed by a vercel_project_environment_variable resource before being moved here, is
+	// taken over and updated in place rather than created again.
+	for key := range planEnvs {
+		if _, ok := stateEnvs[key]; ok {
+			continue
+		}
+		// A variable with the same name that is only used for other targets or git branches is left alone, and the
+		// configured one is created alongside it.
+		apiEnv, ok := envsFromAPIMap[key]
+		if ok && apiEnv.Key == configEnvs[key].name(key) && sameSensitivity(configEnvs[key], apiEnv) && sharesEnvironment(ctx, configEnvs[key], apiEnv) {
+			toUpdate[key] = apiEnv
+		}
+	}
+
+	// Variables that are being re-created are normally deleted first, and only created again once the deletion is
+	// confirmed. With create_before_destroy, they are created first where the new and old variables can coexist.
+	createFirst := map[string]bool{}
+	if plan.UpdateStrategy.ValueString() == updateStrategyCreateBeforeDestroy {
+		for key, e := range toRemove {
+			if _, ok := toAdd[key]; !ok {
+				continue
+			}
+			overlap, diags := targetsOverlap(ctx, e, configEnvs[key])
+			if diags.HasError() {
+				resp.Diagnostics.Append(diags...)
+				return
+			}
+			if !overlap {
+				createFirst[key] = true
+			}
+		}
+	}
+
 	tflog.Info(ctx, "Updating environment variables", map[string]any{
-		"to_remove": len(toRemove),
-		"to_add":    len(toAdd),
-		"unchanged": len(unchanged),
+		"to_remove":    len(toRemove),
+		"to_add":       len(toAdd),
+		"to_update":    len(toUpdate),
+		"unchanged":    len(unchanged),
+		"create_first": len(createFirst),
 	})
 
-	for key, v := range toRemove {
-		err := r.client.DeleteEnvironmentVariable(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString(), v.ID.ValueString())
-		if client.NotFound(err) {
-			continue
+	var updateRequests []client.UpdateEnvironmentVariableRequest
+	var updateKeys []string
+	for _, key := range sortedKeys(toUpdate) {
+		existing := toUpdate[key]
+		// Build and validate the requests before changing anything, as with variables that are re-created.
+		u, diags := configEnvs[key].toUpdateEnvironmentVariableRequest(ctx, existing, plan.ProjectID, plan.TeamID)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		if keepDevelopment[key] {
+			u.Target = append(u.Target, "development")
 		}
+		updateRequests = append(updateRequests, u)
+		updateKeys = append(updateKeys, key)
+	}
+	var updateVariables []client.EnvironmentVariableRequest
+	for _, u := range updateRequests {
+		updateVariables = append(updateVariables, client.EnvironmentVariableRequest{Key: u.Key, CustomEnvironmentIDs: u.CustomEnvironmentIDs})
+	}
+	diags = validateCustomEnvironmentIDs(
+		ctx,
+		r.client,
+		plan.ProjectID.ValueString(),
+		plan.TeamID.ValueString(),
+		updateVariables,
+		func(i int) path.Path { return customEnvironmentIDsPath(updateKeys[i]) },
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var request client.CreateEnvironmentVariablesRequest
+	var addKeys []string
+	if len(toAdd) > 0 {
+		// Build and validate the request before removing anything, so an invalid configuration doesn't leave
+		// the project with variables deleted but not recreated.
+		request, addKeys, diags = toAdd.toCreateEnvironmentVariablesRequest(ctx, plan.ProjectID, plan.TeamID)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		for i, v := range request.EnvironmentVariables {
+			if keepDevelopment[addKeys[i]] {
+				request.EnvironmentVariables[i].Target = append(v.Target, "development")
+			}
+		}
+		diags = validateCustomEnvironmentIDs(
+			ctx,
+			r.client,
+			plan.ProjectID.ValueString(),
+			plan.TeamID.ValueString(),
+			request.EnvironmentVariables,
+			func(i int) path.Path { return customEnvironmentIDsPath(addKeys[i]) },
+		)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Split the variables to create into those created before and after the deletions.
+	before, after := request, request
+	before.EnvironmentVariables, after.EnvironmentVariables = nil, nil
+	for i, v := range request.EnvironmentVariables {
+		if createFirst[addKeys[i]] {
+			before.EnvironmentVariables = append(before.EnvironmentVariables, v)
+		} else {
+			after.EnvironmentVariables = append(after.EnvironmentVariables, v)
+		}
+	}
+
+	var response []client.EnvironmentVariable
+	if len(before.EnvironmentVariables) > 0 {
+		response, err = r.client.CreateEnvironmentVariables(ctx, before)
 		if err != nil {
 			resp.Diagnostics.AddError(
-				"Error updating Project Environment Variables",
-				fmt.Sprintf(
-					"Could not remove environment variable %s (%s), unexpected error: %s",
-					key,
-					v.ID.ValueString(),
-					err,
-				),
+				"Error updating project environment variables",
+				"Could not update project environment variable, unexpected error: "+err.Error(),
 			)
 			return
 		}
-
-		tflog.Info(ctx, "deleted environment variable", map[string]any{
-			"team_id":        plan.TeamID.ValueString(),
-			"project_id":     plan.ProjectID.ValueString(),
-			"environment_id": v.ID.ValueString(),
-		})
 	}
 
-	var response []client.EnvironmentVariable
-	if len(toAdd) > 0 {
-		if len(toRemove) > 0 {
-			// Sleep a bit to ensure the environment variables are fully propagated before we try to create them
-			// This is disgusting, but what you gonna do?
-			time.Sleep(time.Second * 5)
+	deleted, diags := r.deleteEnvironmentVariables(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString(), toRemove, "Error updating Project Environment Variables")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var recreatedIDs []string
+	for _, key := range deleted {
+		if _, ok := toAdd[key]; ok && !createFirst[key] {
+			recreatedIDs = append(recreatedIDs, toRemove[key].ID.ValueString())
 		}
-		request, diags := toAdd.toCreateEnvironmentVariablesRequest(ctx, plan.ProjectID, plan.TeamID)
+	}
 
-		if diags.HasError() {
-			resp.Diagnostics.Append(diags...)
-			return
+	if len(after.EnvironmentVariables) > 0 {
+		if len(recreatedIDs) > 0 {
+			tflog.Info(ctx, "waiting for deleted environment variables to propagate", map[string]any{
+				"project_id": plan.ProjectID.ValueString(),
+				"removed":    len(recreatedIDs),
+			})
+			err = r.client.WaitForEnvironmentVariablesDeleted(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString(), recreatedIDs)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error updating project environment variables",
+					"Could not confirm environment variables were deleted before re-creating them: "+err.Error(),
+				)
+				return
+			}
 		}
-		response, err = r.client.CreateEnvironmentVariables(ctx, request)
+		created, err := r.client.CreateEnvironmentVariables(ctx, after)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error updating project environment variables",
@@ -729,8 +1799,40 @@ func (r *projectEnvironmentVariablesResource) Update(ctx context.Context, req re
 			)
 			return
 		}
+		response = append(response, created...)
+	}
+
+	// Variables updated in place keep their ID, so they can all be updated at once.
+	updated := make([]client.EnvironmentVariable, len(updateRequests))
+	errs := runConcurrently(len(updateRequests), r.client.Features().EnvVars.Parallelism, func(i int) error {
+		var err error
+		updated[i], err = r.client.UpdateEnvironmentVariable(ctx, updateRequests[i])
+		return err
+	})
+	for i, err := range errs {
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating project environment variables",
+				fmt.Sprintf("Could not update environment variable %s (%s), unexpected error: %s", updateRequests[i].Key, updateRequests[i].EnvID, err),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	response = append(response, updated...)
+	for _, e := range response {
+		key, ok := apiKey(e)
+		if !ok {
+			continue
+		}
+		diags = recordEnvVariableWrite(ctx, resp.Private, plan.ProjectID.ValueString(), plan.TeamID.ValueString(), key, e)
+		resp.Diagnostics.Append(diags...)
 	}
 
+	if plan.ExcludeDevelopment.ValueBool() {
+		response = excludeDevelopmentTarget(response)
+	}
 	result, diags := convertResponseToProjectEnvironmentVariables(ctx, response, plan, unchanged)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
@@ -758,34 +1860,104 @@ func (r *projectEnvironmentVariablesResource) Delete(ctx context.Context, req re
 		return
 	}
 
+	if state.RetainOnDelete.ValueBool() {
+		tflog.Info(ctx, "retaining project environment variables on delete", map[string]any{
+			"team_id":    state.TeamID.ValueString(),
+			"project_id": state.ProjectID.ValueString(),
+		})
+		return
+	}
+
 	envs, diags := state.environment(ctx)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
 	}
-	for key, v := range envs {
-		err := r.client.DeleteEnvironmentVariable(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString(), v.ID.ValueString())
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error updating Project Environment Variables",
-				fmt.Sprintf(
-					"Could not remove environment variable %s (%s), unexpected error: %s",
-					key,
-					v.ID.ValueString(),
-					err,
-				),
+	_, diags = r.deleteEnvironmentVariables(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString(), envs, "Error deleting Project Environment Variables")
+	resp.Diagnostics.Append(diags...)
+}
+
+// deleteEnvironmentVariables deletes environment variables concurrently, as deleting them one at a time makes large
+// resources slow to update and destroy. Variables that no longer exist are treated as deleted. Every variable is
+// attempted, and an error is returned for each one that could not be deleted, along with the keys that were.
+func (r *projectEnvironmentVariablesResource) deleteEnvironmentVariables(ctx context.Context, projectID, teamID string, envs EnvironmentItemsMap, summary string) (deleted []string, diags diag.Diagnostics) {
+	keys := make([]string, 0, len(envs))
+	for key := range envs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	errs := runConcurrently(len(keys), r.client.Features().EnvVars.Parallelism, func(i int) error {
+		err := r.client.DeleteEnvironmentVariable(ctx, projectID, teamID, envs[keys[i]].ID.ValueString())
+		if client.NotFound(err) {
+			return nil
+		}
+		return err
+	})
+	for i, key := range keys {
+		id := envs[key].ID.ValueString()
+		if errs[i] != nil {
+			diags.AddAttributeError(
+				path.Root("variables").AtMapKey(key),
+				summary,
+				fmt.Sprintf("Could not remove environment variable %s (%s), unexpected error: %s", envs[key].name(key), id, errs[i]),
 			)
-			return
+			continue
 		}
 		tflog.Info(ctx, "deleted environment variable", map[string]any{
-			"team_id":        state.TeamID.ValueString(),
-			"project_id":     state.ProjectID.ValueString(),
-			"environment_id": v.ID.ValueString(),
+			"team_id":        teamID,
+			"project_id":     projectID,
+			"environment_id": id,
 		})
+		deleted = append(deleted, key)
+	}
+	return deleted, diags
+}
+
+// targetsOverlap returns true if two versions of an environment variable share a target or custom environment, in
+// which case Vercel does not allow them to exist at the same time.
+func targetsOverlap(ctx context.Context, a, b EnvironmentItem) (bool, diag.Diagnostics) {
+	var aTargets, bTargets, aCustom, bCustom []string
+	var diags diag.Diagnostics
+	diags.Append(a.Target.ElementsAs(ctx, &aTargets, true)...)
+	diags.Append(b.Target.ElementsAs(ctx, &bTargets, true)...)
+	diags.Append(a.CustomEnvironmentIDs.ElementsAs(ctx, &aCustom, true)...)
+	diags.Append(b.CustomEnvironmentIDs.ElementsAs(ctx, &bCustom, true)...)
+	if diags.HasError() {
+		return false, diags
+	}
+	for _, t := range bTargets {
+		if contains(aTargets, t) {
+			return true, nil
+		}
+	}
+	for _, id := range bCustom {
+		if contains(aCustom, id) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// excludeDevelopmentTarget removes development-only environment variables, and strips the `development` target
+// from all others, so that they can be compared against configuration that does not manage development values.
+func excludeDevelopmentTarget(envs []client.EnvironmentVariable) []client.EnvironmentVariable {
+	filtered := make([]client.EnvironmentVariable, 0, len(envs))
+	for _, e := range envs {
+		if isDevelopmentOnly(e) {
+			continue
+		}
+		filtered = append(filtered, withoutDevelopmentTarget(e))
 	}
+	return filtered
+}
+
+// customEnvironmentIDsPath returns the path to the custom_environment_ids of a variable.
+func customEnvironmentIDsPath(key string) path.Path {
+	return path.Root("variables").AtMapKey(key).AtName("custom_environment_ids")
 }
 
-// envVarMatches returns true if the two environment variables match by key, target, and custom_environment_ids.
+// envVarMatches returns true if the two environment variables match by key, target, custom_environment_ids and git_branch.
 func envVarMatches(ctx context.Context, key string, ee EnvironmentItem, e client.EnvironmentVariable) bool {
 	// TODO: Incorporate any data changes if the value in Vercel has updated, and we can actually read it.
 	
@@ -800,6 +1972,12 @@ func envVarMatches(ctx context.Context, key string, ee EnvironmentItem, e client
 		return false
 	}
 	if (key == e.Key && isSameStringSet(target, e.Target) && isSameStringSet(customEnvironmentIDs, e.CustomEnvironmentIDs)) {
+		if !sameGitBranch(ee.GitBranch.ValueStringPointer(), e.GitBranch) {
+			return false // The variable has moved to a different branch.
+		}
+		if !ee.Type.IsNull() && !ee.Type.IsUnknown() && ee.Type.ValueString() != e.Type {
+			return false // The type has been changed.
+		}
 		if e.Decrypted != nil && !*e.Decrypted {
 			return false // We don't know if it's value is encrypted.
 		}
@@ -813,3 +1991,147 @@ func envVarMatches(ctx context.Context, key string, ee EnvironmentItem, e client
 	}
 	return false
 }
+
+// environmentItemIndex finds the map key that an environment variable from the API is managed as, without comparing
+// it against every managed variable.
+type environmentItemIndex struct {
+	items  EnvironmentItemsMap
+	byID   map[string]string
+	byName map[string][]string
+}
+
+func (e EnvironmentItemsMap) index() environmentItemIndex {
+	i := environmentItemIndex{
+		items:  e,
+		byID:   map[string]string{},
+		byName: map[string][]string{},
+	}
+	for key, ee := range e {
+		if ee.ID.ValueString() != "" {
+			i.byID[ee.ID.ValueString()] = key
+		}
+		i.byName[ee.name(key)] = append(i.byName[ee.name(key)], key)
+	}
+	for _, keys := range i.byName {
+		sort.Strings(keys)
+	}
+	return i
+}
+
+// keyFor returns the map key of the variable that an environment variable from the API is managed as. It is found by
+// its ID, or otherwise by its name, git branch and an overlapping target or custom environment, so that a variable
+// with the same name that is only used for other environments, such as one added in the dashboard, is not mistaken
+// for a managed one.
+func (i environmentItemIndex) keyFor(ctx context.Context, e client.EnvironmentVariable) (string, bool) {
+	if key, ok := i.byID[e.ID]; ok {
+		return key, true
+	}
+	for _, key := range i.byName[e.Key] {
+		if sharesEnvironment(ctx, i.items[key], e) {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// sharesEnvironment returns whether a configured environment variable and one from the API have the same git branch
+// and share a target or custom environment. Unknown values are assumed to match, as they can't be compared yet.
+func sharesEnvironment(ctx context.Context, ee EnvironmentItem, e client.EnvironmentVariable) bool {
+	if !ee.GitBranch.IsUnknown() && !sameGitBranch(ee.GitBranch.ValueStringPointer(), e.GitBranch) {
+		return false
+	}
+	if ee.Target.IsUnknown() || ee.CustomEnvironmentIDs.IsUnknown() {
+		return true
+	}
+	var target, customEnvironmentIDs []string
+	if ee.Target.ElementsAs(ctx, &target, true).HasError() || ee.CustomEnvironmentIDs.ElementsAs(ctx, &customEnvironmentIDs, true).HasError() {
+		return false
+	}
+	return len(intersectStrings(target, e.Target)) > 0 || len(intersectStrings(customEnvironmentIDs, e.CustomEnvironmentIDs)) > 0
+}
+
+// managesName returns whether any variable has the given name.
+func (i environmentItemIndex) managesName(name string) bool {
+	return len(i.byName[name]) > 0
+}
+
+// MoveState allows a `vercel_project_environment_variable` resource to be moved into a
+// `vercel_project_environment_variables` resource with a `moved` block, without the Environment Variable being
+// deleted and created again. The variable is added to `variables` under its name.
+func (r *projectEnvironmentVariablesResource) MoveState(ctx context.Context) []resource.StateMover {
+	var source resource.SchemaResponse
+	(&projectEnvironmentVariableResource{}).Schema(ctx, resource.SchemaRequest{}, &source)
+	return []resource.StateMover{
+		{
+			SourceSchema: &source.Schema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "vercel_project_environment_variable" || !strings.HasSuffix(req.SourceProviderAddress, "/vercel") {
+					return
+				}
+				if req.SourceState == nil {
+					resp.Diagnostics.AddError(
+						"Unable to move Project Environment Variable",
+						"The state of the vercel_project_environment_variable resource could not be read. Please upgrade it with the current version of the provider before moving it.",
+					)
+					return
+				}
+				var e ProjectEnvironmentVariable
+				diags := req.SourceState.Get(ctx, &e)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// Custom Environment slugs are not supported in `target`, but are already resolved to
+				// custom_environment_ids.
+				var target []string
+				diags = e.Target.ElementsAs(ctx, &target, true)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				builtIn, _ := splitTargets(target)
+				targetValue := types.SetNull(types.StringType)
+				if len(builtIn) > 0 {
+					targetValue = toStringSet(builtIn)
+				}
+				envType := types.StringNull()
+				if e.Sensitive.ValueBool() {
+					envType = types.StringValue("sensitive")
+				}
+				item := EnvironmentItem{
+					Key:                  types.StringNull(),
+					Target:               targetValue,
+					CustomEnvironmentIDs: e.CustomEnvironmentIDs,
+					GitBranch:            e.GitBranch,
+					Value:                types.StringNull(),
+					ID:                   e.ID,
+					Sensitive:            e.Sensitive,
+					Type:                 envType,
+					Comment:              e.Comment,
+					ValueVersion:         types.StringNull(),
+					EdgeConfigItem:       types.ObjectNull(edgeConfigItemReferenceType.AttrTypes),
+				}
+
+				diags = resp.TargetState.Set(ctx, ProjectEnvironmentVariables{
+					TeamID:    e.TeamID,
+					ProjectID: e.ProjectID,
+					Variables: types.MapValueMust(EnvVariableElemType, map[string]attr.Value{
+						e.Key.ValueString(): item.toAttrValue(),
+					}),
+					RetainOnDelete:     e.RetainOnDelete,
+					ExcludeDevelopment: e.ExcludeDevelopment,
+					UpdateStrategy:     types.StringValue(updateStrategyDestroyBeforeCreate),
+					PreserveComment:    types.BoolValue(false),
+					UnmanagedBehavior:  types.StringValue(unmanagedVariableBehaviorIgnore),
+				})
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				diags = moveEnvVariablePrivateState(ctx, req.SourcePrivate, resp.TargetPrivate, e.ProjectID.ValueString(), e.TeamID.ValueString(), e.Key.ValueString(), e.Key.ValueString())
+				resp.Diagnostics.Append(diags...)
+			},
+		},
+	}
+}
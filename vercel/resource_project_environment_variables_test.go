@@ -1,11 +1,18 @@
 package vercel_test
 
 import (
+	"context"
 	"fmt"
+	"regexp"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
 )
 
 func TestAcc_ProjectEnvironmentVariables(t *testing.T) {
@@ -31,6 +38,8 @@ func TestAcc_ProjectEnvironmentVariables(t *testing.T) {
 						"value":      "test_value_2",
 						"git_branch": "staging",
 					}),
+					resource.TestCheckResourceAttr(resourceName, "retain_on_delete", "false"),
+					resource.TestCheckResourceAttr(resourceName, "exclude_development_target", "false"),
 					resource.TestCheckResourceAttrSet(resourceName, "variables.0.id"),
 					resource.TestCheckResourceAttrSet(resourceName, "variables.1.id"),
 				),
@@ -65,6 +74,193 @@ func TestAcc_ProjectEnvironmentVariables(t *testing.T) {
 	})
 }
 
+func TestAcc_ProjectEnvironmentVariablesInvalidCustomEnvironment(t *testing.T) {
+	projectName := "test-acc-example-env-vars-" + acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "%s"
+}
+
+resource "vercel_project_environment_variables" "test" {
+  project_id = vercel_project.test.id
+  variables = {
+    TEST_VAR_1 = {
+      value                  = "test_value_1"
+      custom_environment_ids = ["env_doesnotexist"]
+    }
+  }
+}
+`, projectName)),
+				ExpectError: regexp.MustCompile(`The custom environment "env_doesnotexist" does not exist`),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectEnvironmentVariablesInvalidCustomEnvironmentPlan(t *testing.T) {
+	projectName := "test-acc-env-vars-invalid-custom-" + acctest.RandString(16)
+	config := func(variables string) string {
+		return cfg(fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "%s"
+}
+
+resource "vercel_project_environment_variables" "test" {
+  project_id = vercel_project.test.id
+  variables = {
+    "TEST_VAR_1" = {
+      value  = "test_value_1"
+      target = ["production"]
+    }
+    %s
+  }
+}
+`, projectName, variables))
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config(""),
+			},
+			{
+				// Once the project exists, an unknown ID is reported when planning rather than applying.
+				Config: config(`
+    "TEST_VAR_2" = {
+      value                  = "test_value_2"
+      custom_environment_ids = ["env_doesnotexist"]
+    }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`The custom environment "env_doesnotexist" does not exist`),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectEnvironmentVariablesInvalidKey(t *testing.T) {
+	config := func(key string) string {
+		return fmt.Sprintf(`
+resource "vercel_project_environment_variables" "test" {
+  project_id = "prj_doesnotexist"
+  variables = {
+    "%s" = {
+      value  = "bar"
+      target = ["production"]
+    }
+  }
+}
+`, key)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      cfg(config("TZ")),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`The Environment Variable name "TZ" is reserved`),
+			},
+			{
+				Config:      cfg(config("1FOO-BAR")),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Names can only contain letters, digits and\s+underscores`),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectEnvironmentVariablesSameKeyDifferentTargets(t *testing.T) {
+	projectName := "test-acc-env-vars-same-key-" + acctest.RandString(16)
+	resourceName := "vercel_project_environment_variables.test"
+
+	config := func(previewValue string) string {
+		return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "%[1]s"
+}
+
+resource "vercel_project_environment_variables" "test" {
+  project_id = vercel_project.test.id
+  variables = {
+    "DATABASE_URL_production" = {
+      key       = "DATABASE_URL"
+      value     = "postgres://production"
+      target    = ["production"]
+      sensitive = false
+    }
+    "DATABASE_URL_preview" = {
+      key       = "DATABASE_URL"
+      value     = "%[2]s"
+      target    = ["preview"]
+      sensitive = false
+    }
+  }
+}
+`, projectName, previewValue)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(config("postgres://preview")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "variables.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "variables.DATABASE_URL_production.key", "DATABASE_URL"),
+					resource.TestCheckResourceAttr(resourceName, "variables.DATABASE_URL_preview.key", "DATABASE_URL"),
+					resource.TestCheckResourceAttrSet(resourceName, "variables.DATABASE_URL_production.id"),
+					resource.TestCheckResourceAttrSet(resourceName, "variables.DATABASE_URL_preview.id"),
+				),
+			},
+			{
+				Config: cfg(config("postgres://preview-2")),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: func(s *terraform.State) error {
+					rs := s.RootModule().Resources[resourceName]
+					envs, err := testClient(t).ListEnvironmentVariables(context.TODO(), rs.Primary.Attributes["project_id"], testTeam(t), client.EnvironmentVariableFilter{})
+					if err != nil {
+						return err
+					}
+					values := map[string]string{}
+					for _, e := range envs {
+						if e.Key == "DATABASE_URL" && len(e.Target) == 1 {
+							values[e.Target[0]] = e.Value
+						}
+					}
+					if values["production"] != "postgres://production" || values["preview"] != "postgres://preview-2" {
+						return fmt.Errorf("unexpected DATABASE_URL values: %v", values)
+					}
+					return nil
+				},
+			},
+			{
+				Config:      cfg(strings.Replace(config("postgres://preview"), `target    = ["preview"]`, `target    = ["production", "preview"]`, 1)),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`both define the Environment Variable DATABASE_URL`),
+			},
+		},
+	})
+}
+
 func testAccProjectEnvironmentVariablesConfig(projectName string, githubRepo string) string {
 	return fmt.Sprintf(`
 resource "vercel_project" "test" {
@@ -134,3 +330,954 @@ resource "vercel_project_environment_variables" "test" {
 }
 `, projectName, githubRepo)
 }
+
+func TestAcc_ProjectEnvironmentVariablesNonDestructiveUpdates(t *testing.T) {
+	projectName := "test-acc-env-vars-in-place-" + acctest.RandString(16)
+	resourceName := "vercel_project_environment_variables.test"
+
+	var id string
+	config := func(target string) string {
+		return fmt.Sprintf(`
+provider "vercel" {
+  team = "%[1]s"
+
+  features {
+    env_vars {
+      destructive_updates = false
+    }
+  }
+}
+
+resource "vercel_project" "test" {
+  name = "%[2]s"
+}
+
+resource "vercel_project_environment_variables" "test" {
+  project_id = vercel_project.test.id
+  variables = {
+    "FOO" = {
+      value  = "bar"
+      target = [%[3]s]
+    }
+  }
+}
+`, testTeam(t), projectName, target)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config(`"production"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith(resourceName, "variables.FOO.id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: config(`"production", "preview"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "variables.FOO.target.#", "2"),
+					resource.TestCheckResourceAttrWith(resourceName, "variables.FOO.id", func(value string) error {
+						if value != id {
+							return fmt.Errorf("expected environment variable to be updated in place, but its ID changed from %s to %s", id, value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectEnvironmentVariablesCreateBeforeDestroy(t *testing.T) {
+	projectName := "test-acc-env-vars-cbd-" + acctest.RandString(16)
+	resourceName := "vercel_project_environment_variables.test"
+
+	var id string
+	config := func(target string) string {
+		return cfg(fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "%[1]s"
+}
+
+resource "vercel_project_environment_variables" "test" {
+  project_id      = vercel_project.test.id
+  update_strategy = "create_before_destroy"
+  variables = {
+    "FOO" = {
+      value  = "bar"
+      target = [%[2]s]
+    }
+  }
+}
+`, projectName, target))
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config(`"production"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "update_strategy", "create_before_destroy"),
+					resource.TestCheckResourceAttrWith(resourceName, "variables.FOO.id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: config(`"preview"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr(resourceName, "variables.FOO.target.*", "preview"),
+					resource.TestCheckResourceAttrWith(resourceName, "variables.FOO.id", func(value string) error {
+						if value == id {
+							return fmt.Errorf("expected environment variable to be re-created, but its ID is unchanged")
+						}
+						return nil
+					}),
+					func(s *terraform.State) error {
+						rs := s.RootModule().Resources["vercel_project.test"]
+						envs, err := testClient(t).GetEnvironmentVariables(context.TODO(), rs.Primary.ID, testTeam(t))
+						if err != nil {
+							return err
+						}
+						count := 0
+						for _, e := range envs {
+							if e.Key == "FOO" {
+								count++
+							}
+						}
+						if count != 1 {
+							return fmt.Errorf("expected exactly one FOO environment variable, found %d", count)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectEnvironmentVariablesValueVersion(t *testing.T) {
+	projectName := "test-acc-env-vars-value-version-" + acctest.RandString(16)
+	resourceName := "vercel_project_environment_variables.test"
+
+	config := func(valueVersion string) string {
+		return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "%[1]s"
+}
+
+resource "vercel_project_environment_variables" "test" {
+  project_id = vercel_project.test.id
+  variables = {
+    "FOO" = {
+      value         = "bar"
+      value_version = "%[2]s"
+      target        = ["production"]
+      sensitive     = true
+    }
+  }
+}
+`, projectName, valueVersion)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(config("1")),
+				Check:  resource.TestCheckResourceAttr(resourceName, "variables.FOO.value_version", "1"),
+			},
+			{
+				Config: cfg(config("2")),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "variables.FOO.value_version", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "variables.FOO.id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectEnvironmentVariablesRename(t *testing.T) {
+	projectName := "test-acc-env-vars-rename-" + acctest.RandString(16)
+	resourceName := "vercel_project_environment_variables.test"
+
+	config := func(key string) string {
+		return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "%[1]s"
+}
+
+resource "vercel_project_environment_variables" "test" {
+  project_id                 = vercel_project.test.id
+  preserve_comment_on_rename = true
+  variables = {
+    "%[2]s" = {
+      value  = "bar"
+      target = ["production"]
+    }
+  }
+}
+`, projectName, key)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "%[1]s"
+}
+
+resource "vercel_project_environment_variables" "test" {
+  project_id                 = vercel_project.test.id
+  preserve_comment_on_rename = true
+  variables = {
+    "FOO" = {
+      value   = "bar"
+      target  = ["production"]
+      comment = "kept across renames"
+    }
+  }
+}
+`, projectName)),
+			},
+			{
+				Config: cfg(config("BAR")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "variables.FOO.id"),
+					resource.TestCheckResourceAttrSet(resourceName, "variables.BAR.id"),
+					resource.TestCheckResourceAttr(resourceName, "variables.BAR.comment", "kept across renames"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectEnvironmentVariablesDeleteUnmanaged(t *testing.T) {
+	projectName := "test-acc-env-vars-unmanaged-" + acctest.RandString(16)
+	resourceName := "vercel_project_environment_variables.test"
+	config := cfg(fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "%s"
+}
+
+resource "vercel_project_environment_variables" "test" {
+  project_id                  = vercel_project.test.id
+  unmanaged_variable_behavior = "delete"
+  variables = {
+    "FOO" = {
+      value  = "bar"
+      target = ["production"]
+    }
+  }
+}
+`, projectName))
+
+	var projectID string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.TestCheckResourceAttrWith("vercel_project.test", "id", func(value string) error {
+					projectID = value
+					return nil
+				}),
+			},
+			{
+				// A variable added outside of Terraform is deleted by the next apply.
+				PreConfig: func() {
+					_, err := testClient(t).CreateEnvironmentVariable(context.TODO(), client.CreateEnvironmentVariableRequest{
+						ProjectID: projectID,
+						TeamID:    testTeam(t),
+						EnvironmentVariable: client.EnvironmentVariableRequest{
+							Key:    "UNMANAGED",
+							Value:  "baz",
+							Target: []string{"preview"},
+							Type:   "encrypted",
+						},
+					})
+					if err != nil {
+						t.Fatalf("could not create unmanaged environment variable: %s", err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "variables.%", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "variables.UNMANAGED.id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectEnvironmentVariablesSameNameOtherTarget(t *testing.T) {
+	projectName := "test-acc-env-vars-other-target-" + acctest.RandString(16)
+	project := fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "%s"
+}
+`, projectName)
+
+	var projectID, unmanagedID string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(project),
+				Check: resource.TestCheckResourceAttrWith("vercel_project.test", "id", func(value string) error {
+					projectID = value
+					return nil
+				}),
+			},
+			{
+				// A variable with the same name that only exists for another target, such as one added in the
+				// dashboard, is not taken over. The configured variable is created alongside it.
+				PreConfig: func() {
+					e, err := testClient(t).CreateEnvironmentVariable(context.TODO(), client.CreateEnvironmentVariableRequest{
+						ProjectID: projectID,
+						TeamID:    testTeam(t),
+						EnvironmentVariable: client.EnvironmentVariableRequest{
+							Key:    "FOO",
+							Value:  "from-dashboard",
+							Target: []string{"preview"},
+							Type:   "encrypted",
+						},
+					})
+					if err != nil {
+						t.Fatalf("could not create unmanaged environment variable: %s", err)
+					}
+					unmanagedID = e.ID
+				},
+				Config: cfg(project + `
+resource "vercel_project_environment_variables" "test" {
+  project_id = vercel_project.test.id
+  variables = {
+    "FOO" = {
+      value  = "from-terraform"
+      target = ["production"]
+    }
+  }
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("vercel_project_environment_variables.test", "variables.FOO.id", func(value string) error {
+						if value == unmanagedID {
+							return fmt.Errorf("expected the preview Environment Variable not to be taken over")
+						}
+						return nil
+					}),
+					func(*terraform.State) error {
+						e, err := testClient(t).GetEnvironmentVariable(context.TODO(), projectID, testTeam(t), unmanagedID)
+						if err != nil {
+							return fmt.Errorf("expected the preview Environment Variable to still exist: %w", err)
+						}
+						if len(e.Target) != 1 || e.Target[0] != "preview" || e.Value != "from-dashboard" {
+							return fmt.Errorf("expected the preview Environment Variable to be left untouched, got target %v", e.Target)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectEnvironmentVariablesType(t *testing.T) {
+	projectName := "test-acc-env-vars-type-" + acctest.RandString(16)
+	resourceName := "vercel_project_environment_variables.test"
+	config := cfg(fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "%s"
+}
+
+resource "vercel_project_environment_variables" "test" {
+  project_id = vercel_project.test.id
+  variables = {
+    "FLAG" = {
+      value  = "1"
+      target = ["production", "preview"]
+      type   = "plain"
+    }
+    "SECRET" = {
+      value     = "bar"
+      target    = ["production"]
+      sensitive = false
+    }
+  }
+}
+`, projectName))
+
+	var projectID, secretID string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "variables.FLAG.type", "plain"),
+					resource.TestCheckResourceAttr(resourceName, "variables.FLAG.sensitive", "false"),
+					resource.TestCheckResourceAttr(resourceName, "variables.SECRET.type", "encrypted"),
+					resource.TestCheckResourceAttrWith("vercel_project.test", "id", func(value string) error {
+						projectID = value
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith(resourceName, "variables.SECRET.id", func(value string) error {
+						secretID = value
+						return nil
+					}),
+				),
+			},
+			{
+				// Changing the type in the dashboard is detected, and reverted in place.
+				PreConfig: func() {
+					_, err := testClient(t).UpdateEnvironmentVariable(context.TODO(), client.UpdateEnvironmentVariableRequest{
+						Key:       "SECRET",
+						Value:     "bar",
+						Target:    []string{"production"},
+						Type:      "plain",
+						ProjectID: projectID,
+						TeamID:    testTeam(t),
+						EnvID:     secretID,
+					})
+					if err != nil {
+						t.Fatalf("could not update environment variable: %s", err)
+					}
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "variables.SECRET.type", "encrypted"),
+					resource.TestCheckResourceAttrWith(resourceName, "variables.SECRET.id", func(value string) error {
+						if value != secretID {
+							return fmt.Errorf("expected SECRET to be updated in place, but its ID changed from %s to %s", secretID, value)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config: cfg(fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "%s"
+}
+
+resource "vercel_project_environment_variables" "test" {
+  project_id = vercel_project.test.id
+  variables = {
+    "FLAG" = {
+      value     = "1"
+      target    = ["production"]
+      type      = "plain"
+      sensitive = true
+    }
+  }
+}
+`, projectName)),
+				ExpectError: regexp.MustCompile(`does not agree with\s+.sensitive.`),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectEnvironmentVariablesEdgeConfigItem(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	resourceName := "vercel_project_environment_variables.test"
+	config := cfg(fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-env-vars-edge-config-%[1]s"
+}
+
+resource "vercel_edge_config" "test" {
+  name = "test-acc-env-vars-%[1]s"
+}
+
+resource "vercel_edge_config_item" "test" {
+  edge_config_id = vercel_edge_config.test.id
+  key            = "greeting"
+  value          = "hello"
+
+  lifecycle {
+    ignore_changes = [value]
+  }
+}
+
+resource "vercel_project_environment_variables" "test" {
+  project_id = vercel_project.test.id
+  variables = {
+    "GREETING" = {
+      edge_config_item = {
+        edge_config_id = vercel_edge_config.test.id
+        key            = vercel_edge_config_item.test.key
+      }
+      target    = ["production"]
+      sensitive = false
+    }
+  }
+}
+`, nameSuffix))
+
+	testValue := func(expected string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			rs, ok := s.RootModule().Resources[resourceName]
+			if !ok {
+				return fmt.Errorf("not found: %s", resourceName)
+			}
+			env, err := testClient(t).GetEnvironmentVariable(context.TODO(), rs.Primary.Attributes["project_id"], testTeam(t), rs.Primary.Attributes["variables.GREETING.id"])
+			if err != nil {
+				return err
+			}
+			if env.Value != expected {
+				return fmt.Errorf("expected the value of GREETING to be %q, got %q", expected, env.Value)
+			}
+			return nil
+		}
+	}
+
+	var edgeConfigID string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "variables.GREETING.edge_config_item.key", "greeting"),
+					resource.TestCheckResourceAttrWith("vercel_edge_config.test", "id", func(value string) error {
+						edgeConfigID = value
+						return nil
+					}),
+					testValue("hello"),
+				),
+			},
+			{
+				// A change to the Edge Config item is applied to the Environment Variable.
+				PreConfig: func() {
+					_, err := testClient(t).CreateEdgeConfigItem(context.TODO(), client.CreateEdgeConfigItemRequest{
+						EdgeConfigID: edgeConfigID,
+						TeamID:       testTeam(t),
+						Key:          "greeting",
+						Value:        "goodbye",
+					})
+					if err != nil {
+						t.Fatalf("could not update edge config item: %s", err)
+					}
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testValue("goodbye"),
+			},
+			{
+				Config: cfg(fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-env-vars-edge-config-%s"
+}
+
+resource "vercel_project_environment_variables" "test" {
+  project_id = vercel_project.test.id
+  variables = {
+    "GREETING" = {
+      value = "hello"
+      edge_config_item = {
+        edge_config_id = "ecfg_example"
+        key            = "greeting"
+      }
+      target = ["production"]
+    }
+  }
+}
+`, nameSuffix)),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectEnvironmentVariablesSensitiveChangedOutside(t *testing.T) {
+	projectName := "test-acc-env-vars-changed-outside-" + acctest.RandString(16)
+	resourceName := "vercel_project_environment_variables.test"
+	config := cfg(fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "%s"
+}
+
+resource "vercel_project_environment_variables" "test" {
+  project_id = vercel_project.test.id
+  variables = {
+    "SECRET" = {
+      value     = "bar"
+      target    = ["production"]
+      sensitive = true
+    }
+  }
+}
+`, projectName))
+
+	var projectID, secretID string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("vercel_project.test", "id", func(value string) error {
+						projectID = value
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith(resourceName, "variables.SECRET.id", func(value string) error {
+						secretID = value
+						return nil
+					}),
+				),
+			},
+			{
+				// Rotating the sensitive value in the dashboard is detected from its updatedAt, and reverted.
+				PreConfig: func() {
+					_, err := testClient(t).UpdateEnvironmentVariable(context.TODO(), client.UpdateEnvironmentVariableRequest{
+						Key:       "SECRET",
+						Value:     "rotated",
+						Target:    []string{"production"},
+						Type:      "sensitive",
+						ProjectID: projectID,
+						TeamID:    testTeam(t),
+						EnvID:     secretID,
+					})
+					if err != nil {
+						t.Fatalf("could not update environment variable: %s", err)
+					}
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectEnvironmentVariablesChunkedCreate(t *testing.T) {
+	projectName := "test-acc-env-vars-chunked-" + acctest.RandString(16)
+	resourceName := "vercel_project_environment_variables.test"
+	config := func(extra string) string {
+		return cfg(fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "%s"
+}
+
+resource "vercel_project_environment_variable" "conflict" {
+  project_id = vercel_project.test.id
+  key        = "CONFLICT"
+  value      = "existing"
+  target     = ["production"]
+}
+
+resource "vercel_project_environment_variables" "test" {
+  project_id = vercel_project.test.id
+  variables = merge(
+    { for i in range(120) : "CHUNKED_${i}" => { value = "value-${i}", target = ["production"] } },
+    %s
+  )
+}
+`, projectName, extra))
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				// The variables are created over several requests, and the one that fails is reported by key.
+				Config:      config(`{ "CONFLICT" = { value = "new", target = ["production"] } }`),
+				ExpectError: regexp.MustCompile(`(?s)Could not create the project environment variables.*CONFLICT`),
+			},
+			{
+				Config: config(`{}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "variables.%", "120"),
+					resource.TestCheckResourceAttr(resourceName, "variables.CHUNKED_0.value", "value-0"),
+					resource.TestCheckResourceAttr(resourceName, "variables.CHUNKED_119.value", "value-119"),
+					resource.TestCheckResourceAttrSet(resourceName, "variables.CHUNKED_119.id"),
+				),
+			},
+			{
+				Config: config(`{}`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectEnvironmentVariablesRetainOnDeleteReplaced(t *testing.T) {
+	projectName := "test-acc-env-vars-retain-" + acctest.RandString(16)
+	resourceName := "vercel_project_environment_variables.test"
+
+	var id string
+	config := func(value string) string {
+		return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "%[1]s"
+}
+
+resource "vercel_project_environment_variables" "test" {
+  project_id       = vercel_project.test.id
+  retain_on_delete = true
+  variables = {
+    "FOO" = {
+      value  = "%[2]s"
+      target = ["production"]
+    }
+  }
+}
+`, projectName, value)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(config("bar")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith(resourceName, "variables.FOO.id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+			{
+				// Changing a value replaces the resource, which leaves the variables in place, so they are adopted
+				// and updated rather than failing to be created again.
+				Config: cfg(config("baz")),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "variables.FOO.value", "baz"),
+					resource.TestCheckResourceAttrWith(resourceName, "variables.FOO.id", func(value string) error {
+						if value != id {
+							return fmt.Errorf("expected the retained environment variable %s to be adopted, got %s", id, value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectEnvironmentVariablesRetainOnDelete(t *testing.T) {
+	projectName := "test-acc-env-vars-retain-" + acctest.RandString(16)
+	resourceName := "vercel_project_environment_variables.test"
+	projectConfig := fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "%s"
+}
+`, projectName)
+
+	var projectID string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(projectConfig + `
+resource "vercel_project_environment_variables" "test" {
+  project_id       = vercel_project.test.id
+  retain_on_delete = true
+  variables = {
+    "FOO" = {
+      value  = "bar"
+      target = ["production"]
+    }
+  }
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "retain_on_delete", "true"),
+					resource.TestCheckResourceAttrWith(resourceName, "project_id", func(value string) error {
+						projectID = value
+						return nil
+					}),
+				),
+			},
+			{
+				// Removing the resource destroys it, which should leave the variables in place on the project.
+				Config: cfg(projectConfig),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroy),
+					},
+				},
+				Check: func(*terraform.State) error {
+					envs, err := testClient(t).GetEnvironmentVariables(context.TODO(), projectID, testTeam(t))
+					if err != nil {
+						return err
+					}
+					for _, e := range envs {
+						if e.Key == "FOO" {
+							return nil
+						}
+					}
+					return fmt.Errorf("expected Environment Variable FOO to be retained on project %s", projectID)
+				},
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectEnvironmentVariablesExcludeDevelopmentTarget(t *testing.T) {
+	projectName := "test-acc-env-vars-exclude-dev-" + acctest.RandString(16)
+	resourceName := "vercel_project_environment_variables.test"
+	config := func(target string) string {
+		return cfg(fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "%[1]s"
+}
+
+resource "vercel_project_environment_variables" "test" {
+  project_id                 = vercel_project.test.id
+  exclude_development_target = true
+  variables = {
+    "FOO" = {
+      value     = "bar"
+      target    = [%[2]s]
+      sensitive = false
+    }
+  }
+}
+`, projectName, target))
+	}
+
+	var projectID, id string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config(`"production", "preview"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "exclude_development_target", "true"),
+					resource.TestCheckResourceAttrWith("vercel_project.test", "id", func(value string) error {
+						projectID = value
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith(resourceName, "variables.FOO.id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+			{
+				// A development target added outside of Terraform, such as with `vercel env add`, is not drift.
+				PreConfig: func() {
+					_, err := testClient(t).UpdateEnvironmentVariable(context.TODO(), client.UpdateEnvironmentVariableRequest{
+						Key:       "FOO",
+						Value:     "bar",
+						Target:    []string{"production", "preview", "development"},
+						Type:      "encrypted",
+						ProjectID: projectID,
+						TeamID:    testTeam(t),
+						EnvID:     id,
+					})
+					if err != nil {
+						t.Fatalf("could not update environment variable: %s", err)
+					}
+				},
+				Config: config(`"production", "preview"`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "variables.FOO.target.#", "2"),
+					func(*terraform.State) error {
+						e, err := testClient(t).GetEnvironmentVariable(context.TODO(), projectID, testTeam(t), id)
+						if err != nil {
+							return err
+						}
+						if !slices.Contains(e.Target, "development") {
+							return fmt.Errorf("expected the development target to be preserved, got %v", e.Target)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config:      config(`"production", "development"`),
+				ExpectError: regexp.MustCompile("The `development` target cannot be used"),
+			},
+		},
+	})
+}
@@ -0,0 +1,351 @@
+package vercel
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// flagsSecretKey is the name of the environment variable the Flags Explorer reads its secret from.
+const flagsSecretKey = "FLAGS_SECRET"
+
+var (
+	_ resource.Resource                = &projectFlagsExplorerResource{}
+	_ resource.ResourceWithConfigure   = &projectFlagsExplorerResource{}
+	_ resource.ResourceWithImportState = &projectFlagsExplorerResource{}
+	_ resource.ResourceWithModifyPlan  = &projectFlagsExplorerResource{}
+)
+
+func newProjectFlagsExplorerResource() resource.Resource {
+	return &projectFlagsExplorerResource{}
+}
+
+type projectFlagsExplorerResource struct {
+	client *client.Client
+}
+
+func (r *projectFlagsExplorerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_flags_explorer"
+}
+
+func (r *projectFlagsExplorerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema returns the schema information for a project flags explorer resource.
+func (r *projectFlagsExplorerResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a Project Flags Explorer resource.
+
+The Flags Explorer is part of the Vercel Toolbar, and allows feature flags to be viewed and overridden for a single browser session.
+It is enabled for a project by setting a ` + "`" + flagsSecretKey + "`" + ` Environment Variable, which this resource manages. Destroying this resource disables the Flags Explorer.
+
+For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/feature-flags/flags-explorer).
+
+~> The ` + "`" + flagsSecretKey + "`" + ` Environment Variable should not also be managed by a ` + "`vercel_project_environment_variable` or `vercel_project_environment_variables`" + ` resource.
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description:   "The ID of the Vercel project.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the Vercel team. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"id": schema.StringAttribute{
+				Description:   "The ID of the `" + flagsSecretKey + "` Environment Variable.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"secret": schema.StringAttribute{
+				Description: "The secret used by the Flags Explorer to authenticate requests and encrypt flag overrides. This must be 32 random bytes, encoded using base64url.",
+				Required:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Validators: []validator.String{
+					validateFlagsSecret(),
+				},
+			},
+		},
+	}
+}
+
+// ProjectFlagsExplorer reflects the state terraform stores internally for a project flags explorer.
+type ProjectFlagsExplorer struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	TeamID    types.String `tfsdk:"team_id"`
+	ID        types.String `tfsdk:"id"`
+	Secret    types.String `tfsdk:"secret"`
+}
+
+func flagsSecretPrivateKey(projectID, teamID types.String) string {
+	return fmt.Sprintf("vercel_flags_secret_%s_%s", projectID.ValueString(), teamID.ValueString())
+}
+
+func convertResponseToProjectFlagsExplorer(response client.EnvironmentVariable, projectID types.String) ProjectFlagsExplorer {
+	return ProjectFlagsExplorer{
+		ProjectID: projectID,
+		TeamID:    toTeamID(response.TeamID),
+		ID:        types.StringValue(response.ID),
+		Secret:    types.StringNull(),
+	}
+}
+
+// ModifyPlan forces the secret to be replaced if it has changed. As it is write-only, terraform
+// cannot detect this itself, so a hash of the secret is stored in private state.
+func (r *projectFlagsExplorerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+	var config ProjectFlagsExplorer
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.Secret.IsUnknown() {
+		return
+	}
+
+	var state ProjectFlagsExplorer
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hash := sha256.Sum256([]byte(config.Secret.ValueString()))
+	storedHash, _ := req.Private.GetKey(ctx, flagsSecretPrivateKey(state.ProjectID, state.TeamID))
+	if strings.Trim(string(storedHash), "\"") != fmt.Sprintf("%x", hash) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("secret"))
+	}
+}
+
+// Create will create the FLAGS_SECRET environment variable for a Vercel project.
+// This is called automatically by the provider when a new resource should be created.
+func (r *projectFlagsExplorerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectFlagsExplorer
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.GetProject(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString())
+	if client.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error creating project flags explorer",
+			"Could not find project, please make sure both the project_id and team_id match the project and team you wish to deploy to.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating project flags explorer",
+			"Error reading project information, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	response, err := r.client.CreateEnvironmentVariable(ctx, client.CreateEnvironmentVariableRequest{
+		EnvironmentVariable: client.EnvironmentVariableRequest{
+			Key:     flagsSecretKey,
+			Value:   plan.Secret.ValueString(),
+			Target:  []string{"production", "preview", "development"},
+			Type:    "encrypted",
+			Comment: "Managed by Terraform for the Vercel Flags Explorer",
+		},
+		ProjectID: plan.ProjectID.ValueString(),
+		TeamID:    plan.TeamID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating project flags explorer",
+			"Could not create "+flagsSecretKey+" environment variable, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	result := convertResponseToProjectFlagsExplorer(response, plan.ProjectID)
+	hash := sha256.Sum256([]byte(plan.Secret.ValueString()))
+	resp.Private.SetKey(ctx, flagsSecretPrivateKey(result.ProjectID, result.TeamID), []byte(fmt.Sprintf("\"%x\"", hash)))
+
+	tflog.Info(ctx, "created project flags explorer", map[string]any{
+		"id":         result.ID.ValueString(),
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read will read the FLAGS_SECRET environment variable of a Vercel project by requesting it from the Vercel API,
+// and will update terraform with this information.
+func (r *projectFlagsExplorerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectFlagsExplorer
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetEnvironmentVariable(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString(), state.ID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading project flags explorer",
+			fmt.Sprintf("Could not get project flags explorer %s %s, unexpected error: %s",
+				state.ProjectID.ValueString(),
+				state.ID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	result := convertResponseToProjectFlagsExplorer(out, state.ProjectID)
+	tflog.Info(ctx, "read project flags explorer", map[string]any{
+		"id":         result.ID.ValueString(),
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update does nothing, as every change to a project flags explorer requires replacement.
+func (r *projectFlagsExplorerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ProjectFlagsExplorer
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the FLAGS_SECRET environment variable, disabling the Flags Explorer.
+func (r *projectFlagsExplorerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProjectFlagsExplorer
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteEnvironmentVariable(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString(), state.ID.ValueString())
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting project flags explorer",
+			fmt.Sprintf(
+				"Could not delete project flags explorer %s, unexpected error: %s",
+				state.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	resp.Private.SetKey(ctx, flagsSecretPrivateKey(state.ProjectID, state.TeamID), nil)
+	tflog.Info(ctx, "deleted project flags explorer", map[string]any{
+		"id":         state.ID.ValueString(),
+		"team_id":    state.TeamID.ValueString(),
+		"project_id": state.ProjectID.ValueString(),
+	})
+}
+
+// ImportState takes an identifier and finds the FLAGS_SECRET environment variable for the project.
+// The results are then stored in terraform state. As the secret is write-only, the next plan will replace it.
+func (r *projectFlagsExplorerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, projectID, ok := splitInto1Or2(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing project flags explorer",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/project_id\" or \"project_id\"", req.ID),
+		)
+		return
+	}
+
+	envs, err := r.client.GetEnvironmentVariables(ctx, projectID, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing project flags explorer",
+			fmt.Sprintf("Could not get environment variables for project %s %s, unexpected error: %s",
+				teamID,
+				projectID,
+				err,
+			),
+		)
+		return
+	}
+
+	for _, env := range envs {
+		if env.Key != flagsSecretKey {
+			continue
+		}
+		result := convertResponseToProjectFlagsExplorer(env, types.StringValue(projectID))
+		tflog.Info(ctx, "imported project flags explorer", map[string]any{
+			"id":         result.ID.ValueString(),
+			"team_id":    result.TeamID.ValueString(),
+			"project_id": result.ProjectID.ValueString(),
+		})
+
+		diags := resp.State.Set(ctx, result)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Error importing project flags explorer",
+		fmt.Sprintf("Could not find a %s environment variable for project %s %s", flagsSecretKey, teamID, projectID),
+	)
+}
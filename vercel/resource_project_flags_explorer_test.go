@@ -0,0 +1,78 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+func testAccProjectFlagsExplorerExists(testClient *client.Client, n, teamID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		env, err := testClient.GetEnvironmentVariable(context.TODO(), rs.Primary.Attributes["project_id"], teamID, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if env.Key != "FLAGS_SECRET" {
+			return fmt.Errorf("expected FLAGS_SECRET environment variable, but got %s", env.Key)
+		}
+		return nil
+	}
+}
+
+func TestAcc_ProjectFlagsExplorer(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.example", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config:      cfg(testAccProjectFlagsExplorerConfig(nameSuffix, "not-a-valid-secret")),
+				ExpectError: regexp.MustCompile("Invalid Flags secret"),
+			},
+			{
+				Config: cfg(testAccProjectFlagsExplorerConfig(nameSuffix, "c2VjcmV0LXNlY3JldC1zZWNyZXQtc2VjcmV0LXNlY3I")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectFlagsExplorerExists(testClient(t), "vercel_project_flags_explorer.example", testTeam(t)),
+					resource.TestCheckResourceAttrSet("vercel_project_flags_explorer.example", "id"),
+					resource.TestCheckNoResourceAttr("vercel_project_flags_explorer.example", "secret"),
+				),
+			},
+			{
+				Config: cfg(testAccProjectFlagsExplorerConfig(nameSuffix, "dXBkYXRlZC11cGRhdGVkLXVwZGF0ZWQtdXBkYXRlZC0")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectFlagsExplorerExists(testClient(t), "vercel_project_flags_explorer.example", testTeam(t)),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectFlagsExplorerConfig(projectName, secret string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "example" {
+  name = "test-acc-flags-explorer-%[1]s"
+}
+
+resource "vercel_project_flags_explorer" "example" {
+  project_id = vercel_project.example.id
+  secret     = "%[2]s"
+}
+`, projectName, secret)
+}
@@ -0,0 +1,415 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// Compile-time assertions to ensure the implementation conforms to the expected interfaces.
+var (
+	_ resource.Resource                     = &projectHeaderRuleResource{}
+	_ resource.ResourceWithConfigure        = &projectHeaderRuleResource{}
+	_ resource.ResourceWithImportState      = &projectHeaderRuleResource{}
+	_ resource.ResourceWithConfigValidators = &projectHeaderRuleResource{}
+)
+
+func newProjectHeaderRuleResource() resource.Resource {
+	return &projectHeaderRuleResource{}
+}
+
+type projectHeaderRuleResource struct {
+	client *client.Client
+}
+
+func (r *projectHeaderRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_header_rule"
+}
+
+func (r *projectHeaderRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cli, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = cli
+}
+
+func (r *projectHeaderRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a Project Header Rule resource.
+
+A Header Rule adds or overrides headers on requests and responses that match a path, such as security headers like ` + "`Strict-Transport-Security`" + ` or ` + "`Content-Security-Policy`" + `. Header Rules are managed through the Vercel API rather than ` + "`vercel.json`" + `, so they can be enforced centrally without changes to the project's code.
+
+Header Rules take effect immediately and do not require a new Deployment.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The ID of the Header Rule.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"project_id": schema.StringAttribute{
+				Description:   "The ID of the Project the Header Rule applies to.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the team the Project exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Description: "A name for the Header Rule, shown in the Vercel dashboard.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the Header Rule.",
+				Optional:    true,
+			},
+			"source": schema.StringAttribute{
+				Description: "A regular expression matching the request paths the Header Rule applies to, e.g. `/(.*)` for all paths. This uses the same syntax as the `src` of a route in `vercel.json`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the Header Rule is applied. Defaults to `true`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"response_headers": schema.MapAttribute{
+				Description: "Headers to set on responses, keyed by header name.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"request_headers": schema.MapAttribute{
+				Description: "Headers to set on requests before they reach the Deployment, keyed by header name.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *projectHeaderRuleResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("response_headers"),
+			path.MatchRoot("request_headers"),
+		),
+	}
+}
+
+// ProjectHeaderRule mirrors the Terraform state for the resource.
+type ProjectHeaderRule struct {
+	ID              types.String `tfsdk:"id"`
+	ProjectID       types.String `tfsdk:"project_id"`
+	TeamID          types.String `tfsdk:"team_id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Source          types.String `tfsdk:"source"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	ResponseHeaders types.Map    `tfsdk:"response_headers"`
+	RequestHeaders  types.Map    `tfsdk:"request_headers"`
+}
+
+func (h ProjectHeaderRule) toProjectRoute(ctx context.Context) (route client.ProjectRoute, diags diag.Diagnostics) {
+	var responseHeaders, requestHeaders map[string]string
+	diags.Append(h.ResponseHeaders.ElementsAs(ctx, &responseHeaders, false)...)
+	diags.Append(h.RequestHeaders.ElementsAs(ctx, &requestHeaders, false)...)
+	if diags.HasError() {
+		return route, diags
+	}
+
+	var transforms []client.ProjectRouteTransform
+	for k, v := range requestHeaders {
+		transforms = append(transforms, client.ProjectRouteTransform{
+			Type:   "request.headers",
+			Op:     "set",
+			Target: client.ProjectRouteTransformTarget{Key: k},
+			Args:   v,
+		})
+	}
+	return client.ProjectRoute{
+		ID:          h.ID.ValueString(),
+		ProjectID:   h.ProjectID.ValueString(),
+		TeamID:      h.TeamID.ValueString(),
+		Name:        h.Name.ValueString(),
+		Description: h.Description.ValueString(),
+		Enabled:     h.Enabled.ValueBool(),
+		Route: client.ProjectRouteDefinition{
+			Src:        h.Source.ValueString(),
+			Headers:    responseHeaders,
+			Transforms: transforms,
+			// Header rules should not stop later routes from matching.
+			Continue: true,
+		},
+	}, diags
+}
+
+func convertResponseToProjectHeaderRule(ctx context.Context, out client.ProjectRoute) (h ProjectHeaderRule, diags diag.Diagnostics) {
+	responseHeaders := types.MapNull(types.StringType)
+	if len(out.Route.Headers) > 0 {
+		responseHeaders, diags = types.MapValueFrom(ctx, types.StringType, out.Route.Headers)
+		if diags.HasError() {
+			return h, diags
+		}
+	}
+	requested := map[string]string{}
+	for _, t := range out.Route.Transforms {
+		if t.Type == "request.headers" && t.Op == "set" {
+			requested[t.Target.Key] = t.Args
+		}
+	}
+	requestHeaders := types.MapNull(types.StringType)
+	if len(requested) > 0 {
+		requestHeaders, diags = types.MapValueFrom(ctx, types.StringType, requested)
+		if diags.HasError() {
+			return h, diags
+		}
+	}
+
+	return ProjectHeaderRule{
+		ID:              types.StringValue(out.ID),
+		ProjectID:       types.StringValue(out.ProjectID),
+		TeamID:          toTeamID(out.TeamID),
+		Name:            types.StringValue(out.Name),
+		Description:     optionalStringValue(out.Description),
+		Source:          types.StringValue(out.Route.Src),
+		Enabled:         types.BoolValue(out.Enabled),
+		ResponseHeaders: responseHeaders,
+		RequestHeaders:  requestHeaders,
+	}, nil
+}
+
+func (r *projectHeaderRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectHeaderRule
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, diags := plan.toProjectRoute(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	out, err := r.client.CreateProjectRoute(ctx, request)
+	if client.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error creating project header rule",
+			"Could not find project, please make sure both the project_id and team_id match the project and team you wish to configure.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating project header rule",
+			"Could not create project header rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	result, diags := convertResponseToProjectHeaderRule(ctx, out)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "created project header rule", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"id":         result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectHeaderRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectHeaderRule
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetProjectRoute(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString(), state.ID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading project header rule",
+			fmt.Sprintf("Could not get project header rule %s %s %s, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.ProjectID.ValueString(),
+				state.ID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	result, diags := convertResponseToProjectHeaderRule(ctx, out)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "read project header rule", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"id":         result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectHeaderRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ProjectHeaderRule
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, diags := plan.toProjectRoute(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	out, err := r.client.UpdateProjectRoute(ctx, request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating project header rule",
+			fmt.Sprintf("Could not update project header rule %s %s %s, unexpected error: %s",
+				plan.TeamID.ValueString(),
+				plan.ProjectID.ValueString(),
+				plan.ID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	result, diags := convertResponseToProjectHeaderRule(ctx, out)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Trace(ctx, "updated project header rule", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"id":         result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectHeaderRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProjectHeaderRule
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteProjectRoute(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString(), state.ID.ValueString())
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting project header rule",
+			fmt.Sprintf("Could not delete project header rule %s %s %s, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.ProjectID.ValueString(),
+				state.ID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "deleted project header rule", map[string]any{
+		"team_id":    state.TeamID.ValueString(),
+		"project_id": state.ProjectID.ValueString(),
+		"id":         state.ID.ValueString(),
+	})
+}
+
+func (r *projectHeaderRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, projectID, id, ok := splitInto2Or3(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing project header rule",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/project_id/header_rule_id\" or \"project_id/header_rule_id\"", req.ID),
+		)
+		return
+	}
+
+	out, err := r.client.GetProjectRoute(ctx, projectID, teamID, id)
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading project header rule",
+			fmt.Sprintf("Could not get project header rule %s %s %s, unexpected error: %s", teamID, projectID, id, err),
+		)
+		return
+	}
+
+	result, diags := convertResponseToProjectHeaderRule(ctx, out)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "imported project header rule", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"id":         result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,97 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+func testAccProjectHeaderRuleExists(testClient *client.Client, n, teamID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		_, err := testClient.GetProjectRoute(context.TODO(), rs.Primary.Attributes["project_id"], teamID, rs.Primary.ID)
+		return err
+	}
+}
+
+func TestAcc_ProjectHeaderRule(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.example", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccProjectHeaderRuleConfig(nameSuffix, `
+  response_headers = {
+    "Strict-Transport-Security" = "max-age=63072000"
+  }
+`)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectHeaderRuleExists(testClient(t), "vercel_project_header_rule.example", testTeam(t)),
+					resource.TestCheckResourceAttr("vercel_project_header_rule.example", "enabled", "true"),
+					resource.TestCheckResourceAttr("vercel_project_header_rule.example", "source", "/(.*)"),
+					resource.TestCheckResourceAttr("vercel_project_header_rule.example", "response_headers.%", "1"),
+					resource.TestCheckResourceAttr("vercel_project_header_rule.example", "response_headers.Strict-Transport-Security", "max-age=63072000"),
+					resource.TestCheckNoResourceAttr("vercel_project_header_rule.example", "request_headers"),
+				),
+			},
+			{
+				Config: cfg(testAccProjectHeaderRuleConfig(nameSuffix, `
+  enabled = false
+  response_headers = {
+    "X-Content-Type-Options" = "nosniff"
+  }
+  request_headers = {
+    "X-Internal" = "true"
+  }
+`)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectHeaderRuleExists(testClient(t), "vercel_project_header_rule.example", testTeam(t)),
+					resource.TestCheckResourceAttr("vercel_project_header_rule.example", "enabled", "false"),
+					resource.TestCheckResourceAttr("vercel_project_header_rule.example", "response_headers.%", "1"),
+					resource.TestCheckResourceAttr("vercel_project_header_rule.example", "response_headers.X-Content-Type-Options", "nosniff"),
+					resource.TestCheckResourceAttr("vercel_project_header_rule.example", "request_headers.X-Internal", "true"),
+				),
+			},
+			{
+				ResourceName:      "vercel_project_header_rule.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["vercel_project_header_rule.example"]
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["project_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccProjectHeaderRuleConfig(projectName, headers string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "example" {
+  name = "test-acc-header-rule-%[1]s"
+}
+
+resource "vercel_project_header_rule" "example" {
+  project_id = vercel_project.example.id
+  name       = "Security headers"
+  source     = "/(.*)"
+%[2]s
+}
+`, projectName, headers)
+}
@@ -0,0 +1,611 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// Compile-time assertions to ensure the implementation conforms to the expected interfaces.
+var (
+	_ resource.Resource                     = &projectRoutingRulesResource{}
+	_ resource.ResourceWithConfigure        = &projectRoutingRulesResource{}
+	_ resource.ResourceWithImportState      = &projectRoutingRulesResource{}
+	_ resource.ResourceWithConfigValidators = &projectRoutingRulesResource{}
+	_ resource.ResourceWithValidateConfig   = &projectRoutingRulesResource{}
+)
+
+func newProjectRoutingRulesResource() resource.Resource {
+	return &projectRoutingRulesResource{}
+}
+
+type projectRoutingRulesResource struct {
+	client *client.Client
+}
+
+func (r *projectRoutingRulesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_routing_rules"
+}
+
+func (r *projectRoutingRulesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cli, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = cli
+}
+
+func (r *projectRoutingRulesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a Project Routing Rules resource.
+
+Routing Rules are redirects and rewrites that are managed through the Vercel API rather than ` + "`vercel.json`" + `, so large redirect maps can be maintained without changes to the project's code. Routing Rules take effect immediately and do not require a new Deployment.
+
+This resource manages all of the API-managed redirects and rewrites of a Project. Any redirects or rewrites added outside of this resource will be removed. Header rules managed by ` + "`vercel_project_header_rule`" + ` are not affected.
+
+Routing Rules are evaluated in order, and the first rule whose ` + "`source`" + ` matches a request is used. All ` + "`redirects`" + ` are evaluated before any ` + "`rewrites`" + `, and each list is evaluated in the order it is configured, so list more specific sources before broader ones that overlap them.
+
+~> Vercel cannot move an existing rule. Adding rules to the end of a list only creates the new rules, but inserting or reordering rules earlier in the list re-creates every rule after that point.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The ID of the Project.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"project_id": schema.StringAttribute{
+				Description:   "The ID of the Project to manage routing rules for.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the team the Project exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"redirects": schema.ListNestedAttribute{
+				Description: "Requests matching a `source` are redirected to a `destination`. Redirects are evaluated in the order they are listed, before any `rewrites`.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							Description: "A regular expression matching the request paths to redirect, using the same syntax as the `src` of a route in `vercel.json`. Each `source` must be unique.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"destination": schema.StringAttribute{
+							Description: "The path or URL to redirect to. Capture groups from the `source` can be referenced as `$1`, `$2` etc.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"status_code": schema.Int64Attribute{
+							Description: "The HTTP status code of the redirect. Must be one of `301`, `302`, `303`, `307` or `308`. Defaults to `308`.",
+							Optional:    true,
+							Computed:    true,
+							Default:     int64default.StaticInt64(308),
+							Validators: []validator.Int64{
+								int64validator.OneOf(301, 302, 303, 307, 308),
+							},
+						},
+					},
+				},
+			},
+			"rewrites": schema.ListNestedAttribute{
+				Description: "Requests matching a `source` are served from a `destination`, without changing the URL in the browser. Rewrites are evaluated in the order they are listed, after all `redirects`.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							Description: "A regular expression matching the request paths to rewrite, using the same syntax as the `src` of a route in `vercel.json`. Each `source` must be unique.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"destination": schema.StringAttribute{
+							Description: "The path or URL to serve the request from. Capture groups from the `source` can be referenced as `$1`, `$2` etc.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *projectRoutingRulesResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("redirects"),
+			path.MatchRoot("rewrites"),
+		),
+	}
+}
+
+// ProjectRoutingRules mirrors the Terraform state for the resource.
+type ProjectRoutingRules struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	TeamID    types.String `tfsdk:"team_id"`
+	Redirects types.List   `tfsdk:"redirects"`
+	Rewrites  types.List   `tfsdk:"rewrites"`
+}
+
+type RoutingRedirect struct {
+	Source      types.String `tfsdk:"source"`
+	Destination types.String `tfsdk:"destination"`
+	StatusCode  types.Int64  `tfsdk:"status_code"`
+}
+
+type RoutingRewrite struct {
+	Source      types.String `tfsdk:"source"`
+	Destination types.String `tfsdk:"destination"`
+}
+
+var routingRedirectElemType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"source":      types.StringType,
+		"destination": types.StringType,
+		"status_code": types.Int64Type,
+	},
+}
+
+var routingRewriteElemType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"source":      types.StringType,
+		"destination": types.StringType,
+	},
+}
+
+func (r *projectRoutingRulesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ProjectRoutingRules
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sources := map[string]bool{}
+	for _, rule := range []struct {
+		name string
+		list types.List
+	}{{"redirects", config.Redirects}, {"rewrites", config.Rewrites}} {
+		if rule.list.IsNull() || rule.list.IsUnknown() {
+			continue
+		}
+		for _, e := range rule.list.Elements() {
+			obj, ok := e.(types.Object)
+			if !ok || obj.IsUnknown() {
+				continue
+			}
+			src, ok := obj.Attributes()["source"].(types.String)
+			if !ok || src.IsUnknown() || src.IsNull() {
+				continue
+			}
+			if sources[src.ValueString()] {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(rule.name),
+					"Duplicate routing rule source",
+					fmt.Sprintf("The source %q is used by more than one redirect or rewrite. Each source must be unique.", src.ValueString()),
+				)
+			}
+			sources[src.ValueString()] = true
+		}
+	}
+}
+
+func isRedirectRoute(route client.ProjectRoute) bool {
+	_, hasLocation := route.Route.Headers["Location"]
+	return route.Route.Status >= 300 && route.Route.Status < 400 && hasLocation
+}
+
+func isRewriteRoute(route client.ProjectRoute) bool {
+	return route.Route.Dest != "" && route.Route.Status == 0 && !route.Route.Continue
+}
+
+func redirectToProjectRoute(projectID, teamID string, r RoutingRedirect) client.ProjectRoute {
+	return client.ProjectRoute{
+		ProjectID: projectID,
+		TeamID:    teamID,
+		Name:      "Redirect " + r.Source.ValueString(),
+		Enabled:   true,
+		Route: client.ProjectRouteDefinition{
+			Src:     r.Source.ValueString(),
+			Status:  r.StatusCode.ValueInt64(),
+			Headers: map[string]string{"Location": r.Destination.ValueString()},
+		},
+	}
+}
+
+func rewriteToProjectRoute(projectID, teamID string, r RoutingRewrite) client.ProjectRoute {
+	return client.ProjectRoute{
+		ProjectID: projectID,
+		TeamID:    teamID,
+		Name:      "Rewrite " + r.Source.ValueString(),
+		Enabled:   true,
+		Route: client.ProjectRouteDefinition{
+			Src:  r.Source.ValueString(),
+			Dest: r.Destination.ValueString(),
+		},
+	}
+}
+
+// toProjectRoutes returns the routes the plan requires, in the order they should be evaluated.
+func (p ProjectRoutingRules) toProjectRoutes(ctx context.Context) ([]client.ProjectRoute, diag.Diagnostics) {
+	var redirects []RoutingRedirect
+	var rewrites []RoutingRewrite
+	var diags diag.Diagnostics
+	diags.Append(p.Redirects.ElementsAs(ctx, &redirects, true)...)
+	diags.Append(p.Rewrites.ElementsAs(ctx, &rewrites, true)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var routes []client.ProjectRoute
+	for _, rd := range redirects {
+		routes = append(routes, redirectToProjectRoute(p.ProjectID.ValueString(), p.TeamID.ValueString(), rd))
+	}
+	for _, rw := range rewrites {
+		routes = append(routes, rewriteToProjectRoute(p.ProjectID.ValueString(), p.TeamID.ValueString(), rw))
+	}
+	return routes, nil
+}
+
+// managedRoutes returns the redirect and rewrite routes of a project, keyed by source.
+func managedRoutes(routes []client.ProjectRoute) map[string]client.ProjectRoute {
+	managed := map[string]client.ProjectRoute{}
+	for _, route := range routes {
+		if isRedirectRoute(route) || isRewriteRoute(route) {
+			managed[route.Route.Src] = route
+		}
+	}
+	return managed
+}
+
+func sameRoute(a, b client.ProjectRoute) bool {
+	return a.Enabled == b.Enabled &&
+		a.Route.Status == b.Route.Status &&
+		a.Route.Dest == b.Route.Dest &&
+		a.Route.Headers["Location"] == b.Route.Headers["Location"]
+}
+
+func convertResponseToProjectRoutingRules(projectID, teamID string, routes []client.ProjectRoute, plan ProjectRoutingRules) ProjectRoutingRules {
+	redirects := []attr.Value{}
+	rewrites := []attr.Value{}
+	for _, route := range routes {
+		switch {
+		case isRedirectRoute(route):
+			redirects = append(redirects, types.ObjectValueMust(routingRedirectElemType.AttrTypes, map[string]attr.Value{
+				"source":      types.StringValue(route.Route.Src),
+				"destination": types.StringValue(route.Route.Headers["Location"]),
+				"status_code": types.Int64Value(route.Route.Status),
+			}))
+		case isRewriteRoute(route):
+			rewrites = append(rewrites, types.ObjectValueMust(routingRewriteElemType.AttrTypes, map[string]attr.Value{
+				"source":      types.StringValue(route.Route.Src),
+				"destination": types.StringValue(route.Route.Dest),
+			}))
+		}
+	}
+
+	result := ProjectRoutingRules{
+		ID:        types.StringValue(projectID),
+		ProjectID: types.StringValue(projectID),
+		TeamID:    toTeamID(teamID),
+		Redirects: types.ListValueMust(routingRedirectElemType, redirects),
+		Rewrites:  types.ListValueMust(routingRewriteElemType, rewrites),
+	}
+	if len(redirects) == 0 && plan.Redirects.IsNull() {
+		result.Redirects = types.ListNull(routingRedirectElemType)
+	}
+	if len(rewrites) == 0 && plan.Rewrites.IsNull() {
+		result.Rewrites = types.ListNull(routingRewriteElemType)
+	}
+	return result
+}
+
+// applyRoutingRules creates, updates and deletes project routes so that the redirects and rewrites of the
+// project match the plan, in the planned order. Routes that are not redirects or rewrites are left untouched.
+//
+// Vercel cannot move a route, and new routes are always added after the existing ones. So existing routes are
+// only kept, and updated in place, while they are in the planned order. From the first route that is out of
+// order, the remaining routes are created again in order before the old ones are deleted, so that requests
+// are matched by one of them throughout.
+func (r *projectRoutingRulesResource) applyRoutingRules(ctx context.Context, plan ProjectRoutingRules, existing []client.ProjectRoute) diag.Diagnostics {
+	desired, diags := plan.toProjectRoutes(ctx)
+	if diags.HasError() {
+		return diags
+	}
+	wanted := map[string]bool{}
+	for _, route := range desired {
+		wanted[route.Route.Src] = isRedirectRoute(route)
+	}
+
+	var current []client.ProjectRoute
+	for _, route := range existing {
+		if !isRedirectRoute(route) && !isRewriteRoute(route) {
+			continue
+		}
+		if isRedirect, ok := wanted[route.Route.Src]; ok && isRedirect == isRedirectRoute(route) {
+			current = append(current, route)
+			continue
+		}
+		diags.Append(r.deleteRoutingRule(ctx, plan, route)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	kept := 0
+	for kept < len(current) && kept < len(desired) && current[kept].Route.Src == desired[kept].Route.Src {
+		kept++
+	}
+
+	for i, route := range desired[:kept] {
+		if sameRoute(current[i], route) {
+			continue
+		}
+		route.ID = current[i].ID
+		if _, err := r.client.UpdateProjectRoute(ctx, route); err != nil {
+			diags.AddError(
+				"Error updating routing rule",
+				fmt.Sprintf("Could not update routing rule %s for project %s, unexpected error: %s", route.Route.Src, plan.ProjectID.ValueString(), err),
+			)
+			return diags
+		}
+	}
+	for _, route := range desired[kept:] {
+		if _, err := r.client.CreateProjectRoute(ctx, route); err != nil {
+			diags.AddError(
+				"Error creating routing rule",
+				fmt.Sprintf("Could not create routing rule %s for project %s, unexpected error: %s", route.Route.Src, plan.ProjectID.ValueString(), err),
+			)
+			return diags
+		}
+	}
+	for _, route := range current[kept:] {
+		diags.Append(r.deleteRoutingRule(ctx, plan, route)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	return diags
+}
+
+func (r *projectRoutingRulesResource) deleteRoutingRule(ctx context.Context, plan ProjectRoutingRules, route client.ProjectRoute) (diags diag.Diagnostics) {
+	err := r.client.DeleteProjectRoute(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString(), route.ID)
+	if err != nil && !client.NotFound(err) {
+		diags.AddError(
+			"Error deleting routing rule",
+			fmt.Sprintf("Could not delete routing rule %s for project %s, unexpected error: %s", route.Route.Src, plan.ProjectID.ValueString(), err),
+		)
+	}
+	return diags
+}
+
+func (r *projectRoutingRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectRoutingRules
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := r.client.ListProjectRoutes(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString())
+	if client.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error creating project routing rules",
+			"Could not find project, please make sure both the project_id and team_id match the project and team you wish to configure.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating project routing rules",
+			"Could not read existing project routes, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = r.applyRoutingRules(ctx, plan, existing)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routes, err := r.client.ListProjectRoutes(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating project routing rules",
+			"Could not read project routes, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	result := convertResponseToProjectRoutingRules(plan.ProjectID.ValueString(), r.client.TeamID(plan.TeamID.ValueString()), routes, plan)
+	tflog.Info(ctx, "created project routing rules", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectRoutingRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectRoutingRules
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routes, err := r.client.ListProjectRoutes(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading project routing rules",
+			fmt.Sprintf("Could not get project routing rules %s %s, unexpected error: %s", state.TeamID.ValueString(), state.ProjectID.ValueString(), err),
+		)
+		return
+	}
+
+	result := convertResponseToProjectRoutingRules(state.ProjectID.ValueString(), r.client.TeamID(state.TeamID.ValueString()), routes, state)
+	tflog.Info(ctx, "read project routing rules", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectRoutingRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ProjectRoutingRules
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := r.client.ListProjectRoutes(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating project routing rules",
+			fmt.Sprintf("Could not get project routing rules %s %s, unexpected error: %s", plan.TeamID.ValueString(), plan.ProjectID.ValueString(), err),
+		)
+		return
+	}
+
+	diags = r.applyRoutingRules(ctx, plan, existing)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routes, err := r.client.ListProjectRoutes(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating project routing rules",
+			fmt.Sprintf("Could not get project routing rules %s %s, unexpected error: %s", plan.TeamID.ValueString(), plan.ProjectID.ValueString(), err),
+		)
+		return
+	}
+
+	result := convertResponseToProjectRoutingRules(plan.ProjectID.ValueString(), r.client.TeamID(plan.TeamID.ValueString()), routes, plan)
+	tflog.Trace(ctx, "updated project routing rules", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectRoutingRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProjectRoutingRules
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routes, err := r.client.ListProjectRoutes(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting project routing rules",
+			fmt.Sprintf("Could not get project routing rules %s %s, unexpected error: %s", state.TeamID.ValueString(), state.ProjectID.ValueString(), err),
+		)
+		return
+	}
+
+	for src, route := range managedRoutes(routes) {
+		err := r.client.DeleteProjectRoute(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString(), route.ID)
+		if err != nil && !client.NotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error deleting project routing rules",
+				fmt.Sprintf("Could not delete routing rule %s for project %s %s, unexpected error: %s", src, state.TeamID.ValueString(), state.ProjectID.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "deleted project routing rules", map[string]any{
+		"team_id":    state.TeamID.ValueString(),
+		"project_id": state.ProjectID.ValueString(),
+	})
+}
+
+func (r *projectRoutingRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, projectID, ok := splitInto1Or2(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing project routing rules",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/project_id\" or \"project_id\"", req.ID),
+		)
+		return
+	}
+
+	routes, err := r.client.ListProjectRoutes(ctx, projectID, teamID)
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading project routing rules",
+			fmt.Sprintf("Could not get project routing rules %s %s, unexpected error: %s", teamID, projectID, err),
+		)
+		return
+	}
+
+	result := convertResponseToProjectRoutingRules(projectID, r.client.TeamID(teamID), routes, ProjectRoutingRules{
+		Redirects: types.ListNull(routingRedirectElemType),
+		Rewrites:  types.ListNull(routingRewriteElemType),
+	})
+	tflog.Info(ctx, "imported project routing rules", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+	})
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
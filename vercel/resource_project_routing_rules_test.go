@@ -0,0 +1,146 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// testAccProjectRoutingRulesOrder checks the redirects and rewrites of a project are evaluated in the given order.
+func testAccProjectRoutingRulesOrder(testClient *client.Client, n, teamID string, sources ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		routes, err := testClient.ListProjectRoutes(context.TODO(), rs.Primary.Attributes["project_id"], teamID)
+		if err != nil {
+			return err
+		}
+		var got []string
+		for _, r := range routes {
+			if !r.Route.Continue && (r.Route.Dest != "" || r.Route.Headers["Location"] != "") {
+				got = append(got, r.Route.Src)
+			}
+		}
+		if !slices.Equal(got, sources) {
+			return fmt.Errorf("expected routing rules in the order %v, got %v", sources, got)
+		}
+		return nil
+	}
+}
+
+func TestAcc_ProjectRoutingRules(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.example", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccProjectRoutingRulesConfig(nameSuffix, `
+  redirects = [
+    {
+      source      = "/old/(.*)"
+      destination = "/new/$1"
+    },
+    {
+      source      = "/sale"
+      destination = "https://example.com/sale"
+      status_code = 302
+    },
+  ]
+`)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("vercel_project_routing_rules.example", "id", "vercel_project.example", "id"),
+					resource.TestCheckResourceAttr("vercel_project_routing_rules.example", "redirects.#", "2"),
+					resource.TestCheckResourceAttr("vercel_project_routing_rules.example", "redirects.0.source", "/old/(.*)"),
+					resource.TestCheckResourceAttr("vercel_project_routing_rules.example", "redirects.0.destination", "/new/$1"),
+					resource.TestCheckResourceAttr("vercel_project_routing_rules.example", "redirects.0.status_code", "308"),
+					resource.TestCheckResourceAttr("vercel_project_routing_rules.example", "redirects.1.source", "/sale"),
+					resource.TestCheckResourceAttr("vercel_project_routing_rules.example", "redirects.1.destination", "https://example.com/sale"),
+					resource.TestCheckResourceAttr("vercel_project_routing_rules.example", "redirects.1.status_code", "302"),
+					resource.TestCheckNoResourceAttr("vercel_project_routing_rules.example", "rewrites"),
+				),
+			},
+			{
+				Config: cfg(testAccProjectRoutingRulesConfig(nameSuffix, `
+  redirects = [
+    {
+      source      = "/old/(.*)"
+      destination = "/newer/$1"
+    },
+  ]
+  rewrites = [
+    {
+      source      = "/docs/(.*)"
+      destination = "https://docs.example.com/$1"
+    },
+  ]
+`)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_project_routing_rules.example", "redirects.#", "1"),
+					resource.TestCheckResourceAttr("vercel_project_routing_rules.example", "redirects.0.source", "/old/(.*)"),
+					resource.TestCheckResourceAttr("vercel_project_routing_rules.example", "redirects.0.destination", "/newer/$1"),
+					resource.TestCheckResourceAttr("vercel_project_routing_rules.example", "rewrites.#", "1"),
+					resource.TestCheckResourceAttr("vercel_project_routing_rules.example", "rewrites.0.source", "/docs/(.*)"),
+					resource.TestCheckResourceAttr("vercel_project_routing_rules.example", "rewrites.0.destination", "https://docs.example.com/$1"),
+					testAccProjectRoutingRulesOrder(testClient(t), "vercel_project_routing_rules.example", testTeam(t), "/old/(.*)", "/docs/(.*)"),
+				),
+			},
+			{
+				// A more specific redirect inserted before an overlapping one is evaluated first.
+				Config: cfg(testAccProjectRoutingRulesConfig(nameSuffix, `
+  redirects = [
+    {
+      source      = "/old/special"
+      destination = "/special"
+    },
+    {
+      source      = "/old/(.*)"
+      destination = "/newer/$1"
+    },
+  ]
+  rewrites = [
+    {
+      source      = "/docs/(.*)"
+      destination = "https://docs.example.com/$1"
+    },
+  ]
+`)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_project_routing_rules.example", "redirects.#", "2"),
+					resource.TestCheckResourceAttr("vercel_project_routing_rules.example", "redirects.0.source", "/old/special"),
+					resource.TestCheckResourceAttr("vercel_project_routing_rules.example", "redirects.1.source", "/old/(.*)"),
+					testAccProjectRoutingRulesOrder(testClient(t), "vercel_project_routing_rules.example", testTeam(t), "/old/special", "/old/(.*)", "/docs/(.*)"),
+				),
+			},
+			{
+				ResourceName:      "vercel_project_routing_rules.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getProjectImportID("vercel_project.example"),
+			},
+		},
+	})
+}
+
+func testAccProjectRoutingRulesConfig(projectName, rules string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "example" {
+  name = "test-acc-routing-rules-%[1]s"
+}
+
+resource "vercel_project_routing_rules" "example" {
+  project_id = vercel_project.example.id
+%[2]s
+}
+`, projectName, rules)
+}
@@ -0,0 +1,265 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+var (
+	_ resource.Resource              = &projectShareableLinkResource{}
+	_ resource.ResourceWithConfigure = &projectShareableLinkResource{}
+)
+
+func newProjectShareableLinkResource() resource.Resource {
+	return &projectShareableLinkResource{}
+}
+
+type projectShareableLinkResource struct {
+	client *client.Client
+}
+
+func (r *projectShareableLinkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_shareable_link"
+}
+
+func (r *projectShareableLinkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema returns the schema information for a project shareable link resource.
+func (r *projectShareableLinkResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a Project Shareable Link resource.
+
+A Shareable Link allows anyone with the link to view the protected deployments of a Vercel Project, without needing to log in. The link is generated when the resource is created, and revoked when it is destroyed.
+
+For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/security/deployment-protection/methods-to-bypass-deployment-protection/sharable-links).
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description:   "The ID of the Vercel project.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the Vercel team. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"note": schema.StringAttribute{
+				Description:   "A note describing what the Shareable Link is used for.",
+				Optional:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"deployment_url": schema.StringAttribute{
+				Description:   "The URL of a deployment to build the `url` for, such as the `url` of a `vercel_deployment`. Changing this generates a new Shareable Link and revokes the previous one.",
+				Optional:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"secret": schema.StringAttribute{
+				Description:   "The secret of the Shareable Link. Protected deployments can be viewed by passing it as the `_vercel_share` query parameter.",
+				Computed:      true,
+				Sensitive:     true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"url": schema.StringAttribute{
+				Description:   "The Shareable Link for `deployment_url`. Only set if `deployment_url` is set.",
+				Computed:      true,
+				Sensitive:     true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+type ProjectShareableLink struct {
+	ProjectID     types.String `tfsdk:"project_id"`
+	TeamID        types.String `tfsdk:"team_id"`
+	Note          types.String `tfsdk:"note"`
+	DeploymentURL types.String `tfsdk:"deployment_url"`
+	Secret        types.String `tfsdk:"secret"`
+	URL           types.String `tfsdk:"url"`
+}
+
+// shareableLinkURL builds the link used to view a protected deployment, adding the `_vercel_share` query parameter.
+func shareableLinkURL(deploymentURL, secret string) (string, error) {
+	if !strings.Contains(deploymentURL, "://") {
+		// Deployment URLs returned by the Vercel API do not include a scheme.
+		deploymentURL = "https://" + deploymentURL
+	}
+	u, err := url.Parse(deploymentURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid deployment_url %q: %w", deploymentURL, err)
+	}
+	q := u.Query()
+	q.Set("_vercel_share", secret)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+func (r *projectShareableLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectShareableLink
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := r.client.CreateShareableLink(ctx, client.CreateShareableLinkRequest{
+		TeamID:    plan.TeamID.ValueString(),
+		ProjectID: plan.ProjectID.ValueString(),
+		Note:      plan.Note.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Project Shareable Link",
+			"Could not create Project Shareable Link, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	result := ProjectShareableLink{
+		ProjectID:     plan.ProjectID,
+		TeamID:        types.StringValue(r.client.TeamID(plan.TeamID.ValueString())),
+		Note:          plan.Note,
+		DeploymentURL: plan.DeploymentURL,
+		Secret:        types.StringValue(secret),
+		URL:           types.StringNull(),
+	}
+	if !plan.DeploymentURL.IsNull() {
+		link, err := shareableLinkURL(plan.DeploymentURL.ValueString(), secret)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating Project Shareable Link",
+				"Could not create Project Shareable Link, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		result.URL = types.StringValue(link)
+	}
+
+	tflog.Info(ctx, "created project shareable link", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *projectShareableLinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectShareableLink
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, err := r.client.GetProject(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Project Shareable Link",
+			fmt.Sprintf("Could not get Project %s %s, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	if bypass, ok := project.ProtectionBypass[state.Secret.ValueString()]; !ok || bypass.Scope != client.ShareableLinkScope {
+		tflog.Info(ctx, "project shareable link has been revoked", map[string]any{
+			"team_id":    state.TeamID.ValueString(),
+			"project_id": state.ProjectID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Info(ctx, "read project shareable link", map[string]any{
+		"team_id":    state.TeamID.ValueString(),
+		"project_id": state.ProjectID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *projectShareableLinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Updating a Project Shareable Link is not supported",
+		"Updating a Project Shareable Link is not supported, it should always be recreated instead.",
+	)
+}
+
+func (r *projectShareableLinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProjectShareableLink
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteShareableLink(ctx, client.DeleteShareableLinkRequest{
+		TeamID:    state.TeamID.ValueString(),
+		ProjectID: state.ProjectID.ValueString(),
+		Secret:    state.Secret.ValueString(),
+	})
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error revoking Project Shareable Link",
+			fmt.Sprintf(
+				"Could not revoke Shareable Link for Project %s %s, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "revoked project shareable link", map[string]any{
+		"team_id":    state.TeamID.ValueString(),
+		"project_id": state.ProjectID.ValueString(),
+	})
+}
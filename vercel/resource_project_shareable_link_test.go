@@ -0,0 +1,98 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+func testAccProjectShareableLinkExists(testClient *client.Client, n, teamID string, secret *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		project, err := testClient.GetProject(context.TODO(), rs.Primary.Attributes["project_id"], teamID)
+		if err != nil {
+			return err
+		}
+		*secret = rs.Primary.Attributes["secret"]
+		if bypass, ok := project.ProtectionBypass[*secret]; !ok || bypass.Scope != client.ShareableLinkScope {
+			return fmt.Errorf("expected shareable link to exist on project %s", rs.Primary.Attributes["project_id"])
+		}
+		return nil
+	}
+}
+
+func testAccProjectShareableLinkRevoked(testClient *client.Client, projectName, teamID string, secret *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[projectName]
+		if !ok {
+			return fmt.Errorf("not found: %s", projectName)
+		}
+
+		project, err := testClient.GetProject(context.TODO(), rs.Primary.ID, teamID)
+		if err != nil {
+			return err
+		}
+		if _, ok := project.ProtectionBypass[*secret]; ok {
+			return fmt.Errorf("expected shareable link to be revoked on project %s", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func TestAcc_ProjectShareableLink(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	var secret string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.example", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccProjectShareableLinkConfig(nameSuffix)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectShareableLinkExists(testClient(t), "vercel_project_shareable_link.example", testTeam(t), &secret),
+					resource.TestCheckResourceAttr("vercel_project_shareable_link.example", "note", "QA"),
+					resource.TestCheckResourceAttrSet("vercel_project_shareable_link.example", "secret"),
+					resource.TestMatchResourceAttr("vercel_project_shareable_link.example", "url", regexp.MustCompile(`^https://example-`+nameSuffix+`\.vercel\.app\?_vercel_share=.+$`)),
+				),
+			},
+			{
+				Config: cfg(testAccProjectShareableLinkConfigRemoved(nameSuffix)),
+				Check:  testAccProjectShareableLinkRevoked(testClient(t), "vercel_project.example", testTeam(t), &secret),
+			},
+		},
+	})
+}
+
+func testAccProjectShareableLinkConfig(nameSuffix string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "example" {
+  name = "test-acc-shareable-link-%[1]s"
+}
+
+resource "vercel_project_shareable_link" "example" {
+  project_id     = vercel_project.example.id
+  note           = "QA"
+  deployment_url = "example-%[1]s.vercel.app"
+}
+`, nameSuffix)
+}
+
+func testAccProjectShareableLinkConfigRemoved(nameSuffix string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "example" {
+  name = "test-acc-shareable-link-%[1]s"
+}
+`, nameSuffix)
+}
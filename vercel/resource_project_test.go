@@ -43,6 +43,18 @@ func TestAcc_Project(t *testing.T) {
 				                `,
 				ExpectError: regexp.MustCompile("Invalid Framework"),
 			},
+			{
+				Config: `
+				                    resource "vercel_project" "test" {
+				                        name = "foo"
+				                        serverless_function_region = "iad1"
+				                        resource_config = {
+				                            function_default_regions = ["iad1", "sfo1"]
+				                        }
+				                    }
+				                `,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
 			// Create and Read testing
 			{
 				Config: cfg(testAccProjectConfig(projectSuffix)),
@@ -67,6 +79,8 @@ func TestAcc_Project(t *testing.T) {
 					resource.TestCheckTypeSetElemAttr("vercel_project.test", "environment.0.target.*", "production"),
 					resource.TestCheckResourceAttr("vercel_project.test", "git_comments.on_pull_request", "true"),
 					resource.TestCheckResourceAttr("vercel_project.test", "git_comments.on_commit", "true"),
+					resource.TestCheckResourceAttr("vercel_project.test", "git_provider_options.create_deployments", "false"),
+					resource.TestCheckNoResourceAttr("vercel_project.test", "latest_production_deployment.id"),
 					resource.TestCheckResourceAttr("vercel_project.test", "preview_comments", "true"),
 					resource.TestCheckResourceAttr("vercel_project.test", "enable_preview_feedback", "true"),
 					resource.TestCheckResourceAttr("vercel_project.test", "enable_production_feedback", "false"),
@@ -76,7 +90,9 @@ func TestAcc_Project(t *testing.T) {
 					resource.TestCheckResourceAttr("vercel_project.test", "customer_success_code_visibility", "true"),
 					resource.TestCheckResourceAttr("vercel_project.test", "git_fork_protection", "true"),
 					resource.TestCheckResourceAttr("vercel_project.test", "prioritise_production_builds", "true"),
+					resource.TestCheckResourceAttr("vercel_project.test", "cancel_outdated_builds", "false"),
 					resource.TestCheckResourceAttr("vercel_project.test", "directory_listing", "true"),
+					resource.TestCheckResourceAttr("vercel_project.test", "protected_sourcemaps", "true"),
 					resource.TestCheckResourceAttr("vercel_project.test", "skew_protection", "7 days"),
 					resource.TestCheckResourceAttr("vercel_project.test", "oidc_token_config.enabled", "true"),
 					resource.TestCheckResourceAttr("vercel_project.test", "oidc_token_config.issuer_mode", "team"),
@@ -166,6 +182,7 @@ func TestAcc_ProjectFluidCompute(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("vercel_project.test", "name", fmt.Sprintf("test-acc-fluid-%s", projectSuffix)),
 					resource.TestCheckResourceAttr("vercel_project.test", "resource_config.fluid", "true"),
+					resource.TestCheckResourceAttrSet("vercel_project.test", "resource_config.function_default_regions.#"),
 				),
 			},
 			{
@@ -537,6 +554,154 @@ func TestAcc_ProjectEnablingAffectedProjectDeployments(t *testing.T) {
 	})
 }
 
+func TestAcc_ProjectDeletionProtection(t *testing.T) {
+	projectSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccProjectConfigWithDeletionProtection(projectSuffix, true)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectExists(testClient(t), "vercel_project.test", testTeam(t)),
+					resource.TestCheckResourceAttr("vercel_project.test", "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      cfg(testAccProjectConfigWithDeletionProtection(projectSuffix, true)),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("deletion_protection"),
+			},
+			{
+				Config: cfg(testAccProjectConfigWithDeletionProtection(projectSuffix, false)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_project.test", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectRelatedProjects(t *testing.T) {
+	projectSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		Steps: []resource.TestStep{
+			{
+				Config:      cfg(testAccProjectConfigWithRelatedProjects(projectSuffix, `["prj_doesnotexist"]`)),
+				ExpectError: regexp.MustCompile(`The project "prj_doesnotexist" does not exist`),
+			},
+			{
+				Config: cfg(testAccProjectConfigWithRelatedProjects(projectSuffix, "[vercel_project.related.id]")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_project.test", "related_projects.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("vercel_project.test", "related_projects.*", "vercel_project.related", "id"),
+				),
+			},
+			{
+				Config: cfg(testAccProjectConfigWithRelatedProjects(projectSuffix, "[]")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_project.test", "related_projects.#", "0"),
+				),
+			},
+			{
+				Config: cfg(testAccProjectConfigWithRelatedProjects(projectSuffix, "null")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("vercel_project.test", "related_projects"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectConfigWithRelatedProjects(projectSuffix, relatedProjects string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "related" {
+  name = "test-acc-related-%[1]s"
+}
+
+resource "vercel_project" "test" {
+  name             = "test-acc-project-%[1]s"
+  related_projects = %[2]s
+}
+`, projectSuffix, relatedProjects)
+}
+
+func TestAcc_ProjectRollingRelease(t *testing.T) {
+	projectSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccProjectConfigWithRollingRelease(projectSuffix, `
+    advancement_type = "manual-approval"
+    stages = [
+      { target_percentage = 50, duration = 10 },
+    ]
+`)),
+				ExpectError: regexp.MustCompile("`duration` can only be set when `advancement_type` is `automatic`"),
+			},
+			{
+				Config: cfg(testAccProjectConfigWithRollingRelease(projectSuffix, `
+    advancement_type = "automatic"
+    stages = [
+      { target_percentage = 50, duration = 10 },
+      { target_percentage = 10, duration = 10 },
+    ]
+`)),
+				ExpectError: regexp.MustCompile("Each stage must target a higher percentage of traffic"),
+			},
+			{
+				Config: cfg(testAccProjectConfigWithRollingRelease(projectSuffix, `
+    advancement_type = "automatic"
+    stages = [
+      { target_percentage = 10, duration = 5 },
+      { target_percentage = 50, duration = 10 },
+    ]
+`)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectExists(testClient(t), "vercel_project.test", testTeam(t)),
+					resource.TestCheckResourceAttr("vercel_project.test", "rolling_release.advancement_type", "automatic"),
+					resource.TestCheckResourceAttr("vercel_project.test", "rolling_release.stages.#", "2"),
+					resource.TestCheckResourceAttr("vercel_project.test", "rolling_release.stages.0.target_percentage", "10"),
+					resource.TestCheckResourceAttr("vercel_project.test", "rolling_release.stages.0.duration", "5"),
+					resource.TestCheckResourceAttr("vercel_project.test", "rolling_release.stages.1.target_percentage", "50"),
+				),
+			},
+			{
+				Config: cfg(testAccProjectConfigWithRollingRelease(projectSuffix, `
+    advancement_type = "manual-approval"
+    stages = [
+      { target_percentage = 25 },
+    ]
+`)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_project.test", "rolling_release.advancement_type", "manual-approval"),
+					resource.TestCheckResourceAttr("vercel_project.test", "rolling_release.stages.#", "1"),
+					resource.TestCheckNoResourceAttr("vercel_project.test", "rolling_release.stages.0.duration"),
+				),
+			},
+			{
+				Config: cfg(testAccProjectConfigBase(projectSuffix)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("vercel_project.test", "rolling_release"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectConfigWithRollingRelease(projectSuffix, rollingRelease string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-two-%[1]s"
+  rolling_release = {%[2]s  }
+}
+`, projectSuffix, rollingRelease)
+}
+
 func testAccProjectExists(testClient *client.Client, n, teamID string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -584,6 +749,15 @@ resource "vercel_project" "test" {
 `, projectSuffix)
 }
 
+func testAccProjectConfigWithDeletionProtection(projectSuffix string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name                = "test-acc-deletion-protection-%s"
+  deletion_protection = %t
+}
+`, projectSuffix, enabled)
+}
+
 func testAccProjectConfigWithResourceConfig(projectSuffix string) string {
 	return fmt.Sprintf(`
 resource "vercel_project" "test" {
@@ -1011,6 +1185,9 @@ resource "vercel_project" "test" {
       on_pull_request = true,
       on_commit = true
   }
+  git_provider_options = {
+      create_deployments = false
+  }
   enable_preview_feedback = true
   enable_production_feedback = false
   auto_assign_custom_domains = true
@@ -1019,7 +1196,9 @@ resource "vercel_project" "test" {
   customer_success_code_visibility = true
   git_fork_protection = true
   prioritise_production_builds = true
+  cancel_outdated_builds = false
   directory_listing = true
+  protected_sourcemaps = true
   skew_protection = "7 days"
   oidc_token_config = {
     enabled = true
@@ -123,7 +123,10 @@ For more detailed information, please see the [Vercel documentation](https://ver
 			"key": schema.StringAttribute{
 				Required:      true,
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
-				Description:   "The name of the Environment Variable.",
+				Description:   "The name of the Environment Variable. Names can only contain letters, digits and underscores, and names that Vercel reserves, such as `TZ`, cannot be used.",
+				Validators: []validator.String{
+					validateEnvVariableKey(),
+				},
 			},
 			"value": schema.StringAttribute{
 				Required:    true,
@@ -131,8 +134,8 @@ For more detailed information, please see the [Vercel documentation](https://ver
 				Sensitive:   true,
 			},
 			"project_ids": schema.SetAttribute{
-				Required:    true,
-				Description: "The ID of the Vercel project.",
+				Optional:    true,
+				Description: "The IDs of the Vercel projects that the Environment Variable is linked to. If omitted, the linked projects are not managed by this resource, so that they can be managed separately with `vercel_shared_environment_variable_project_link` resources. Removing this attribute leaves the existing links in place.",
 				ElementType: types.StringType,
 			},
 			"team_id": schema.StringAttribute{
@@ -256,6 +259,15 @@ func (e *SharedEnvironmentVariable) toUpdateSharedEnvironmentVariableRequest(ctx
 	}, true
 }
 
+// withUnmanagedProjectIDs keeps project_ids null when it is not configured, as the linked projects are then managed
+// separately, by vercel_shared_environment_variable_project_link resources.
+func (e SharedEnvironmentVariable) withUnmanagedProjectIDs(prior types.Set) SharedEnvironmentVariable {
+	if prior.IsNull() {
+		e.ProjectIDs = types.SetNull(types.StringType)
+	}
+	return e
+}
+
 // convertResponseToSharedEnvironmentVariable is used to populate terraform state based on an API response.
 // Where possible, values from the API response are used to populate state. If not possible,
 // values from plan are used.
@@ -311,7 +323,7 @@ func (r *sharedEnvironmentVariableResource) Create(ctx context.Context, req reso
 		return
 	}
 
-	result := convertResponseToSharedEnvironmentVariable(response, plan.Value)
+	result := convertResponseToSharedEnvironmentVariable(response, plan.Value).withUnmanagedProjectIDs(plan.ProjectIDs)
 
 	tflog.Info(ctx, "created shared environment variable", map[string]any{
 		"id":      result.ID.ValueString(),
@@ -352,7 +364,7 @@ func (r *sharedEnvironmentVariableResource) Read(ctx context.Context, req resour
 		return
 	}
 
-	result := convertResponseToSharedEnvironmentVariable(out, state.Value)
+	result := convertResponseToSharedEnvironmentVariable(out, state.Value).withUnmanagedProjectIDs(state.ProjectIDs)
 	tflog.Info(ctx, "read shared environment variable", map[string]any{
 		"id":      result.ID.ValueString(),
 		"team_id": result.TeamID.ValueString(),
@@ -386,7 +398,7 @@ func (r *sharedEnvironmentVariableResource) Update(ctx context.Context, req reso
 		return
 	}
 
-	result := convertResponseToSharedEnvironmentVariable(response, plan.Value)
+	result := convertResponseToSharedEnvironmentVariable(response, plan.Value).withUnmanagedProjectIDs(plan.ProjectIDs)
 
 	tflog.Info(ctx, "updated shared environment variable", map[string]any{
 		"id":      result.ID.ValueString(),
@@ -0,0 +1,408 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+var (
+	_ resource.Resource              = &sharedEnvironmentVariableMigrationResource{}
+	_ resource.ResourceWithConfigure = &sharedEnvironmentVariableMigrationResource{}
+)
+
+func newSharedEnvironmentVariableMigrationResource() resource.Resource {
+	return &sharedEnvironmentVariableMigrationResource{}
+}
+
+type sharedEnvironmentVariableMigrationResource struct {
+	client *client.Client
+}
+
+func (r *sharedEnvironmentVariableMigrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_shared_environment_variable_migration"
+}
+
+func (r *sharedEnvironmentVariableMigrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *sharedEnvironmentVariableMigrationResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Moves Environment Variables from a set of Projects to Shared Environment Variables.
+
+When this resource is created, every key must be defined exactly once, with the same value and targets, on every one of the Projects. A Shared Environment Variable is then created for each key and linked to all of the Projects, and the Project Environment Variables it replaces are removed. If any step fails, the changes already made are reverted. The Shared Environment Variables that were created and the Project Environment Variables that were removed are recorded in ` + "`shared_environment_variable_ids`" + ` and ` + "`removed`" + `.
+
+The migration runs once. Destroying this resource does not undo it, and the Shared Environment Variables are not managed by it afterwards. To keep managing them with Terraform, import them as ` + "`vercel_shared_environment_variable`" + ` resources.
+
+~> Sensitive Environment Variables cannot be migrated, as their values cannot be read back. Environment Variables that are specific to a Git branch or to a custom environment cannot be migrated either.
+`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the Vercel team. Shared environment variables require a team.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"project_ids": schema.SetAttribute{
+				Required:      true,
+				ElementType:   types.StringType,
+				Description:   "The IDs of the Projects to move the Environment Variables from. The Shared Environment Variables are linked to all of them.",
+				PlanModifiers: []planmodifier.Set{setplanmodifier.RequiresReplace()},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"keys": schema.SetAttribute{
+				Required:      true,
+				ElementType:   types.StringType,
+				Description:   "The keys of the Environment Variables to move.",
+				PlanModifiers: []planmodifier.Set{setplanmodifier.RequiresReplace()},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"shared_environment_variable_ids": schema.MapAttribute{
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "A map of each key to the ID of the Shared Environment Variable created for it.",
+				PlanModifiers: []planmodifier.Map{mapplanmodifier.UseStateForUnknown()},
+			},
+			"removed": schema.ListNestedAttribute{
+				Computed:      true,
+				Description:   "The Project Environment Variables that were removed, ordered by key and Project ID.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"project_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the Project the Environment Variable was removed from.",
+						},
+						"key": schema.StringAttribute{
+							Computed:    true,
+							Description: "The key of the Environment Variable.",
+						},
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID the Environment Variable had.",
+						},
+						"target": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The environments the Environment Variable was present on.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type SharedEnvironmentVariableMigration struct {
+	TeamID                       types.String `tfsdk:"team_id"`
+	ProjectIDs                   types.Set    `tfsdk:"project_ids"`
+	Keys                         types.Set    `tfsdk:"keys"`
+	SharedEnvironmentVariableIDs types.Map    `tfsdk:"shared_environment_variable_ids"`
+	Removed                      types.List   `tfsdk:"removed"`
+}
+
+var removedEnvironmentVariableAttrTypes = map[string]attr.Type{
+	"project_id": types.StringType,
+	"key":        types.StringType,
+	"id":         types.StringType,
+	"target":     types.SetType{ElemType: types.StringType},
+}
+
+// migratingEnvironmentVariable is a Project Environment Variable that is being replaced by a Shared Environment Variable.
+type migratingEnvironmentVariable struct {
+	projectID string
+	env       client.EnvironmentVariable
+}
+
+// planEnvironmentVariableMigration finds the Project Environment Variable for each key on each project, and describes
+// every reason the keys cannot be moved to Shared Environment Variables. Values are never included in the problems.
+func planEnvironmentVariableMigration(keys, projectIDs []string, envsByProject map[string][]client.EnvironmentVariable) (map[string][]migratingEnvironmentVariable, []string) {
+	byKey := map[string][]migratingEnvironmentVariable{}
+	var problems []string
+	for _, key := range keys {
+		for _, projectID := range projectIDs {
+			var found []client.EnvironmentVariable
+			for _, e := range envsByProject[projectID] {
+				if e.Key == key {
+					found = append(found, e)
+				}
+			}
+			switch {
+			case len(found) == 0:
+				problems = append(problems, fmt.Sprintf("%s is not defined on project %s", key, projectID))
+				continue
+			case len(found) > 1:
+				problems = append(problems, fmt.Sprintf("%s is defined %d times on project %s, with different targets or Git branches", key, len(found), projectID))
+				continue
+			}
+
+			e := found[0]
+			switch {
+			case e.Type == "sensitive":
+				problems = append(problems, fmt.Sprintf("%s on project %s is sensitive, so its value cannot be read", key, projectID))
+				continue
+			case e.GitBranch != nil && *e.GitBranch != "":
+				problems = append(problems, fmt.Sprintf("%s on project %s is specific to the Git branch %s", key, projectID, *e.GitBranch))
+				continue
+			case len(e.CustomEnvironmentIDs) > 0:
+				problems = append(problems, fmt.Sprintf("%s on project %s is specific to a custom environment", key, projectID))
+				continue
+			}
+
+			if existing := byKey[key]; len(existing) > 0 {
+				first := existing[0]
+				if first.env.Value != e.Value {
+					problems = append(problems, fmt.Sprintf("%s has a different value on project %s than on project %s", key, projectID, first.projectID))
+					continue
+				}
+				if !sameTargets(first.env.Target, e.Target) {
+					problems = append(problems, fmt.Sprintf("%s has different targets on project %s than on project %s", key, projectID, first.projectID))
+					continue
+				}
+			}
+			byKey[key] = append(byKey[key], migratingEnvironmentVariable{
+				projectID: projectID,
+				env:       e,
+			})
+		}
+	}
+	return byKey, problems
+}
+
+func sameTargets(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, t := range a {
+		if !contains(b, t) {
+			return false
+		}
+	}
+	return true
+}
+
+// Create moves the Environment Variables. All of the Shared Environment Variables are created before any Project
+// Environment Variable is removed, so that Deployments never lose an Environment Variable part way through.
+func (r *sharedEnvironmentVariableMigrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SharedEnvironmentVariableMigration
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var keys, projectIDs []string
+	resp.Diagnostics.Append(plan.Keys.ElementsAs(ctx, &keys, false)...)
+	resp.Diagnostics.Append(plan.ProjectIDs.ElementsAs(ctx, &projectIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	sort.Strings(keys)
+	sort.Strings(projectIDs)
+	teamID := plan.TeamID.ValueString()
+
+	envsByProject := map[string][]client.EnvironmentVariable{}
+	for _, projectID := range projectIDs {
+		envs, err := r.client.GetEnvironmentVariables(ctx, projectID, teamID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error migrating environment variables",
+				fmt.Sprintf("Could not read environment variables for project %s, unexpected error: %s", projectID, err),
+			)
+			return
+		}
+		envsByProject[projectID] = envs
+	}
+
+	byKey, problems := planEnvironmentVariableMigration(keys, projectIDs, envsByProject)
+	if len(problems) > 0 {
+		resp.Diagnostics.AddError(
+			"Error migrating environment variables",
+			"The environment variables cannot be moved to shared environment variables, and nothing has been changed:\n  - "+strings.Join(problems, "\n  - "),
+		)
+		return
+	}
+
+	created := map[string]string{}
+	var removed []migratingEnvironmentVariable
+	rollback := func() []string {
+		var errs []string
+		for _, m := range removed {
+			_, err := r.client.CreateEnvironmentVariable(ctx, client.CreateEnvironmentVariableRequest{
+				EnvironmentVariable: client.EnvironmentVariableRequest{
+					Key:     m.env.Key,
+					Value:   m.env.Value,
+					Target:  m.env.Target,
+					Type:    m.env.Type,
+					Comment: m.env.Comment,
+				},
+				ProjectID: m.projectID,
+				TeamID:    teamID,
+			})
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("could not restore %s on project %s: %s", m.env.Key, m.projectID, err))
+			}
+		}
+		for _, key := range sortedKeys(created) {
+			if err := r.client.DeleteSharedEnvironmentVariable(ctx, teamID, created[key]); err != nil {
+				errs = append(errs, fmt.Sprintf("could not delete shared environment variable %s (%s): %s", key, created[key], err))
+			}
+		}
+		return errs
+	}
+	fail := func(summary string, err error) {
+		detail := fmt.Sprintf("%s, unexpected error: %s", summary, err)
+		if errs := rollback(); len(errs) > 0 {
+			detail += "\n\nThe changes already made could not all be reverted:\n  - " + strings.Join(errs, "\n  - ")
+		} else {
+			detail += "\n\nThe changes already made have been reverted."
+		}
+		resp.Diagnostics.AddError("Error migrating environment variables", detail)
+	}
+
+	for _, key := range keys {
+		first := byKey[key][0].env
+		out, err := r.client.CreateSharedEnvironmentVariable(ctx, client.CreateSharedEnvironmentVariableRequest{
+			EnvironmentVariable: client.SharedEnvironmentVariableRequest{
+				Type:       "encrypted",
+				ProjectIDs: projectIDs,
+				Target:     first.Target,
+				EnvironmentVariables: []client.SharedEnvVarRequest{
+					{
+						Key:     key,
+						Value:   first.Value,
+						Comment: first.Comment,
+					},
+				},
+			},
+			TeamID: teamID,
+		})
+		if err != nil {
+			fail(fmt.Sprintf("Could not create shared environment variable %s", key), err)
+			return
+		}
+		created[key] = out.ID
+		tflog.Info(ctx, "created shared environment variable for migration", map[string]any{
+			"key":                            key,
+			"shared_environment_variable_id": out.ID,
+		})
+	}
+
+	for _, key := range keys {
+		for _, m := range byKey[key] {
+			err := r.client.DeleteEnvironmentVariable(ctx, m.projectID, teamID, m.env.ID)
+			if err != nil && !client.NotFound(err) {
+				fail(fmt.Sprintf("Could not remove environment variable %s from project %s", key, m.projectID), err)
+				return
+			}
+			removed = append(removed, m)
+			tflog.Info(ctx, "removed project environment variable for migration", map[string]any{
+				"key":        key,
+				"project_id": m.projectID,
+				"id":         m.env.ID,
+			})
+		}
+	}
+
+	ids, diags := types.MapValueFrom(ctx, types.StringType, created)
+	resp.Diagnostics.Append(diags...)
+	var removedValues []attr.Value
+	for _, m := range removed {
+		target, diags := types.SetValueFrom(ctx, types.StringType, m.env.Target)
+		resp.Diagnostics.Append(diags...)
+		removedValues = append(removedValues, types.ObjectValueMust(removedEnvironmentVariableAttrTypes, map[string]attr.Value{
+			"project_id": types.StringValue(m.projectID),
+			"key":        types.StringValue(m.env.Key),
+			"id":         types.StringValue(m.env.ID),
+			"target":     target,
+		}))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result := SharedEnvironmentVariableMigration{
+		TeamID:                       toTeamID(r.client.TeamID(teamID)),
+		ProjectIDs:                   plan.ProjectIDs,
+		Keys:                         plan.Keys,
+		SharedEnvironmentVariableIDs: ids,
+		Removed:                      types.ListValueMust(types.ObjectType{AttrTypes: removedEnvironmentVariableAttrTypes}, removedValues),
+	}
+	tflog.Info(ctx, "migrated environment variables to shared environment variables", map[string]any{
+		"team_id": result.TeamID.ValueString(),
+		"created": len(created),
+		"removed": len(removed),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read does not refresh anything, as the migration only happens once. The Shared Environment Variables it created are
+// not managed by this resource.
+func (r *sharedEnvironmentVariableMigrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SharedEnvironmentVariableMigration
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *sharedEnvironmentVariableMigrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Migration should always be recreated", "Something incorrectly caused an Update, this should always be recreated instead of updated.")
+}
+
+// Delete only removes the migration from the terraform state. The migration is not undone.
+func (r *sharedEnvironmentVariableMigrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SharedEnvironmentVariableMigration
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "removed shared environment variable migration from state", map[string]any{
+		"team_id": state.TeamID.ValueString(),
+	})
+}
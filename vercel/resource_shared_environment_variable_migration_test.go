@@ -0,0 +1,125 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testCheckProjectHasNoEnvironmentVariable(t *testing.T, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		envs, err := testClient(t).GetEnvironmentVariables(context.TODO(), rs.Primary.ID, testTeam(t))
+		if err != nil {
+			return err
+		}
+		for _, e := range envs {
+			if e.Key == key {
+				return fmt.Errorf("expected %s to have been removed from project %s", key, rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+// testCleanupMigratedSharedEnvironmentVariables deletes the shared environment variables created by a migration once
+// the test has finished, as destroying the migration leaves them in place.
+func testCleanupMigratedSharedEnvironmentVariables(t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		for k, id := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "shared_environment_variable_ids.") || k == "shared_environment_variable_ids.%" {
+				continue
+			}
+			t.Cleanup(func() {
+				_ = testClient(t).DeleteSharedEnvironmentVariable(context.TODO(), testTeam(t), id)
+			})
+		}
+		return nil
+	}
+}
+
+func TestAcc_SharedEnvironmentVariableMigration(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccSharedEnvironmentVariableMigrationProjects(name)),
+			},
+			{
+				Config: cfg(testAccSharedEnvironmentVariableMigrationProjects(name) + `
+resource "vercel_shared_environment_variable_migration" "mismatch" {
+  project_ids = [vercel_project.one.id, vercel_project.two.id]
+  keys        = ["DIFFERENT"]
+}
+`),
+				ExpectError: regexp.MustCompile(`DIFFERENT\s+has\s+a\s+different\s+value`),
+			},
+			{
+				Config: cfg(testAccSharedEnvironmentVariableMigrationProjects(name) + `
+resource "vercel_shared_environment_variable_migration" "test" {
+  project_ids = [vercel_project.one.id, vercel_project.two.id]
+  keys        = ["SHARED"]
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("vercel_shared_environment_variable_migration.test", "shared_environment_variable_ids.SHARED"),
+					resource.TestCheckResourceAttr("vercel_shared_environment_variable_migration.test", "removed.#", "2"),
+					resource.TestCheckResourceAttr("vercel_shared_environment_variable_migration.test", "removed.0.key", "SHARED"),
+					testCheckProjectHasNoEnvironmentVariable(t, "vercel_project.one", "SHARED"),
+					testCheckProjectHasNoEnvironmentVariable(t, "vercel_project.two", "SHARED"),
+					testCleanupMigratedSharedEnvironmentVariables(t, "vercel_shared_environment_variable_migration.test"),
+				),
+			},
+		},
+	})
+}
+
+// The environment variables are created outside of the project resources, so that the
+// projects do not try to recreate them once they have been migrated.
+func testAccSharedEnvironmentVariableMigrationProjects(name string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "one" {
+  name = "test-acc-migration-one-%[1]s"
+}
+
+resource "vercel_project" "two" {
+  name = "test-acc-migration-two-%[1]s"
+}
+
+resource "vercel_project_environment_variables" "one" {
+  project_id = vercel_project.one.id
+  variables = {
+    SHARED    = { value = "shared", target = ["production", "preview"] }
+    DIFFERENT = { value = "one", target = ["production"] }
+  }
+  lifecycle {
+    ignore_changes = [variables]
+  }
+}
+
+resource "vercel_project_environment_variables" "two" {
+  project_id = vercel_project.two.id
+  variables = {
+    SHARED    = { value = "shared", target = ["production", "preview"] }
+    DIFFERENT = { value = "two", target = ["production"] }
+  }
+  lifecycle {
+    ignore_changes = [variables]
+  }
+}
+`, name)
+}
@@ -16,8 +16,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &sharedEnvironmentVariableProjectLinkResource{}
-	_ resource.ResourceWithConfigure = &sharedEnvironmentVariableProjectLinkResource{}
+	_ resource.Resource                = &sharedEnvironmentVariableProjectLinkResource{}
+	_ resource.ResourceWithConfigure   = &sharedEnvironmentVariableProjectLinkResource{}
+	_ resource.ResourceWithImportState = &sharedEnvironmentVariableProjectLinkResource{}
 )
 
 func newSharedEnvironmentVariableProjectLinkResource() resource.Resource {
@@ -54,7 +55,9 @@ func (r *sharedEnvironmentVariableProjectLinkResource) Schema(_ context.Context,
 	resp.Schema = schema.Schema{
 		Description: `Links a project to a Shared Environment Variable.
 
-~> This resource is intended to be used alongside a vercel_shared_environment_variable Data Source, not the Resource. The Resource also defines which projects to link to the shared environment variable, and using both vercel_shared_environment_variable and vercel_shared_environment_variable_project_link together results in undefined behavior.`,
+This allows the team that owns a Shared Environment Variable and the teams that own projects to manage which projects it is linked to separately.
+
+~> This resource can be used alongside either a vercel_shared_environment_variable Data Source, or a vercel_shared_environment_variable Resource that does not set ` + "`project_ids`" + `. Setting ` + "`project_ids`" + ` on the Resource means it manages all of the linked projects, and using it together with vercel_shared_environment_variable_project_link results in undefined behavior.`,
 		Attributes: map[string]schema.Attribute{
 			"shared_environment_variable_id": schema.StringAttribute{
 				Required:      true,
@@ -148,6 +151,10 @@ func (r *sharedEnvironmentVariableProjectLinkResource) Read(ctx context.Context,
 	}
 
 	response, err := r.client.GetSharedEnvironmentVariable(ctx, state.TeamID.ValueString(), state.SharedEnvironmentVariableID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading shared environment variable",
@@ -220,3 +227,49 @@ func (r *sharedEnvironmentVariableProjectLinkResource) Delete(ctx context.Contex
 		"project_id":                     result.ProjectID.ValueString(),
 	})
 }
+
+func (r *sharedEnvironmentVariableProjectLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, envID, projectID, ok := splitInto2Or3(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing shared environment variable project link",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/shared_environment_variable_id/project_id\" or \"shared_environment_variable_id/project_id\"", req.ID),
+		)
+		return
+	}
+
+	response, err := r.client.GetSharedEnvironmentVariable(ctx, teamID, envID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading shared environment variable",
+			fmt.Sprintf("Could not get shared environment variable %s %s, unexpected error: %s",
+				teamID,
+				envID,
+				err,
+			),
+		)
+		return
+	}
+
+	if !slices.Contains(response.ProjectIDs, projectID) {
+		resp.Diagnostics.AddError(
+			"Error importing shared environment variable project link",
+			fmt.Sprintf("Shared environment variable %s is not linked to project %s", envID, projectID),
+		)
+		return
+	}
+
+	result := SharedEnvironmentVariableProjectLink{
+		TeamID:                      types.StringValue(response.TeamID),
+		SharedEnvironmentVariableID: types.StringValue(response.ID),
+		ProjectID:                   types.StringValue(projectID),
+	}
+	tflog.Info(ctx, "imported shared environment variable project link", map[string]any{
+		"team_id":                        result.TeamID.ValueString(),
+		"shared_environment_variable_id": result.SharedEnvironmentVariableID.ValueString(),
+		"project_id":                     result.ProjectID.ValueString(),
+	})
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
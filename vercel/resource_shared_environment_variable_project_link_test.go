@@ -73,6 +73,12 @@ func TestAcc_SharedEnvironmentVariableProjectLink(t *testing.T) {
 					testCheckSharedEnvironmentVariableProjectLinked(testClient(t), "data.vercel_shared_environment_variable.test", "vercel_project.test1", testTeam(t)),
 				),
 			},
+			{
+				ResourceName:      "vercel_shared_environment_variable_project_link.test0",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getSharedEnvironmentVariableProjectLinkImportID("vercel_shared_environment_variable_project_link.test0"),
+			},
 			{
 				Config: cfg(testAccSharedEnvironmentVariableProjectLinkAdd1(name)),
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -93,6 +99,80 @@ func TestAcc_SharedEnvironmentVariableProjectLink(t *testing.T) {
 	})
 }
 
+func getSharedEnvironmentVariableProjectLinkImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+
+		return fmt.Sprintf(
+			"%s/%s/%s",
+			rs.Primary.Attributes["team_id"],
+			rs.Primary.Attributes["shared_environment_variable_id"],
+			rs.Primary.Attributes["project_id"],
+		), nil
+	}
+}
+
+func TestAcc_SharedEnvironmentVariableProjectLinkWithResource(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.test0", testTeam(t)),
+			testAccProjectDestroy(testClient(t), "vercel_project.test1", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccSharedEnvironmentVariableProjectLinkWithResource(name, "bar")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("vercel_shared_environment_variable.test", "project_ids"),
+					testCheckSharedEnvironmentVariableProjectLinked(testClient(t), "vercel_shared_environment_variable.test", "vercel_project.test0", testTeam(t)),
+					testCheckSharedEnvironmentVariableProjectLinked(testClient(t), "vercel_shared_environment_variable.test", "vercel_project.test1", testTeam(t)),
+				),
+			},
+			{
+				// Changing the variable itself must not unlink the separately managed projects.
+				Config: cfg(testAccSharedEnvironmentVariableProjectLinkWithResource(name, "baz")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_shared_environment_variable.test", "value", "baz"),
+					testCheckSharedEnvironmentVariableProjectLinked(testClient(t), "vercel_shared_environment_variable.test", "vercel_project.test0", testTeam(t)),
+					testCheckSharedEnvironmentVariableProjectLinked(testClient(t), "vercel_shared_environment_variable.test", "vercel_project.test1", testTeam(t)),
+				),
+			},
+		},
+	})
+}
+
+func testAccSharedEnvironmentVariableProjectLinkWithResource(name, value string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test0" {
+    name = "test-acc-shared-env-link-0-%[1]s"
+}
+
+resource "vercel_project" "test1" {
+    name = "test-acc-shared-env-link-1-%[1]s"
+}
+
+resource "vercel_shared_environment_variable" "test" {
+    key    = "test_acc_%[1]s"
+    value  = "%[2]s"
+    target = ["production", "preview"]
+}
+
+resource "vercel_shared_environment_variable_project_link" "test0" {
+    shared_environment_variable_id = vercel_shared_environment_variable.test.id
+    project_id                     = vercel_project.test0.id
+}
+
+resource "vercel_shared_environment_variable_project_link" "test1" {
+    shared_environment_variable_id = vercel_shared_environment_variable.test.id
+    project_id                     = vercel_project.test1.id
+}
+`, name, value)
+}
+
 func testAccSharedEnvironmentVariableProjectLinkSetup(name string) string {
 	return fmt.Sprintf(`
 data "vercel_shared_environment_variable" "test" {
@@ -0,0 +1,580 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+var (
+	_ resource.Resource              = &sharedEnvironmentVariableSetResource{}
+	_ resource.ResourceWithConfigure = &sharedEnvironmentVariableSetResource{}
+)
+
+func newSharedEnvironmentVariableSetResource() resource.Resource {
+	return &sharedEnvironmentVariableSetResource{}
+}
+
+type sharedEnvironmentVariableSetResource struct {
+	client *client.Client
+}
+
+func (r *sharedEnvironmentVariableSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_shared_environment_variable_set"
+}
+
+func (r *sharedEnvironmentVariableSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema returns the schema information for a shared environment variable set resource.
+func (r *sharedEnvironmentVariableSetResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a resource for managing a number of Shared Environment Variables.
+
+Shared Environment Variables are defined at the team level, and linked to any number of Vercel Projects. Every Environment Variable in the set has the same targets and is linked to the same projects, so a secret used by many projects only needs to be defined once.
+
+For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/concepts/projects/environment-variables/shared-environment-variables).
+
+~> Each Environment Variable should only be managed by one ` + "`vercel_shared_environment_variable_set` or `vercel_shared_environment_variable`" + ` resource. Projects can also be linked to the variables with ` + "`vercel_shared_environment_variable_project_link`" + `, but those projects must not also be listed in ` + "`project_ids`" + `.
+`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the Vercel team. Shared environment variables require a team.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"project_ids": schema.SetAttribute{
+				Required:    true,
+				Description: "The IDs of the Vercel projects that the Environment Variables are linked to.",
+				ElementType: types.StringType,
+			},
+			"target": schema.SetAttribute{
+				Required:    true,
+				Description: "The environments that the Environment Variables should be present on. Valid targets are either `production`, `preview`, or `development`.",
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("production", "preview", "development")),
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"sensitive": schema.BoolAttribute{
+				Description:   "Whether the Environment Variables are sensitive or not. (May be affected by a [team-wide environment variable policy](https://vercel.com/docs/projects/environment-variables/sensitive-environment-variables#environment-variables-policy))",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace(), boolplanmodifier.UseStateForUnknown()},
+			},
+			"apply_to_all_custom_environments": schema.BoolAttribute{
+				Description: "Whether the Environment Variables should be applied to all custom environments in the linked projects. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"variables": schema.MapNestedAttribute{
+				Required:    true,
+				Description: "A map of Shared Environment Variables. The map key is the environment variable name. Names can only contain letters, digits and underscores, and names that Vercel reserves, such as `TZ`, cannot be used.",
+				Validators: []validator.Map{
+					validateEnvVariableKeys(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description:   "The ID of the Environment Variable.",
+							Computed:      true,
+							PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+						},
+						"value": schema.StringAttribute{
+							Required:    true,
+							Description: "The value of the Environment Variable.",
+							Sensitive:   true,
+						},
+						"comment": schema.StringAttribute{
+							Description: "A comment explaining what the environment variable is for.",
+							Optional:    true,
+							Computed:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(0, 1000),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// SharedEnvironmentVariableSet reflects the state terraform stores internally for a shared environment variable set.
+type SharedEnvironmentVariableSet struct {
+	TeamID                       types.String `tfsdk:"team_id"`
+	ProjectIDs                   types.Set    `tfsdk:"project_ids"`
+	Target                       types.Set    `tfsdk:"target"`
+	Sensitive                    types.Bool   `tfsdk:"sensitive"`
+	ApplyToAllCustomEnvironments types.Bool   `tfsdk:"apply_to_all_custom_environments"`
+	Variables                    types.Map    `tfsdk:"variables"`
+}
+
+// SharedEnvironmentVariableSetItem is a single variable in a shared environment variable set.
+type SharedEnvironmentVariableSetItem struct {
+	ID      types.String `tfsdk:"id"`
+	Value   types.String `tfsdk:"value"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+var sharedEnvironmentVariableSetItemType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":      types.StringType,
+		"value":   types.StringType,
+		"comment": types.StringType,
+	},
+}
+
+func (s SharedEnvironmentVariableSet) variables(ctx context.Context) (map[string]SharedEnvironmentVariableSetItem, diag.Diagnostics) {
+	vars := map[string]SharedEnvironmentVariableSetItem{}
+	if s.Variables.IsNull() || s.Variables.IsUnknown() {
+		return vars, nil
+	}
+	diags := s.Variables.ElementsAs(ctx, &vars, false)
+	return vars, diags
+}
+
+func (s SharedEnvironmentVariableSet) envVariableType() string {
+	if s.Sensitive.ValueBool() {
+		return "sensitive"
+	}
+	return "encrypted"
+}
+
+func (s SharedEnvironmentVariableSet) toCreateSharedEnvironmentVariableRequest(ctx context.Context, keys []string, vars map[string]SharedEnvironmentVariableSetItem) (req client.CreateSharedEnvironmentVariableRequest, diags diag.Diagnostics) {
+	var target, projectIDs []string
+	diags.Append(s.Target.ElementsAs(ctx, &target, false)...)
+	diags.Append(s.ProjectIDs.ElementsAs(ctx, &projectIDs, false)...)
+	if diags.HasError() {
+		return req, diags
+	}
+
+	evs := make([]client.SharedEnvVarRequest, 0, len(keys))
+	for _, key := range keys {
+		evs = append(evs, client.SharedEnvVarRequest{
+			Key:     key,
+			Value:   vars[key].Value.ValueString(),
+			Comment: vars[key].Comment.ValueString(),
+		})
+	}
+	return client.CreateSharedEnvironmentVariableRequest{
+		EnvironmentVariable: client.SharedEnvironmentVariableRequest{
+			ApplyToAllCustomEnvironments: s.ApplyToAllCustomEnvironments.ValueBool(),
+			Target:                       target,
+			Type:                         s.envVariableType(),
+			ProjectIDs:                   projectIDs,
+			EnvironmentVariables:         evs,
+		},
+		TeamID: s.TeamID.ValueString(),
+	}, nil
+}
+
+func (s SharedEnvironmentVariableSet) toUpdateSharedEnvironmentVariableRequest(ctx context.Context, v SharedEnvironmentVariableSetItem) (req client.UpdateSharedEnvironmentVariableRequest, diags diag.Diagnostics) {
+	var target, projectIDs []string
+	diags.Append(s.Target.ElementsAs(ctx, &target, false)...)
+	diags.Append(s.ProjectIDs.ElementsAs(ctx, &projectIDs, false)...)
+	if diags.HasError() {
+		return req, diags
+	}
+	return client.UpdateSharedEnvironmentVariableRequest{
+		ApplyToAllCustomEnvironments: s.ApplyToAllCustomEnvironments.ValueBool(),
+		Value:                        v.Value.ValueString(),
+		Target:                       target,
+		Type:                         s.envVariableType(),
+		TeamID:                       s.TeamID.ValueString(),
+		EnvID:                        v.ID.ValueString(),
+		ProjectIDs:                   projectIDs,
+		Comment:                      v.Comment.ValueString(),
+	}, nil
+}
+
+// convertResponseToSharedEnvironmentVariableSet is used to populate terraform state based on the API responses for
+// each variable. The settings shared by the variables are taken from the first variable that differs from the
+// prior state, so that a change made outside of Terraform to any one variable is reported as drift.
+func convertResponseToSharedEnvironmentVariableSet(responses map[string]client.SharedEnvironmentVariableResponse, prior SharedEnvironmentVariableSet, priorVars map[string]SharedEnvironmentVariableSetItem) SharedEnvironmentVariableSet {
+	result := SharedEnvironmentVariableSet{
+		TeamID:                       prior.TeamID,
+		ProjectIDs:                   prior.ProjectIDs,
+		Target:                       prior.Target,
+		Sensitive:                    prior.Sensitive,
+		ApplyToAllCustomEnvironments: prior.ApplyToAllCustomEnvironments,
+	}
+
+	keys := make([]string, 0, len(responses))
+	for key := range responses {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	vars := map[string]attr.Value{}
+	for i, key := range keys {
+		response := responses[key]
+		target := []attr.Value{}
+		for _, t := range response.Target {
+			target = append(target, types.StringValue(t))
+		}
+		projectIDs := []attr.Value{}
+		for _, id := range response.ProjectIDs {
+			projectIDs = append(projectIDs, types.StringValue(id))
+		}
+		targetValue := types.SetValueMust(types.StringType, target)
+		projectIDsValue := types.SetValueMust(types.StringType, projectIDs)
+		sensitive := types.BoolValue(response.Type == "sensitive")
+		applyToAll := types.BoolValue(response.ApplyToAllCustomEnvironments)
+		if i == 0 || !targetValue.Equal(prior.Target) && result.Target.Equal(prior.Target) {
+			result.Target = targetValue
+		}
+		if i == 0 || !projectIDsValue.Equal(prior.ProjectIDs) && result.ProjectIDs.Equal(prior.ProjectIDs) {
+			result.ProjectIDs = projectIDsValue
+		}
+		if i == 0 || !sensitive.Equal(prior.Sensitive) && result.Sensitive.Equal(prior.Sensitive) {
+			result.Sensitive = sensitive
+		}
+		if i == 0 || !applyToAll.Equal(prior.ApplyToAllCustomEnvironments) && result.ApplyToAllCustomEnvironments.Equal(prior.ApplyToAllCustomEnvironments) {
+			result.ApplyToAllCustomEnvironments = applyToAll
+		}
+		if i == 0 {
+			result.TeamID = toTeamID(response.TeamID)
+		}
+
+		value := types.StringValue(response.Value)
+		if response.Type == "sensitive" {
+			value = priorVars[key].Value
+		}
+		vars[key] = types.ObjectValueMust(sharedEnvironmentVariableSetItemType.AttrTypes, map[string]attr.Value{
+			"id":      types.StringValue(response.ID),
+			"value":   value,
+			"comment": types.StringValue(response.Comment),
+		})
+	}
+	result.Variables = types.MapValueMust(sharedEnvironmentVariableSetItemType, vars)
+	return result
+}
+
+// Create will create the shared environment variables in a single request.
+// This is called automatically by the provider when a new resource should be created.
+func (r *sharedEnvironmentVariableSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SharedEnvironmentVariableSet
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vars, diags := plan.variables(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	keys := make([]string, 0, len(vars))
+	for key := range vars {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	request, diags := plan.toCreateSharedEnvironmentVariableRequest(ctx, keys, vars)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	created, err := r.client.CreateSharedEnvironmentVariables(ctx, request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating shared environment variables",
+			"Could not create shared environment variables, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	responses := map[string]client.SharedEnvironmentVariableResponse{}
+	for _, e := range created {
+		responses[e.Key] = e
+	}
+	result := convertResponseToSharedEnvironmentVariableSet(responses, plan, vars)
+
+	tflog.Info(ctx, "created shared environment variables", map[string]any{
+		"team_id": result.TeamID.ValueString(),
+		"count":   len(created),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// getVariables reads each of the given variables from the Vercel API in parallel. Variables that no longer exist
+// are left out of the result.
+func (r *sharedEnvironmentVariableSetResource) getVariables(ctx context.Context, teamID string, vars map[string]SharedEnvironmentVariableSetItem) (map[string]client.SharedEnvironmentVariableResponse, error) {
+	keys := make([]string, 0, len(vars))
+	for key := range vars {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var mu sync.Mutex
+	responses := map[string]client.SharedEnvironmentVariableResponse{}
+	errs := runConcurrently(len(keys), maxConcurrentRequests, func(i int) error {
+		out, err := r.client.GetSharedEnvironmentVariable(ctx, teamID, vars[keys[i]].ID.ValueString())
+		if client.NotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("could not get shared environment variable %s: %w", keys[i], err)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		responses[keys[i]] = out
+		return nil
+	})
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return responses, nil
+}
+
+// Read will read the shared environment variables by requesting them from the Vercel API, and will update terraform
+// with this information.
+func (r *sharedEnvironmentVariableSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SharedEnvironmentVariableSet
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vars, diags := state.variables(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responses, err := r.getVariables(ctx, state.TeamID.ValueString(), vars)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading shared environment variables",
+			"Could not read shared environment variables, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if len(responses) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	result := convertResponseToSharedEnvironmentVariableSet(responses, state, vars)
+	tflog.Info(ctx, "read shared environment variables", map[string]any{
+		"team_id": result.TeamID.ValueString(),
+		"count":   len(responses),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update creates, updates and deletes shared environment variables so that they match the plan. Variables whose
+// value or comment have changed, or all variables if the shared settings have changed, are updated in place.
+func (r *sharedEnvironmentVariableSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state SharedEnvironmentVariableSet
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planVars, diags := plan.variables(ctx)
+	resp.Diagnostics.Append(diags...)
+	stateVars, diags := state.variables(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settingsChanged := !plan.Target.Equal(state.Target) ||
+		!plan.ProjectIDs.Equal(state.ProjectIDs) ||
+		!plan.ApplyToAllCustomEnvironments.Equal(state.ApplyToAllCustomEnvironments)
+
+	keys := make([]string, 0, len(planVars))
+	for key := range planVars {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var toCreate, toDelete, updateKeys []string
+	var toUpdate []client.UpdateSharedEnvironmentVariableRequest
+	unchanged := map[string]SharedEnvironmentVariableSetItem{}
+	for _, key := range keys {
+		v := planVars[key]
+		prior, ok := stateVars[key]
+		if !ok {
+			toCreate = append(toCreate, key)
+			continue
+		}
+		v.ID = prior.ID
+		planVars[key] = v
+		if !settingsChanged && v.Value.Equal(prior.Value) && (v.Comment.IsUnknown() || v.Comment.Equal(prior.Comment)) {
+			unchanged[key] = prior
+			continue
+		}
+		u, diags := plan.toUpdateSharedEnvironmentVariableRequest(ctx, v)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		toUpdate = append(toUpdate, u)
+		updateKeys = append(updateKeys, key)
+	}
+	for key := range stateVars {
+		if _, ok := planVars[key]; !ok {
+			toDelete = append(toDelete, key)
+		}
+	}
+	sort.Strings(toDelete)
+
+	tflog.Info(ctx, "updating shared environment variables", map[string]any{
+		"to_create": len(toCreate),
+		"to_update": len(toUpdate),
+		"to_delete": len(toDelete),
+	})
+
+	for _, key := range toDelete {
+		err := r.client.DeleteSharedEnvironmentVariable(ctx, state.TeamID.ValueString(), stateVars[key].ID.ValueString())
+		if err != nil && !client.NotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error updating shared environment variables",
+				fmt.Sprintf("Could not delete shared environment variable %s (%s), unexpected error: %s", key, stateVars[key].ID.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	responses := map[string]client.SharedEnvironmentVariableResponse{}
+	if len(toCreate) > 0 {
+		request, diags := plan.toCreateSharedEnvironmentVariableRequest(ctx, toCreate, planVars)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		created, err := r.client.CreateSharedEnvironmentVariables(ctx, request)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating shared environment variables",
+				"Could not create shared environment variables, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		for _, e := range created {
+			responses[e.Key] = e
+		}
+	}
+
+	for i, u := range toUpdate {
+		updated, err := r.client.UpdateSharedEnvironmentVariable(ctx, u)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating shared environment variables",
+				fmt.Sprintf("Could not update shared environment variable %s (%s), unexpected error: %s", updateKeys[i], u.EnvID, err),
+			)
+			return
+		}
+		responses[updateKeys[i]] = updated
+	}
+
+	if len(unchanged) > 0 {
+		current, err := r.getVariables(ctx, plan.TeamID.ValueString(), unchanged)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating shared environment variables",
+				"Could not read shared environment variables, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		for key, e := range current {
+			responses[key] = e
+		}
+	}
+
+	result := convertResponseToSharedEnvironmentVariableSet(responses, plan, planVars)
+	tflog.Info(ctx, "updated shared environment variables", map[string]any{
+		"team_id": result.TeamID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the shared environment variables.
+func (r *sharedEnvironmentVariableSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SharedEnvironmentVariableSet
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	vars, diags := state.variables(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for key, v := range vars {
+		err := r.client.DeleteSharedEnvironmentVariable(ctx, state.TeamID.ValueString(), v.ID.ValueString())
+		if client.NotFound(err) {
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting shared environment variables",
+				fmt.Sprintf("Could not delete shared environment variable %s (%s), unexpected error: %s", key, v.ID.ValueString(), err),
+			)
+			return
+		}
+		tflog.Info(ctx, "deleted shared environment variable", map[string]any{
+			"id":      v.ID.ValueString(),
+			"team_id": state.TeamID.ValueString(),
+		})
+	}
+}
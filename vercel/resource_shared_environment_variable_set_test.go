@@ -0,0 +1,75 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_SharedEnvironmentVariableSet(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	resourceName := "vercel_shared_environment_variable_set.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccSharedEnvironmentVariableSetConfig(nameSuffix, `["production"]`, fmt.Sprintf(`
+    "test_acc_foo_%[1]s" = {
+      value   = "bar"
+      comment = "foo"
+    }
+    "test_acc_baz_%[1]s" = {
+      value = "qux"
+    }
+`, nameSuffix))),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "variables.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, fmt.Sprintf("variables.test_acc_foo_%s.id", nameSuffix)),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("variables.test_acc_foo_%s.comment", nameSuffix), "foo"),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("variables.test_acc_baz_%s.comment", nameSuffix), ""),
+					resource.TestCheckResourceAttr(resourceName, "project_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "target.*", "production"),
+				),
+			},
+			{
+				Config: cfg(testAccSharedEnvironmentVariableSetConfig(nameSuffix, `["production", "preview"]`, fmt.Sprintf(`
+    "test_acc_foo_%[1]s" = {
+      value   = "bar-updated"
+      comment = "foo"
+    }
+    "test_acc_quux_%[1]s" = {
+      value = "corge"
+    }
+`, nameSuffix))),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "variables.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("variables.test_acc_foo_%s.value", nameSuffix), "bar-updated"),
+					resource.TestCheckResourceAttrSet(resourceName, fmt.Sprintf("variables.test_acc_quux_%s.id", nameSuffix)),
+					resource.TestCheckNoResourceAttr(resourceName, fmt.Sprintf("variables.test_acc_baz_%s.id", nameSuffix)),
+					resource.TestCheckResourceAttr(resourceName, "target.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSharedEnvironmentVariableSetConfig(nameSuffix, target, variables string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-shared-env-set-%[1]s"
+}
+
+resource "vercel_shared_environment_variable_set" "test" {
+  target      = %[2]s
+  project_ids = [vercel_project.test.id]
+  variables = {
+%[3]s
+  }
+}
+`, nameSuffix, target, variables)
+}
@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -183,6 +185,24 @@ func (r *teamConfigResource) Schema(_ context.Context, req resource.SchemaReques
 						Computed:      true,
 						PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
 					},
+					"artifact_retention_days": schema.Int64Attribute{
+						Description:   "The number of days that artifacts are kept in the Remote Cache before they expire.",
+						Optional:      true,
+						Computed:      true,
+						PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+						Validators: []validator.Int64{
+							int64validator.Between(1, 365),
+						},
+					},
+					"max_artifact_size_mb": schema.Int64Attribute{
+						Description:   "The maximum size, in megabytes, of a single artifact that can be uploaded to the Remote Cache.",
+						Optional:      true,
+						Computed:      true,
+						PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
 				},
 			},
 			"enable_preview_feedback": schema.StringAttribute{
@@ -215,6 +235,11 @@ func (r *teamConfigResource) Schema(_ context.Context, req resource.SchemaReques
 				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
 				Description:   "Indicates if ip addresses should be accessible in log drains.",
 			},
+			"two_factor_authentication_enforced": schema.BoolAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+				Description:   "Indicates if members of the team are required to have two-factor authentication enabled. This can only be changed in the Vercel dashboard.",
+			},
 		},
 	}
 }
@@ -284,14 +309,38 @@ type TeamConfig struct {
 	HideIPAddresses                    types.Bool   `tfsdk:"hide_ip_addresses"`
 	HideIPAddressesInLogDrains         types.Bool   `tfsdk:"hide_ip_addresses_in_log_drains"`
 	Saml                               types.Object `tfsdk:"saml"`
+	TwoFactorAuthenticationEnforced    types.Bool   `tfsdk:"two_factor_authentication_enforced"`
+}
+
+// TeamConfigV0 is the state of a team_config resource prior to version 1.
+type TeamConfigV0 struct {
+	ID                                 types.String `tfsdk:"id"`
+	Avatar                             types.Map    `tfsdk:"avatar"`
+	Name                               types.String `tfsdk:"name"`
+	Slug                               types.String `tfsdk:"slug"`
+	Description                        types.String `tfsdk:"description"`
+	InviteCode                         types.String `tfsdk:"invite_code"`
+	SensitiveEnvironmentVariablePolicy types.String `tfsdk:"sensitive_environment_variable_policy"`
+	EmailDomain                        types.String `tfsdk:"email_domain"`
+	PreviewDeploymentSuffix            types.String `tfsdk:"preview_deployment_suffix"`
+	RemoteCaching                      types.Object `tfsdk:"remote_caching"`
+	EnablePreviewFeedback              types.String `tfsdk:"enable_preview_feedback"`
+	EnableProductionFeedback           types.String `tfsdk:"enable_production_feedback"`
+	HideIPAddresses                    types.Bool   `tfsdk:"hide_ip_addresses"`
+	HideIPAddressesInLogDrains         types.Bool   `tfsdk:"hide_ip_addresses_in_log_drains"`
+	Saml                               types.Object `tfsdk:"saml"`
 }
 
 type RemoteCaching struct {
-	Enabled types.Bool `tfsdk:"enabled"`
+	Enabled               types.Bool  `tfsdk:"enabled"`
+	ArtifactRetentionDays types.Int64 `tfsdk:"artifact_retention_days"`
+	MaxArtifactSizeMB     types.Int64 `tfsdk:"max_artifact_size_mb"`
 }
 
 var remoteCachingAttrTypes = map[string]attr.Type{
-	"enabled": types.BoolType,
+	"enabled":                 types.BoolType,
+	"artifact_retention_days": types.Int64Type,
+	"max_artifact_size_mb":    types.Int64Type,
 }
 
 func (r *RemoteCaching) toUpdateTeamRequest() *client.RemoteCaching {
@@ -299,7 +348,9 @@ func (r *RemoteCaching) toUpdateTeamRequest() *client.RemoteCaching {
 		return nil
 	}
 	return &client.RemoteCaching{
-		Enabled: r.Enabled.ValueBoolPointer(),
+		Enabled:               r.Enabled.ValueBoolPointer(),
+		ArtifactRetentionDays: r.ArtifactRetentionDays.ValueInt64Pointer(),
+		MaxArtifactSizeMB:     r.MaxArtifactSizeMB.ValueInt64Pointer(),
 	}
 }
 
@@ -377,7 +428,9 @@ func convertResponseToTeamConfig(ctx context.Context, response client.Team, avat
 	if response.RemoteCaching != nil {
 		var diags diag.Diagnostics
 		remoteCaching, diags = types.ObjectValueFrom(ctx, remoteCachingAttrTypes, &RemoteCaching{
-			Enabled: types.BoolPointerValue(response.RemoteCaching.Enabled),
+			Enabled:               types.BoolPointerValue(response.RemoteCaching.Enabled),
+			ArtifactRetentionDays: types.Int64PointerValue(response.RemoteCaching.ArtifactRetentionDays),
+			MaxArtifactSizeMB:     types.Int64PointerValue(response.RemoteCaching.MaxArtifactSizeMB),
 		})
 		if diags.HasError() {
 			return TeamConfig{}, diags
@@ -429,6 +482,7 @@ func convertResponseToTeamConfig(ctx context.Context, response client.Team, avat
 		HideIPAddressesInLogDrains:         types.BoolPointerValue(response.HideIPAddressesInLogDrains),
 		RemoteCaching:                      remoteCaching,
 		Saml:                               saml,
+		TwoFactorAuthenticationEnforced:    types.BoolValue(response.EnforceTwoFactorAuthentication != nil && *response.EnforceTwoFactorAuthentication),
 	}, nil
 }
 
@@ -777,7 +831,7 @@ func (r *teamConfigResource) UpgradeState(ctx context.Context) map[int64]resourc
 				},
 			},
 			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
-				var priorStateData TeamConfig
+				var priorStateData TeamConfigV0
 				resp.Diagnostics.Append(req.State.Get(ctx, &priorStateData)...)
 				if resp.Diagnostics.HasError() {
 					return
@@ -799,6 +853,7 @@ func (r *teamConfigResource) UpgradeState(ctx context.Context) map[int64]resourc
 					EnableProductionFeedback:           priorStateData.EnableProductionFeedback,
 					HideIPAddresses:                    priorStateData.HideIPAddresses,
 					HideIPAddressesInLogDrains:         priorStateData.HideIPAddressesInLogDrains,
+					TwoFactorAuthenticationEnforced:    types.BoolNull(),
 				}
 
 				if !priorStateData.Saml.IsNull() {
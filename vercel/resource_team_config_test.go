@@ -30,10 +30,13 @@ func TestAcc_TeamConfig(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "description", "Vercel Terraform Testing"),
 					resource.TestCheckResourceAttr(resourceName, "sensitive_environment_variable_policy", "off"),
 					resource.TestCheckResourceAttr(resourceName, "remote_caching.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "remote_caching.artifact_retention_days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "remote_caching.max_artifact_size_mb", "512"),
 					resource.TestCheckResourceAttr(resourceName, "enable_preview_feedback", "off"),
 					resource.TestCheckResourceAttr(resourceName, "enable_production_feedback", "off"),
 					resource.TestCheckResourceAttr(resourceName, "hide_ip_addresses", "true"),
 					resource.TestCheckResourceAttr(resourceName, "hide_ip_addresses_in_log_drains", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "two_factor_authentication_enforced"),
 				),
 			},
 		},
@@ -63,7 +66,9 @@ resource "vercel_team_config" "test" {
   description                           = "Vercel Terraform Testing"
   sensitive_environment_variable_policy = "off"
   remote_caching = {
-    enabled = true
+    enabled                 = true
+    artifact_retention_days = 30
+    max_artifact_size_mb    = 512
   }
   enable_preview_feedback = "off"
   enable_production_feedback = "off"
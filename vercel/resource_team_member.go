@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -597,14 +598,42 @@ func (r *teamMemberResource) Delete(ctx context.Context, req resource.DeleteRequ
 	resp.State.RemoveResource(ctx)
 }
 
+// findUserIDByEmail looks up the ID of the team member with the given email address. Email addresses are compared
+// case-insensitively.
+func (r *teamMemberResource) findUserIDByEmail(ctx context.Context, teamID, email string) (string, error) {
+	members, err := r.client.ListTeamMembers(ctx, teamID)
+	if err != nil {
+		return "", err
+	}
+	for _, m := range members {
+		if strings.EqualFold(m.Email, email) {
+			return m.UserID, nil
+		}
+	}
+	return "", fmt.Errorf("no member of team %s has the email %s", teamID, email)
+}
+
 // ImportState implements resource.ResourceWithImportState.
 func (r *teamMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	teamID, userID, ok := splitInto2(req.ID)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Error importing Team Member",
-			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/user_id\"", req.ID),
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/user_id\" or \"team_id/email\"", req.ID),
 		)
+		return
+	}
+
+	if strings.Contains(userID, "@") {
+		var err error
+		userID, err = r.findUserIDByEmail(ctx, teamID, userID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error importing Team Member",
+				"Could not find Team Member, unexpected error: "+err.Error(),
+			)
+			return
+		}
 	}
 
 	var response client.TeamMember
@@ -0,0 +1,288 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+var (
+	_ resource.Resource               = &teamMemberEnforcementResource{}
+	_ resource.ResourceWithConfigure  = &teamMemberEnforcementResource{}
+	_ resource.ResourceWithModifyPlan = &teamMemberEnforcementResource{}
+)
+
+func newTeamMemberEnforcementResource() resource.Resource {
+	return &teamMemberEnforcementResource{}
+}
+
+type teamMemberEnforcementResource struct {
+	client *client.Client
+}
+
+func (r *teamMemberEnforcementResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_member_enforcement"
+}
+
+func (r *teamMemberEnforcementResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+const (
+	teamMemberEnforcementRemove    = "remove"
+	teamMemberEnforcementDowngrade = "downgrade"
+)
+
+func (r *teamMemberEnforcementResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a resource for making the members of a team authoritative.
+
+Any member of the team that is not listed in ` + "`members`" + ` is either removed from the team, or downgraded to a less privileged role. Members added outside of Terraform are reported as drift when the resource is refreshed, and the next apply enforces the configuration, which is useful for periodic access reviews.
+
+~> The user that owns the API token used by the provider is never removed or downgraded. Reference the ` + "`user_id`" + ` of ` + "`vercel_team_member`" + ` resources in ` + "`members`" + `, so that newly invited members are added before the enforcement runs.
+`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Description:   "The ID of the existing Vercel Team.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"members": schema.SetAttribute{
+				Description: "The user IDs or email addresses of the members that are allowed in the team.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"action": schema.StringAttribute{
+				Description: "What happens to members that are not listed in `members`. With `remove`, they are removed from the team. With `downgrade`, their role is changed to `downgrade_role`. Defaults to `remove`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(teamMemberEnforcementRemove),
+				Validators: []validator.String{
+					stringvalidator.OneOf(teamMemberEnforcementRemove, teamMemberEnforcementDowngrade),
+				},
+			},
+			"downgrade_role": schema.StringAttribute{
+				Description: "The role that members not listed in `members` are given when `action` is `downgrade`. One of 'VIEWER', 'BILLING' or 'CONTRIBUTOR'. Defaults to `VIEWER`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("VIEWER"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("VIEWER", "BILLING", "CONTRIBUTOR"),
+				},
+			},
+			"unmanaged_members": schema.SetAttribute{
+				Description: "The email addresses of members that are not listed in `members`, and have not yet been removed or downgraded. This is always empty after an apply.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+type TeamMemberEnforcement struct {
+	TeamID           types.String `tfsdk:"team_id"`
+	Members          types.Set    `tfsdk:"members"`
+	Action           types.String `tfsdk:"action"`
+	DowngradeRole    types.String `tfsdk:"downgrade_role"`
+	UnmanagedMembers types.Set    `tfsdk:"unmanaged_members"`
+}
+
+// unmanagedMembers returns the members of the team that are not allowed by the configuration, and still need to be
+// removed or downgraded.
+func (r *teamMemberEnforcementResource) unmanagedMembers(ctx context.Context, e TeamMemberEnforcement) ([]client.TeamMember, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var allowed []string
+	diags.Append(e.Members.ElementsAs(ctx, &allowed, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	isAllowed := map[string]bool{}
+	for _, a := range allowed {
+		isAllowed[strings.ToLower(a)] = true
+	}
+
+	user, err := r.client.GetCurrentUser(ctx)
+	if err != nil {
+		diags.AddError(
+			"Error reading Team Members",
+			"Could not read the current user, unexpected error: "+err.Error(),
+		)
+		return nil, diags
+	}
+	members, err := r.client.ListTeamMembers(ctx, e.TeamID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error reading Team Members",
+			"Could not list Team Members, unexpected error: "+err.Error(),
+		)
+		return nil, diags
+	}
+
+	var unmanaged []client.TeamMember
+	for _, m := range members {
+		if m.UserID == user.ID || isAllowed[strings.ToLower(m.UserID)] || isAllowed[strings.ToLower(m.Email)] {
+			continue
+		}
+		if e.Action.ValueString() == teamMemberEnforcementDowngrade && m.Role == e.DowngradeRole.ValueString() {
+			continue
+		}
+		unmanaged = append(unmanaged, m)
+	}
+	sort.Slice(unmanaged, func(i, j int) bool {
+		return unmanaged[i].Email < unmanaged[j].Email
+	})
+	return unmanaged, diags
+}
+
+func unmanagedMembersValue(members []client.TeamMember) types.Set {
+	values := []attr.Value{}
+	for _, m := range members {
+		name := m.Email
+		if name == "" {
+			name = m.UserID
+		}
+		values = append(values, types.StringValue(name))
+	}
+	return types.SetValueMust(types.StringType, values)
+}
+
+// ModifyPlan plans for there to be no unmanaged members, so that any found when refreshing cause an update.
+func (r *teamMemberEnforcementResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("unmanaged_members"), types.SetValueMust(types.StringType, []attr.Value{}))...)
+}
+
+// enforce removes or downgrades every unmanaged member of the team.
+func (r *teamMemberEnforcementResource) enforce(ctx context.Context, plan TeamMemberEnforcement) diag.Diagnostics {
+	unmanaged, diags := r.unmanagedMembers(ctx, plan)
+	if diags.HasError() {
+		return diags
+	}
+	for _, m := range unmanaged {
+		var err error
+		if plan.Action.ValueString() == teamMemberEnforcementDowngrade {
+			err = r.client.UpdateTeamMember(ctx, client.TeamMemberUpdateRequest{
+				TeamID: plan.TeamID.ValueString(),
+				UserID: m.UserID,
+				Role:   plan.DowngradeRole.ValueString(),
+			})
+		} else {
+			err = r.client.RemoveTeamMember(ctx, client.TeamMemberRemoveRequest{
+				TeamID: plan.TeamID.ValueString(),
+				UserID: m.UserID,
+			})
+		}
+		if client.NotFound(err) {
+			continue
+		}
+		if err != nil {
+			diags.AddError(
+				"Error enforcing Team Members",
+				fmt.Sprintf("Could not %s Team Member %s (%s), unexpected error: %s", plan.Action.ValueString(), m.Email, m.UserID, err),
+			)
+			continue
+		}
+		tflog.Info(ctx, "enforced team member", map[string]any{
+			"team_id": plan.TeamID.ValueString(),
+			"user_id": m.UserID,
+			"action":  plan.Action.ValueString(),
+		})
+	}
+	return diags
+}
+
+func (r *teamMemberEnforcementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TeamMemberEnforcement
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.enforce(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.UnmanagedMembers = types.SetValueMust(types.StringType, []attr.Value{})
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *teamMemberEnforcementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TeamMemberEnforcement
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unmanaged, diags := r.unmanagedMembers(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.UnmanagedMembers = unmanagedMembersValue(unmanaged)
+	tflog.Info(ctx, "read team member enforcement", map[string]any{
+		"team_id":   state.TeamID.ValueString(),
+		"unmanaged": len(unmanaged),
+	})
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *teamMemberEnforcementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TeamMemberEnforcement
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.enforce(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.UnmanagedMembers = types.SetValueMust(types.StringType, []attr.Value{})
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete only removes the resource from state. The members of the team are left as they are.
+func (r *teamMemberEnforcementResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "deleted team member enforcement", map[string]any{})
+}
@@ -20,6 +20,17 @@ func getTeamMemberImportID(n string) resource.ImportStateIdFunc {
 	}
 }
 
+func getTeamMemberImportIDByEmail(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["team_id"], rs.Primary.Attributes["email"]), nil
+	}
+}
+
 func TestAcc_TeamMemberResource(t *testing.T) {
 	randomSuffix := acctest.RandString(16)
 	resource.Test(t, resource.TestCase{
@@ -42,6 +53,14 @@ func TestAcc_TeamMemberResource(t *testing.T) {
 				ImportStateIdFunc:                    getTeamMemberImportID("vercel_team_member.test"),
 				ImportStateVerifyIdentifierAttribute: "user_id",
 			},
+			// ImportState by email testing
+			{
+				ResourceName:                         "vercel_team_member.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    getTeamMemberImportIDByEmail("vercel_team_member.test"),
+				ImportStateVerifyIdentifierAttribute: "user_id",
+			},
 			// Update testing
 			{
 				Config: cfg(testAccTeamMemberResourceConfig("VIEWER", testAdditionalUser(t), testTeam(t))),
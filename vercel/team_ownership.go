@@ -0,0 +1,99 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// referencesChanged returns whether a resource is being created, or any of the given ID attributes are changing.
+// The IDs a resource references only need checking in these cases, rather than on every plan. Attributes that are
+// not yet known are ignored.
+func referencesChanged(ctx context.Context, req resource.ModifyPlanRequest, attributes ...string) bool {
+	if req.Plan.Raw.IsNull() {
+		return false
+	}
+	if req.State.Raw.IsNull() {
+		return true
+	}
+	changed := false
+	for _, a := range attributes {
+		var planned, prior types.String
+		if diags := req.Plan.GetAttribute(ctx, path.Root(a), &planned); diags.HasError() || planned.IsUnknown() {
+			continue
+		}
+		if diags := req.State.GetAttribute(ctx, path.Root(a), &prior); diags.HasError() {
+			return false
+		}
+		if !planned.Equal(prior) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+// teamDescription describes the team that a resource is being configured for in error messages.
+func teamDescription(c *client.Client, teamID string) string {
+	if c.TeamID(teamID) == "" {
+		return "your personal account"
+	}
+	return "team " + c.TeamID(teamID)
+}
+
+// checkProjectTeam checks that a project belongs to the team a resource is configured for. The Vercel API reports
+// projects from other teams as not found, which would otherwise surface at apply time as a confusing error, or
+// cause the resource to be removed from state as though the project had been deleted.
+func checkProjectTeam(ctx context.Context, c *client.Client, projectID, teamID string) (diags diag.Diagnostics) {
+	_, err := c.GetProject(ctx, projectID, teamID)
+	if client.NotFound(err) {
+		diags.AddAttributeError(
+			path.Root("project_id"),
+			"Project not found in team",
+			fmt.Sprintf(
+				"The project %s could not be found in %s. Check that project_id refers to a project in the same team as team_id (or the provider's team), as the IDs of projects in other teams cannot be used.",
+				projectID,
+				teamDescription(c, teamID),
+			),
+		)
+		return diags
+	}
+	if err != nil {
+		diags.AddWarning(
+			"Unable to check project",
+			fmt.Sprintf("Could not check that project %s belongs to %s: %s", projectID, teamDescription(c, teamID), err),
+		)
+	}
+	return diags
+}
+
+// checkAccessGroupTeam checks that an access group belongs to the team a resource is configured for.
+func checkAccessGroupTeam(ctx context.Context, c *client.Client, accessGroupID, teamID string) (diags diag.Diagnostics) {
+	_, err := c.GetAccessGroup(ctx, client.GetAccessGroupRequest{
+		AccessGroupID: accessGroupID,
+		TeamID:        teamID,
+	})
+	if client.NotFound(err) {
+		diags.AddAttributeError(
+			path.Root("access_group_id"),
+			"Access Group not found in team",
+			fmt.Sprintf(
+				"The access group %s could not be found in %s. Check that access_group_id refers to an access group in the same team as team_id (or the provider's team), as the IDs of access groups in other teams cannot be used.",
+				accessGroupID,
+				teamDescription(c, teamID),
+			),
+		)
+		return diags
+	}
+	if err != nil {
+		diags.AddWarning(
+			"Unable to check access group",
+			fmt.Sprintf("Could not check that access group %s belongs to %s: %s", accessGroupID, teamDescription(c, teamID), err),
+		)
+	}
+	return diags
+}
@@ -0,0 +1,104 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = jsonStringType{}
+	_ basetypes.StringValuableWithSemanticEquals = jsonStringValue{}
+)
+
+// jsonStringType is a string attribute type that holds a JSON document. Values of this type are
+// compared semantically, so differences in whitespace or object key order do not produce a diff.
+type jsonStringType struct {
+	basetypes.StringType
+}
+
+func (t jsonStringType) Equal(o attr.Type) bool {
+	other, ok := o.(jsonStringType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t jsonStringType) String() string {
+	return "jsonStringType"
+}
+
+func (t jsonStringType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return jsonStringValue{StringValue: in}, nil
+}
+
+func (t jsonStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return stringValuable, nil
+}
+
+func (t jsonStringType) ValueType(ctx context.Context) attr.Value {
+	return jsonStringValue{}
+}
+
+// jsonStringValue is the value of a jsonStringType attribute.
+type jsonStringValue struct {
+	basetypes.StringValue
+}
+
+func newJSONStringValue(s string) jsonStringValue {
+	return jsonStringValue{StringValue: basetypes.NewStringValue(s)}
+}
+
+func (v jsonStringValue) Equal(o attr.Value) bool {
+	other, ok := o.(jsonStringValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v jsonStringValue) Type(ctx context.Context) attr.Type {
+	return jsonStringType{}
+}
+
+// StringSemanticEquals returns true if both values decode to the same JSON document. Values that
+// are not valid JSON are never semantically equal, which leaves validation errors to validateJSON.
+func (v jsonStringValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(jsonStringValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	var a, b any
+	if err := json.Unmarshal([]byte(v.ValueString()), &a); err != nil {
+		return false, diags
+	}
+	if err := json.Unmarshal([]byte(newValue.ValueString()), &b); err != nil {
+		return false, diags
+	}
+	return reflect.DeepEqual(a, b), diags
+}
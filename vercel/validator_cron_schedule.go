@@ -0,0 +1,39 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = validatorCronSchedule{}
+
+func validateCronSchedule() validatorCronSchedule {
+	return validatorCronSchedule{}
+}
+
+type validatorCronSchedule struct {
+}
+
+func (v validatorCronSchedule) Description(ctx context.Context) string {
+	return "Value must be a five field cron expression, such as `0 9 * * 1-5`"
+}
+func (v validatorCronSchedule) MarkdownDescription(ctx context.Context) string {
+	return "Value must be a five field cron expression, such as `0 9 * * 1-5`"
+}
+
+func (v validatorCronSchedule) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	_, err := parseCronSchedule(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid value provided",
+			fmt.Sprintf("Value must be a five field cron expression such as `0 9 * * 1-5`, but it could not be parsed: %s.", err),
+		)
+	}
+}
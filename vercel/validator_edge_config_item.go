@@ -0,0 +1,56 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// edgeConfigMaxSizeBytes is the largest size Vercel allows an Edge Config to be, across all of its items, on any
+// plan. The limits for each plan are in publishedPlanLimits.
+const edgeConfigMaxSizeBytes = 512 * 1024
+
+// edgeConfigItemSize returns the number of bytes an item contributes to the size of an Edge Config.
+// Vercel measures this as the JSON encoding of the key and value.
+func edgeConfigItemSize(key string, value []byte) int {
+	k, _ := json.Marshal(key)
+	return len(k) + len(value) + 1
+}
+
+var _ validator.String = validatorEdgeConfigItemValue{}
+
+func validateEdgeConfigItemValue() validatorEdgeConfigItemValue {
+	return validatorEdgeConfigItemValue{}
+}
+
+type validatorEdgeConfigItemValue struct {
+}
+
+func (v validatorEdgeConfigItemValue) Description(ctx context.Context) string {
+	return fmt.Sprintf("Value must not exceed the maximum Edge Config size of %d bytes", edgeConfigMaxSizeBytes)
+}
+func (v validatorEdgeConfigItemValue) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v validatorEdgeConfigItemValue) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	value, _ := json.Marshal(req.ConfigValue.ValueString())
+	if len(value) > edgeConfigMaxSizeBytes {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid value provided",
+			fmt.Sprintf(
+				"The Edge Config Item value is %d bytes once encoded, which exceeds the maximum Edge Config size of %d bytes.",
+				len(value),
+				edgeConfigMaxSizeBytes,
+			),
+		)
+		return
+	}
+}
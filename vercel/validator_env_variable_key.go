@@ -0,0 +1,114 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// envVariableKeyMaxLength is the longest name Vercel allows an Environment Variable to have.
+const envVariableKeyMaxLength = 256
+
+var envVariableKeyRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// reservedEnvVariableKeys are the names that Vercel documents as reserved, as they are used by the runtime that
+// Vercel Functions run on. Vercel rejects Environment Variables with these names. See
+// https://vercel.com/docs/projects/environment-variables/reserved-environment-variables.
+var reservedEnvVariableKeys = map[string]struct{}{
+	"AWS_SECRET_KEY":                  {},
+	"AWS_EXECUTION_ENV":               {},
+	"AWS_LAMBDA_LOG_GROUP_NAME":       {},
+	"AWS_LAMBDA_LOG_STREAM_NAME":      {},
+	"AWS_LAMBDA_FUNCTION_NAME":        {},
+	"AWS_LAMBDA_FUNCTION_MEMORY_SIZE": {},
+	"AWS_LAMBDA_FUNCTION_VERSION":     {},
+	"NOW_REGION":                      {},
+	"TZ":                              {},
+	"LAMBDA_TASK_ROOT":                {},
+	"LAMBDA_RUNTIME_DIR":              {},
+}
+
+// checkEnvVariableKey returns a description of why Vercel would reject an Environment Variable name, or an empty
+// string if the name is allowed.
+func checkEnvVariableKey(key string) string {
+	if len(key) > envVariableKeyMaxLength {
+		return fmt.Sprintf("The Environment Variable name %q is %d characters long, but can be at most %d characters.", key, len(key), envVariableKeyMaxLength)
+	}
+	if !envVariableKeyRe.MatchString(key) {
+		return fmt.Sprintf("The Environment Variable name %q is invalid. Names can only contain letters, digits and underscores, and cannot start with a digit.", key)
+	}
+	if _, ok := reservedEnvVariableKeys[key]; ok {
+		return fmt.Sprintf("The Environment Variable name %q is reserved by Vercel. Please choose a different name.", key)
+	}
+	return ""
+}
+
+var _ validator.String = validatorEnvVariableKey{}
+
+func validateEnvVariableKey() validatorEnvVariableKey {
+	return validatorEnvVariableKey{}
+}
+
+type validatorEnvVariableKey struct {
+}
+
+func (v validatorEnvVariableKey) Description(ctx context.Context) string {
+	return "Value must be a valid Environment Variable name that is not reserved by Vercel"
+}
+func (v validatorEnvVariableKey) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v validatorEnvVariableKey) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	if problem := checkEnvVariableKey(req.ConfigValue.ValueString()); problem != "" {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Environment Variable name", problem)
+	}
+}
+
+var _ validator.Map = validatorEnvVariableKeys{}
+
+// validateEnvVariableKeys validates the keys of a map of Environment Variables, where each key is the name of an
+// Environment Variable. Keys of elements that set their own `key` are not names, so are not validated.
+func validateEnvVariableKeys() validatorEnvVariableKeys {
+	return validatorEnvVariableKeys{}
+}
+
+type validatorEnvVariableKeys struct {
+}
+
+func (v validatorEnvVariableKeys) Description(ctx context.Context) string {
+	return "Keys must be valid Environment Variable names that are not reserved by Vercel"
+}
+func (v validatorEnvVariableKeys) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v validatorEnvVariableKeys) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	keys := make([]string, 0, len(req.ConfigValue.Elements()))
+	for key := range req.ConfigValue.Elements() {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if obj, ok := req.ConfigValue.Elements()[key].(types.Object); ok {
+			if name, ok := obj.Attributes()["key"]; ok && !name.IsNull() {
+				continue
+			}
+		}
+		if problem := checkEnvVariableKey(key); problem != "" {
+			resp.Diagnostics.AddAttributeError(req.Path.AtMapKey(key), "Invalid Environment Variable name", problem)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package vercel
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = validatorFlagsSecret{}
+
+func validateFlagsSecret() validatorFlagsSecret {
+	return validatorFlagsSecret{}
+}
+
+type validatorFlagsSecret struct {
+}
+
+func (v validatorFlagsSecret) Description(ctx context.Context) string {
+	return "Value must be 32 bytes, encoded using base64url"
+}
+func (v validatorFlagsSecret) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v validatorFlagsSecret) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(req.ConfigValue.ValueString(), "="))
+	if err != nil || len(decoded) != 32 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Flags secret",
+			"The Flags secret must be 32 random bytes, encoded using base64url. One can be generated with `node -e \"console.log(crypto.randomBytes(32).toString('base64url'))\"`.",
+		)
+	}
+}
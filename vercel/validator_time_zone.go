@@ -0,0 +1,40 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = validatorTimeZone{}
+
+func validateTimeZone() validatorTimeZone {
+	return validatorTimeZone{}
+}
+
+type validatorTimeZone struct {
+}
+
+func (v validatorTimeZone) Description(ctx context.Context) string {
+	return "Value must be an IANA time zone name, such as `Europe/London`"
+}
+func (v validatorTimeZone) MarkdownDescription(ctx context.Context) string {
+	return "Value must be an IANA time zone name, such as `Europe/London`"
+}
+
+func (v validatorTimeZone) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	_, err := time.LoadLocation(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid value provided",
+			fmt.Sprintf("Value must be an IANA time zone name such as `Europe/London`, but it could not be loaded: %s.", err),
+		)
+	}
+}
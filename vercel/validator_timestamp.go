@@ -0,0 +1,39 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = validatorTimestamp{}
+
+func validateTimestamp() validatorTimestamp {
+	return validatorTimestamp{}
+}
+
+type validatorTimestamp struct {
+}
+
+func (v validatorTimestamp) Description(ctx context.Context) string {
+	return "Value must be a timestamp in RFC 3339 format, such as `2024-01-01T00:00:00Z`"
+}
+func (v validatorTimestamp) MarkdownDescription(ctx context.Context) string {
+	return "Value must be a timestamp in RFC 3339 format, such as `2024-01-01T00:00:00Z`"
+}
+
+func (v validatorTimestamp) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid value provided",
+			fmt.Sprintf("Value must be a timestamp in RFC 3339 format such as `2024-01-01T00:00:00Z`, but it could not be parsed: %s.", err),
+		)
+	}
+}
@@ -0,0 +1,76 @@
+package vercel
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// writeOnlyHashKey returns the private state key that holds the hash of a write-only attribute.
+func writeOnlyHashKey(valuePath path.Path) string {
+	return "vercel_wo_" + valuePath.String()
+}
+
+// writeOnlyHash returns the hash of a write-only value, in the form it is kept in private state.
+func writeOnlyHash(value string) []byte {
+	return []byte(fmt.Sprintf("\"%x\"", sha256.Sum256([]byte(value))))
+}
+
+// recordWriteOnlyValue keeps the hash of a write-only value in private state, or removes it if the value is not set.
+func recordWriteOnlyValue(ctx context.Context, private privateState, valuePath path.Path, value types.String) diag.Diagnostics {
+	if value.IsNull() || value.IsUnknown() {
+		return private.SetKey(ctx, writeOnlyHashKey(valuePath), nil)
+	}
+	return private.SetKey(ctx, writeOnlyHashKey(valuePath), writeOnlyHash(value.ValueString()))
+}
+
+// hasWriteOnlyValue returns whether a write-only value was set when the resource was last created or updated.
+func hasWriteOnlyValue(ctx context.Context, private privateState, valuePath path.Path) bool {
+	stored, _ := private.GetKey(ctx, writeOnlyHashKey(valuePath))
+	return len(stored) > 0
+}
+
+// checkWriteOnlyValue validates a configured write-only value. Write-only attributes can only be used once the
+// `secrets.write_only` feature is enabled. Terraform does not plan changes to write-only values, so if the value no
+// longer matches the hash in private state but its version has not changed, a warning is returned.
+func checkWriteOnlyValue(ctx context.Context, c *client.Client, req resource.ModifyPlanRequest, valuePath, versionPath path.Path) (diags diag.Diagnostics) {
+	var value types.String
+	diags.Append(req.Config.GetAttribute(ctx, valuePath, &value)...)
+	if diags.HasError() || value.IsNull() {
+		return diags
+	}
+	if c != nil && !c.Features().Secrets.WriteOnly {
+		diags.AddAttributeError(
+			valuePath,
+			"Write-only secrets are not enabled",
+			fmt.Sprintf("To use `%s`, set `write_only = true` in the `secrets` block of the provider `features`.", valuePath),
+		)
+		return diags
+	}
+	if value.IsUnknown() || req.State.Raw.IsNull() {
+		return diags
+	}
+
+	stored, _ := req.Private.GetKey(ctx, writeOnlyHashKey(valuePath))
+	if len(stored) == 0 || string(stored) == string(writeOnlyHash(value.ValueString())) {
+		return diags
+	}
+	var version, priorVersion types.String
+	diags.Append(req.Config.GetAttribute(ctx, versionPath, &version)...)
+	diags.Append(req.State.GetAttribute(ctx, versionPath, &priorVersion)...)
+	if diags.HasError() || !version.Equal(priorVersion) {
+		return diags
+	}
+	diags.AddAttributeWarning(
+		valuePath,
+		"Write-only value changed",
+		fmt.Sprintf("The value of `%s` has changed, but `%s` has not, so the new value may not be applied. Change `%s` to apply it.", valuePath, versionPath, versionPath),
+	)
+	return diags
+}